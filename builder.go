@@ -27,6 +27,8 @@ type Builder struct {
 	tabs       *Tabs            // Last tabs widget to add new tabs
 	class      string           // CSS-like class name for styling
 	x, y, w, h int              // Grid cell coordinates and dimensions
+	item       *FlexItem        // Layout parameters for the next Flex child
+	point      *Breakpoint      // Breakpoint for the next Responsive variant
 }
 
 // NewBuilder creates a new Builder instance with the specified theme.
@@ -86,14 +88,7 @@ func (b *Builder) With(fn func(*Builder)) *Builder {
 // This method is normally not called from the outside, because for most
 // widgets specific builder methods exist, e.g. List or Static.
 func (b *Builder) Add(widget Widget) *Builder {
-	if len(b.stack) > 0 {
-		top := b.stack.Peek()
-		if _, ok := top.(*Grid); ok {
-			top.Add(widget, b.x, b.y, b.w, b.h)
-		} else {
-			top.Add(widget)
-		}
-	}
+	b.attach(widget)
 	widget.Apply(b.theme)
 	b.current = widget
 	if container, ok := widget.(Container); ok {
@@ -117,18 +112,33 @@ func (b *Builder) End() *Builder {
 // widgets whose internal child tree should not be exposed to the builder
 // API (e.g. ColorPanel, ColorPicker).
 func (b *Builder) addLeaf(widget Widget) {
-	if len(b.stack) > 0 {
-		top := b.stack.Peek()
-		if _, ok := top.(*Grid); ok {
-			top.Add(widget, b.x, b.y, b.w, b.h)
-		} else {
-			top.Add(widget)
-		}
-	}
+	b.attach(widget)
 	widget.Apply(b.theme)
 	b.current = widget
 }
 
+// attach adds widget to the current parent with the layout parameters the
+// parent takes: the cell set with Cell for a Grid, the item set with Item
+// for a Flex, the breakpoint set with Breakpoint for a Responsive.
+func (b *Builder) attach(widget Widget) {
+	if len(b.stack) == 0 {
+		return
+	}
+	top := b.stack.Peek()
+	switch top.(type) {
+	case *Grid:
+		top.Add(widget, b.x, b.y, b.w, b.h)
+	case *Flex:
+		top.Add(widget, b.item)
+	case *Responsive:
+		top.Add(widget, b.point)
+	default:
+		top.Add(widget)
+	}
+	b.item = nil
+	b.point = nil
+}
+
 // ---- Widgets --------------------------------------------------------------
 
 // BarChart creates a new BarChart widget for displaying multi-series stacked
@@ -140,6 +150,14 @@ func (b *Builder) BarChart(id string) *Builder {
 	return b
 }
 
+// Board creates a new Kanban board. Add columns and cards via the returned
+// *Board after building.
+func (b *Builder) Board(id string) *Builder {
+	board := NewBoard(id, b.class)
+	b.Add(board)
+	return b
+}
+
 // Box creates a new box widget with the specified id and display title.
 // The box is automatically styled with theme styles for the border and
 // the title.
@@ -195,6 +213,14 @@ func (b *Builder) Button(id, text string) *Builder {
 	return b
 }
 
+// Calendar creates a month grid for picking a date, showing the current
+// month with today selected.
+func (b *Builder) Calendar(id string) *Builder {
+	calendar := NewCalendar(id, b.class)
+	b.Add(calendar)
+	return b
+}
+
 // Checkbox creates a new checkbox widget with the specified id and display text.
 func (b *Builder) Checkbox(id, text string, checked bool) *Builder {
 	checkbox := NewCheckbox(id, b.class, text, checked)
@@ -226,6 +252,17 @@ func (b *Builder) Combo(id string, items ...string) *Builder {
 	return b
 }
 
+// DatePicker creates a date input that opens a calendar popup. An
+// optional placeholder is shown while no date is set.
+func (b *Builder) DatePicker(id string, placeholder ...string) *Builder {
+	picker := NewDatePicker(id, b.class)
+	if len(placeholder) > 0 {
+		picker.SetPlaceholder(placeholder[0])
+	}
+	b.Add(picker)
+	return b
+}
+
 // Deck creates a new deck widget for displaying rich multi-row items.
 //
 // Parameters:
@@ -366,6 +403,15 @@ func (b *Builder) Input(id string, params ...string) *Builder {
 	return b
 }
 
+// LineChart creates a new LineChart widget for displaying multi-series line
+// and area charts. Configure series, the x-axis and display options via the
+// returned *LineChart after building.
+func (b *Builder) LineChart(id string) *Builder {
+	lc := NewLineChart(id, b.class)
+	b.Add(lc)
+	return b
+}
+
 // List creates a new list widget for displaying selectable items.
 //
 // Parameters:
@@ -384,6 +430,16 @@ func (b *Builder) Marquee(id string) *Builder {
 	return b
 }
 
+// Notification creates a notification placed in the layout, see
+// UI.Notify for notifications stacked on top of the screen. Clicking its
+// close button dismisses it and dispatches EvtHide; the application
+// decides what happens then.
+func (b *Builder) Notification(id string, level Level, title, text string) *Builder {
+	n := NewNotification(id, b.class).SetLevel(level).SetTitle(title).SetText(text)
+	b.Add(n)
+	return b
+}
+
 // PreviewPanel creates a fg/bg preview panel that displays the WCAG
 // contrast ratio between its colours. It is updated by parent widgets
 // (typically a ColorPicker) and emits no events. The builder treats it as
@@ -426,6 +482,26 @@ func (b *Builder) Radio(id string, args ...string) *Builder {
 	return b
 }
 
+// Responsive creates a container that shows one of its children, the
+// variants, depending on its size. Precede each variant with Breakpoint to
+// set the size from which it is used; a variant without one is the
+// fallback.
+//
+//	Responsive("main").
+//		VFlex("narrow", Stretch, 0).
+//		...
+//		End().
+//		Breakpoint(120, 0).
+//		HFlex("wide", Stretch, 1).
+//		...
+//		End().
+//		End()
+func (b *Builder) Responsive(id string) *Builder {
+	r := NewResponsive(id, b.class)
+	b.Add(r)
+	return b
+}
+
 // Slider creates a horizontal int-valued range input. The widget defaults to
 // min=0, max=100, value=0, step=1; configure via the returned widget after
 // retrieval with Find. The renderer picks a compact one-row style at height
@@ -489,6 +565,15 @@ func (b *Builder) Sparkline(id string) *Builder {
 	return b
 }
 
+// Splitter creates a new draggable handle between the previous and the next
+// child of the current Flex. Dragging it or pressing the arrow keys resizes
+// both neighbours.
+func (b *Builder) Splitter(id string) *Builder {
+	s := NewSplitter(id, b.class)
+	b.Add(s)
+	return b
+}
+
 // Spinner creates a new spinner widget for animated spinners.
 func (b *Builder) Spinner(id string, sequence string) *Builder {
 	spinner := NewSpinner(id, b.class, sequence)
@@ -582,6 +667,14 @@ func (b *Builder) Tiles(id string, render ItemRender, tileWidth, tileHeight int)
 	return b
 }
 
+// TimeSpinner creates a compact "HH:MM" input for a time of day.
+func (b *Builder) TimeSpinner(id string, hour, minute int) *Builder {
+	spinner := NewTimeSpinner(id, b.class)
+	spinner.SetTime(hour, minute)
+	b.Add(spinner)
+	return b
+}
+
 // Tree creates a new tree widget for displaying hierarchical data.
 func (b *Builder) Tree(id string) *Builder {
 	tree := NewTree(id, b.class)
@@ -685,12 +778,12 @@ func (b *Builder) buildGroup(form *Form, group *FormGroup, name string) {
 		control := sf.Tag.Get("control")
 		options := sf.Tag.Get("options")
 		_, readonly := sf.Tag.Lookup("readonly")
-		width, err := strconv.Atoi(sf.Tag.Get("width"))
+		tag, sized := sf.Tag.Lookup("width")
+		width, err := strconv.Atoi(tag)
 		if err != nil {
 			width = 10
 		}
-		l, err := strconv.Atoi(sf.Tag.Get("line"))
-		if err == nil {
+		if l, err := strconv.Atoi(sf.Tag.Get("line")); err == nil {
 			line = l
 		}
 
@@ -698,6 +791,9 @@ func (b *Builder) buildGroup(form *Form, group *FormGroup, name string) {
 		if readonly {
 			widget.SetFlag(FlagReadonly, true)
 		}
+		if _, ok := widget.(*DatePicker); ok && !sized {
+			width, _ = widget.Hint()
+		}
 		widget.SetHint(width, 1)
 		widget.SetParent(b.stack.Peek())
 		widget.On(EvtChange, form.Update(fv))
@@ -709,8 +805,10 @@ func (b *Builder) buildGroup(form *Form, group *FormGroup, name string) {
 
 func (b *Builder) buildFormControl(control, id string, v reflect.Value, options string) Widget {
 	if control == "" {
-		switch v.Kind() {
-		case reflect.Bool:
+		switch {
+		case v.Type() == reflect.TypeFor[time.Time]():
+			control = "date"
+		case v.Kind() == reflect.Bool:
 			control = "checkbox"
 		default:
 			control = "input"
@@ -718,6 +816,16 @@ func (b *Builder) buildFormControl(control, id string, v reflect.Value, options
 	}
 
 	switch control {
+	case "date", "datetime":
+		picker := NewDatePicker(id, b.class)
+		picker.Apply(b.theme)
+		picker.SetShowTime(control == "datetime")
+		if v.CanInterface() {
+			if t, ok := v.Interface().(time.Time); ok {
+				picker.SetDate(t)
+			}
+		}
+		return picker
 	case "checkbox":
 		checkbox := NewCheckbox(id, b.class, id, v.Bool())
 		checkbox.Apply(b.theme)
@@ -818,6 +926,28 @@ func (b *Builder) Cell(x, y, w, h int) *Builder {
 	return b
 }
 
+// Item sets the layout parameters of the next widget added to a Flex, like
+// Cell does for a Grid. Unlike Cell, it applies to one widget only.
+//
+//	HFlex("main", Stretch, 1).
+//		Item(FlexItem{Min: 20, Shrink: 1, Collapse: true}).
+//		VFlex("sidebar", Stretch, 0).
+//		...
+//		End().
+//		Item(FlexItem{Grow: 1}).
+//		Text("content", nil, false, 0)
+func (b *Builder) Item(item FlexItem) *Builder {
+	b.item = &item
+	return b
+}
+
+// Breakpoint sets the minimum width and height from which the next widget
+// added to a Responsive is shown. Like Item, it applies to one widget only.
+func (b *Builder) Breakpoint(minWidth, minHeight int) *Builder {
+	b.point = &Breakpoint{MinWidth: minWidth, MinHeight: minHeight}
+	return b
+}
+
 // Class sets a CSS-like class name that will be applied to subsequently
 // created widgets. The class name is used in selector generation for styling
 // purposes. For example, setting class to "primary" will generate selectors
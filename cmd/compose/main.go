@@ -582,11 +582,17 @@ func logMonitorScreen(theme *core.Theme) core.Widget {
 		ticker = time.NewTicker(1200 * time.Millisecond)
 		go func() {
 			for range ticker.C {
-				if !paused {
-					if logText, ok := core.Find(container, "log-text").(*widgets.Text); ok {
-						logText.Add(generateLogLine())
-					}
+				root := widgets.FindRoot(container)
+				if root == nil {
+					continue
 				}
+				root.Post(func() {
+					if !paused {
+						if logText, ok := core.Find(container, "log-text").(*widgets.Text); ok {
+							logText.Add(generateLogLine())
+						}
+					}
+				})
 			}
 		}()
 		return true
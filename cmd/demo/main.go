@@ -12,7 +12,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/gdamore/tcell/v3"
 	. "github.com/tekugo/zeichenwerk"
 	. "github.com/tekugo/zeichenwerk/core"
 	"github.com/tekugo/zeichenwerk/inspector"
@@ -147,13 +146,7 @@ func createUI(theme *Theme) *UI {
 
 	// Register demo commands and bind Ctrl+K globally.
 	registerCommandsDemo(ui)
-	OnKey(Find(ui, "main"), func(e *tcell.EventKey) bool {
-		if e.Key() == tcell.KeyCtrlK {
-			ui.Commands().Open()
-			return true
-		}
-		return false
-	})
+	ui.Keymap().Bind("Ctrl-K", ActionCommandsOpen)
 
 	switcher := Find(ui, "switcher").(*Switcher)
 	Find(ui, "navigation").On(EvtActivate, func(_ Widget, event Event, data ...any) bool {
@@ -1612,17 +1605,23 @@ func sparklineDemo(builder *Builder) {
 					v01 := (math.Sin(phase*0.7) + 1.0) / 2.0
 					phase += 0.25
 
-					rbRel.Add(v)
-					rbAbs.Add(v)
-					rbThr.Add(v01)
-					rbGrad.Add(v01)
-					rbMulti.Add(v)
-
-					spRel.Refresh()
-					spAbs.Refresh()
-					spThr.Refresh()
-					spGrad.Refresh()
-					spMulti.Refresh()
+					root := FindRoot(container)
+					if root == nil {
+						continue
+					}
+					root.Post(func() {
+						rbRel.Add(v)
+						rbAbs.Add(v)
+						rbThr.Add(v01)
+						rbGrad.Add(v01)
+						rbMulti.Add(v)
+
+						spRel.Refresh()
+						spAbs.Refresh()
+						spThr.Refresh()
+						spGrad.Refresh()
+						spMulti.Refresh()
+					})
 				}
 			}
 		}()
@@ -1698,7 +1697,10 @@ func heatmapDemo(builder *Builder) {
 					if r >= 8 && r < 18 && c < 5 {
 						base = 0.5
 					}
-					hm.SetValue(r, c, base+next()*0.5)
+					value := base + next()*0.5
+					if root := FindRoot(container); root != nil {
+						root.Post(func() { hm.SetValue(r, c, value) })
+					}
 				}
 			}
 		}()
@@ -4,9 +4,11 @@ import (
 	"fmt"
 	"math"
 	"math/rand"
+	"time"
 
 	. "github.com/tekugo/zeichenwerk"
 	. "github.com/tekugo/zeichenwerk/core"
+	. "github.com/tekugo/zeichenwerk/values"
 	. "github.com/tekugo/zeichenwerk/widgets"
 )
 
@@ -123,6 +125,38 @@ hm.SetAll(values)`,
 		Builder: `builder.Indicator("ok", Success, "Build succeeded")`,
 		Compose: `compose.Indicator("ok", "", core.Success, "Build succeeded")`,
 	},
+	{
+		Category: "Display",
+		Name:     "LineChart",
+		Summary:  "Multi-series line and area chart in Braille dots, with cursor readout and time axis.",
+		DocFile:  "line-chart.md",
+		DemoFn:   lineChartDemoFn,
+		Builder: `builder.LineChart("chart").Hint(-1, 12)
+chart := builder.Find("chart").(*LineChart)
+chart.SetCategories([]string{"Mon", "Tue", "Wed", "Thu", "Fri"})
+chart.SetSeries([]LineSeries{
+    {Label: "Visits", Values: []float64{120, 145, 131, 188, 160}},
+})
+chart.SetShowFill(true)`,
+		Compose: `compose.LineChart("chart", "", compose.Hint(-1, 12))
+// then, for a streaming chart:
+ts := core.NewTimeSeries[float64](time.Now(), time.Second, 120, true)
+chart.SetSeries([]widgets.LineSeries{{Label: "load", Provider: ts}})`,
+	},
+	{
+		Category: "Display",
+		Name:     "Notification",
+		Summary:  "Toast messages stacked in a corner, with timeout, actions, grouping and history.",
+		DocFile:  "notification.md",
+		DemoFn:   notificationDemoFn,
+		Builder: `ui.Notify(Success, "Saved", "main.go written to disk.")
+ui.Notify(Error, "Build failed", "exit status 1", NotifyOptions{
+    Timeout: -1,
+    Actions: []NotificationAction{{Label: "Retry", Fn: build}},
+})
+ui.Perform(ActionNotificationsHistory)`,
+		Compose: `compose.Notification("saved", "", core.Success, "Saved", "main.go written to disk.")`,
+	},
 	{
 		Category: "Display",
 		Name:     "Rule",
@@ -483,6 +517,85 @@ func indicatorDemoFn(b *Builder) {
 		End()
 }
 
+func lineChartDemoFn(b *Builder) {
+	days := []string{"Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun"}
+	b.VFlex("lc-demo", Stretch, 1).Padding(1, 2).
+		Static("desc", "Braille dots give each cell 2×4 points. ←→ or the mouse move the cursor.").
+		Padding(0, 0, 1, 0).
+		Static("l1", "Categories with area fill:").
+		LineChart("lc-days").Hint(-1, 12).
+		Static("l2", "Time series, one sample per second:").Padding(1, 0, 0, 0).
+		LineChart("lc-time").Hint(-1, 12).
+		End()
+
+	lc := b.Find("lc-days").(*LineChart)
+	lc.SetCategories(days)
+	lc.SetShowFill(true)
+	lc.SetSeries([]LineSeries{
+		{Label: "Visits", Values: []float64{120, 145, 131, 188, 160, 92, 75}},
+		{Label: "Signups", Values: []float64{18, 25, 22, 41, 30, 12, 9}},
+	})
+
+	now := time.Now()
+	cpu := NewTimeSeries[float64](now.Add(-120*time.Second), time.Second, 120, true)
+	mem := NewTimeSeries[float64](now.Add(-120*time.Second), time.Second, 120, true)
+	for i := range 120 {
+		t := now.Add(time.Duration(i-120) * time.Second)
+		cpu.Set(t, 50+30*math.Sin(float64(i)*0.1)+rand.Float64()*10)
+		mem.Set(t, 40+float64(i)*0.2)
+	}
+	lt := b.Find("lc-time").(*LineChart)
+	lt.SetAbsolute(true)
+	lt.SetMax(100)
+	lt.SetSeries([]LineSeries{
+		{Label: "CPU %", Provider: cpu},
+		{Label: "Memory %", Provider: mem},
+	})
+}
+
+func notificationDemoFn(b *Builder) {
+	b.VFlex("nt-demo", Stretch, 1).Padding(1, 2).
+		Static("desc", "UI.Notify stacks notifications in the bottom-right corner. They never take the focus; repeats are grouped.").
+		Padding(0, 0, 1, 0).
+		HFlex("nt-buttons", Start, 2).
+		Button("nt-info", "Info").
+		Button("nt-success", "Success").
+		Button("nt-warning", "Warning").
+		Button("nt-error", "Error with action").
+		Button("nt-history", "History").
+		End().
+		Static("nt-status", "").Padding(1, 0, 0, 0).
+		End()
+
+	notify := func(id string, fn func(ui *UI)) {
+		b.Find(id).On(EvtActivate, func(w Widget, _ Event, _ ...any) bool {
+			fn(FindRoot(w).(*UI))
+			return true
+		})
+	}
+	notify("nt-info", func(ui *UI) {
+		ui.Notify(Info, "Sync", "Pulled 3 new commits from origin.")
+	})
+	notify("nt-success", func(ui *UI) {
+		ui.Notify(Success, "Saved", "main.go written to disk.")
+	})
+	notify("nt-warning", func(ui *UI) {
+		ui.Notify(Warning, "Disk space", "Less than 1 GB remaining on /home.", NotifyOptions{Timeout: 10 * time.Second})
+	})
+	notify("nt-error", func(ui *UI) {
+		ui.Notify(Error, "Build failed", "exit status 1", NotifyOptions{
+			Timeout: -1,
+			Actions: []NotificationAction{
+				{Label: "Retry", Fn: func() { Update(ui, "nt-status", "Retrying build …") }},
+				{Label: "History", Fn: ui.NotificationHistory},
+			},
+		})
+	})
+	notify("nt-history", func(ui *UI) {
+		ui.NotificationHistory()
+	})
+}
+
 func ruleDemo(b *Builder) {
 	b.VFlex("rule-demo", Stretch, 1).Padding(1, 2).
 		Static("desc", "Horizontal and vertical rules. Style names refer to theme borders.").
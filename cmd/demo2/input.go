@@ -2,6 +2,7 @@ package main
 
 import (
 	"fmt"
+	"time"
 
 	. "github.com/tekugo/zeichenwerk"
 	. "github.com/tekugo/zeichenwerk/core"
@@ -9,6 +10,27 @@ import (
 )
 
 var inputEntries = []Entry{
+	{
+		Category: "Input",
+		Name:     "Board",
+		Summary:  "Kanban board of columns with cards moved by keys or drag-and-drop.",
+		DocFile:  "board.md",
+		DemoFn:   boardDemo,
+		Builder: `builder.Board("sprint")
+board := builder.Find("sprint").(*Board)
+todo := board.AddColumn("todo", "To Do")
+todo.AddCard(&BoardCard{Title: "Design auth flow", Label: "$blue"})
+wip := board.AddColumn("wip", "In Progress")
+wip.SetLimit(2)
+board.AddColumn("done", "Done")
+board.On(EvtMove, func(_ Widget, _ Event, data ...any) bool {
+    change := data[0].(BoardChange)
+    return change.ToColumn == wip && wip.Over() // veto
+})`,
+		Compose: `compose.Board("sprint", "")
+board := Find(ui, "sprint").(*Board)
+board.AddColumn("todo", "To Do")`,
+	},
 	{
 		Category: "Input",
 		Name:     "Button",
@@ -28,6 +50,22 @@ btn.On(EvtActivate, func(_ Widget, _ Event, _ ...any) bool {
     }),
 )`,
 	},
+	{
+		Category: "Input",
+		Name:     "Calendar",
+		Summary:  "Month grid for picking a date or a range, with min/max and disabled dates.",
+		DocFile:  "calendar.md",
+		DemoFn:   calendarDemo,
+		Builder: `builder.Calendar("day")
+cal := builder.Find("day").(*Calendar)
+cal.SetLocale("de")
+cal.SetDisabled(func(t time.Time) bool { return t.Weekday() == time.Sunday })
+cal.On(EvtActivate, func(_ Widget, _ Event, data ...any) bool {
+    fmt.Println("picked", data[0])
+    return true
+})`,
+		Compose: `compose.Calendar("day", "")`,
+	},
 	{
 		Category: "Input",
 		Name:     "Checkbox",
@@ -56,6 +94,21 @@ combo.On(EvtActivate, func(_ Widget, _ Event, data ...any) bool {
 })`,
 		Compose: `compose.Combo("history", "", []string{"fix bug", "add feature", "refactor"})`,
 	},
+	{
+		Category: "Input",
+		Name:     "DatePicker",
+		Summary:  "Date input that opens a calendar popup, optionally with a time spinner.",
+		DocFile:  "date-picker.md",
+		DemoFn:   datePickerDemo,
+		Builder: `builder.DatePicker("due", "Pick a date")
+picker := builder.Find("due").(*DatePicker)
+picker.SetShowTime(true)
+picker.On(EvtChange, func(_ Widget, _ Event, data ...any) bool {
+    fmt.Println("due =", data[0])
+    return true
+})`,
+		Compose: `compose.DatePicker("due", "", "Pick a date")`,
+	},
 	{
 		Category: "Input",
 		Name:     "Editor",
@@ -154,6 +207,43 @@ s.On(EvtChange, func(_ Widget, _ Event, data ...any) bool {
     compose.Hint(0, 1),
 )`,
 	},
+	{
+		Category: "Input",
+		Name:     "Splitter",
+		Summary:  "Draggable handle that resizes the two Flex panes around it.",
+		DocFile:  "splitter.md",
+		DemoFn:   splitterDemo,
+		Builder: `builder.HFlex("panes", Stretch, 0).
+    List("files", "main.go", "ui.go").Hint(20, 0).
+    Splitter("split").
+    Text("preview", nil, false, 0).Hint(-1, 0).
+End()
+split := builder.Find("split").(*Splitter)
+split.SetMinSize(10)
+split.On(EvtChange, func(_ Widget, _ Event, data ...any) bool {
+    fmt.Println("ratio =", data[0])
+    return true
+})`,
+		Compose: `compose.HFlex("panes", "", Stretch, 0,
+    compose.List("files", "", files, compose.Hint(20, 0)),
+    compose.Splitter("split", ""),
+    compose.Text("preview", "", nil, false, 0, compose.Hint(-1, 0)),
+)`,
+	},
+	{
+		Category: "Input",
+		Name:     "TimeSpinner",
+		Summary:  "Compact HH:MM input for a time of day.",
+		DocFile:  "time-spinner.md",
+		DemoFn:   timeSpinnerDemo,
+		Builder: `builder.TimeSpinner("alarm", 7, 30)
+spinner := builder.Find("alarm").(*TimeSpinner)
+spinner.On(EvtChange, func(_ Widget, _ Event, data ...any) bool {
+    fmt.Printf("%02d:%02d\n", data[0], data[1])
+    return true
+})`,
+		Compose: `compose.TimeSpinner("alarm", "", 7, 30)`,
+	},
 	{
 		Category: "Input",
 		Name:     "Tree",
@@ -226,6 +316,37 @@ func buttonDemo(b *Builder) {
 	}
 }
 
+func calendarDemo(b *Builder) {
+	b.VFlex("calendar-demo", Start, 1).Padding(1, 2).
+		Static("desc", "Arrows move by day and week, PgUp/PgDn by month, Ctrl+PgUp/PgDn by year. Enter picks; weekends are disabled.").
+		Padding(0, 0, 1, 0).
+		HFlex("calendars", Start, 4).
+		Calendar("single").
+		Calendar("range").
+		End().
+		Static("status", "Pick a date on the left or a range on the right.").
+		End()
+
+	status := b.Find("status").(*Static)
+	single := b.Find("single").(*Calendar)
+	single.SetDisabled(func(t time.Time) bool {
+		return t.Weekday() == time.Saturday || t.Weekday() == time.Sunday
+	})
+	single.On(EvtActivate, func(_ Widget, _ Event, data ...any) bool {
+		status.Set("Picked " + data[0].(time.Time).Format("Monday, 2 January 2006"))
+		return true
+	})
+
+	ranged := b.Find("range").(*Calendar)
+	ranged.SetRangeMode(true)
+	ranged.SetWeekStart(time.Sunday)
+	ranged.SetLocale("fr")
+	ranged.On(EvtActivate, func(_ Widget, _ Event, data ...any) bool {
+		status.Set(fmt.Sprintf("Range %s – %s", data[0].(time.Time).Format(time.DateOnly), data[1].(time.Time).Format(time.DateOnly)))
+		return true
+	})
+}
+
 func checkboxDemo(b *Builder) {
 	b.VFlex("checkbox-demo", Stretch, 1).Padding(1, 2).
 		Static("desc", "Checkboxes toggle a boolean state.").
@@ -270,6 +391,37 @@ func comboDemoFn(b *Builder) {
 	})
 }
 
+func datePickerDemo(b *Builder) {
+	b.VFlex("date-picker-demo", Start, 1).Padding(1, 2).
+		Static("desc", "Enter or a click opens the calendar. In the date-and-time picker Tab moves to the time; Enter confirms. Delete clears.").
+		Padding(0, 0, 1, 0).
+		Static("date-label", "Date (next 90 days):").
+		DatePicker("date", "Pick a date").
+		Static("datetime-label", "Date and time:").
+		DatePicker("datetime").
+		Static("range-label", "Range:").
+		DatePicker("range", "Pick a range").
+		Static("status", "").Padding(1, 0, 0, 0).
+		End()
+
+	status := b.Find("status").(*Static)
+	today := time.Now()
+	date := b.Find("date").(*DatePicker)
+	date.SetMinDate(today)
+	date.SetMaxDate(today.AddDate(0, 0, 90))
+	datetime := b.Find("datetime").(*DatePicker)
+	datetime.SetShowTime(true)
+	datetime.SetDate(today)
+	ranged := b.Find("range").(*DatePicker)
+	ranged.SetRangeMode(true)
+	for _, picker := range []*DatePicker{date, datetime, ranged} {
+		picker.On(EvtChange, func(_ Widget, _ Event, _ ...any) bool {
+			status.Set(picker.ID() + " = " + picker.Text())
+			return true
+		})
+	}
+}
+
 func editorDemo(b *Builder) {
 	b.VFlex("editor-demo", Stretch, 1).Padding(1, 2).
 		Static("desc", "Multi-line editor with line numbers, cursor, Tab indentation.").
@@ -414,6 +566,98 @@ func sliderDemo(b *Builder) {
 	contrast.On(EvtChange, func(_ Widget, _ Event, _ ...any) bool { update(); return true })
 }
 
+func splitterDemo(b *Builder) {
+	b.VFlex("splitter-demo", Stretch, 1).Padding(1, 2).
+		Static("desc", "Drag a handle or focus it and use the arrow keys. Double click resets.").
+		Padding(0, 0, 1, 0).
+		HFlex("panes", Stretch, 0).Hint(0, -1).
+		Box("left", "Left").Hint(24, 0).
+		Static("left-text", "At least 10 columns wide").
+		End().
+		Splitter("split-h").
+		VFlex("right", Stretch, 0).Hint(-1, 0).
+		Box("top", "Top").Hint(0, -1).
+		Static("top-text", "Top pane").
+		End().
+		Splitter("split-v").
+		Box("bottom", "Bottom").Hint(0, -1).
+		Static("bottom-text", "Bottom pane").
+		End().
+		End().
+		End().
+		Static("status", "Drag a splitter to see its ratio").Padding(1, 0, 0, 0).
+		End()
+
+	status := b.Find("status").(*Static)
+	report := func(name string) func(Widget, Event, ...any) bool {
+		return func(_ Widget, _ Event, data ...any) bool {
+			status.Set(fmt.Sprintf("%s ratio = %.2f", name, data[0].(float64)))
+			return true
+		}
+	}
+	horizontal := b.Find("split-h").(*Splitter)
+	horizontal.SetMinSize(10)
+	horizontal.On(EvtChange, report("Horizontal"))
+	b.Find("split-v").On(EvtChange, report("Vertical"))
+}
+
+func boardDemo(b *Builder) {
+	b.VFlex("board-demo", Stretch, 1).Padding(1, 2).
+		Static("desc", "Move cards with Ctrl+arrows or drag them. In Progress takes at most 2 cards.").
+		Board("sprint").Hint(0, -1).
+		Static("status", "Enter or click a selected card to open it").
+		End()
+
+	status := b.Find("status").(*Static)
+	board := b.Find("sprint").(*Board)
+	todo := board.AddColumn("todo", "To Do")
+	todo.AddCard(&BoardCard{Title: "Design auth flow", Body: "OAuth2 vs sessions", Label: "$blue"})
+	todo.AddCard(&BoardCard{Title: "Write unit tests", Body: "Filter and Board", Label: "$orange", Tags: []string{"testing"}})
+	todo.AddCard(&BoardCard{Title: "Fix parser bug", Body: "Issue #142", Label: "$red", Tags: []string{"bug"}})
+	todo.AddCard(&BoardCard{Title: "Update docs", Body: "Reference pages for the new widgets"})
+	wip := board.AddColumn("wip", "In Progress")
+	wip.SetLimit(2)
+	wip.AddCard(&BoardCard{Title: "API sketch", Body: "Rough out the endpoints", Label: "$cyan"})
+	wip.AddCard(&BoardCard{Title: "Write tests", Body: "Board component", Label: "$cyan"})
+	done := board.AddColumn("done", "Done")
+	done.AddCard(&BoardCard{Title: "Auth flow", Body: "Completed", Label: "$green"})
+	done.AddCard(&BoardCard{Title: "DB schema", Body: "Migrated", Label: "$green"})
+
+	board.On(EvtMove, func(_ Widget, _ Event, data ...any) bool {
+		change := data[0].(BoardChange)
+		if change.ToColumn == wip && change.FromColumn != wip && wip.Len() >= 2 {
+			status.Set("In Progress is full, finish something first")
+			return true
+		}
+		return false
+	})
+	board.On(EvtChange, func(_ Widget, _ Event, data ...any) bool {
+		change := data[0].(BoardChange)
+		status.Set(fmt.Sprintf("Moved %q from %s[%d] to %s[%d]", change.Card.Title,
+			change.FromColumn.Title, change.FromIndex, change.ToColumn.Title, change.ToIndex))
+		return true
+	})
+	board.On(EvtActivate, func(_ Widget, _ Event, data ...any) bool {
+		status.Set("Opened " + data[0].(*BoardCard).Title)
+		return true
+	})
+}
+
+func timeSpinnerDemo(b *Builder) {
+	b.VFlex("time-spinner-demo", Start, 1).Padding(1, 2).
+		Static("desc", "←/→ switch between hour and minute, ↑/↓ or +/- change them, digits type a value.").
+		Padding(0, 0, 1, 0).
+		TimeSpinner("alarm", 7, 30).
+		Static("status", "Alarm at 07:30").Padding(1, 0, 0, 0).
+		End()
+
+	status := b.Find("status").(*Static)
+	b.Find("alarm").On(EvtChange, func(_ Widget, _ Event, data ...any) bool {
+		status.Set(fmt.Sprintf("Alarm at %02d:%02d", data[0], data[1]))
+		return true
+	})
+}
+
 func treeDemo(b *Builder) {
 	t := NewTree("tree", "")
 	root := NewTreeNode("zeichenwerk")
@@ -523,11 +523,17 @@ func logMonitorScreen(b *Builder) {
 		ticker = time.NewTicker(1200 * time.Millisecond)
 		go func() {
 			for range ticker.C {
-				if !paused {
-					if logText, ok := Find(container, "log-text").(*Text); ok {
-						logText.Add(generateLogLine())
-					}
+				root := FindRoot(container)
+				if root == nil {
+					continue
 				}
+				root.Post(func() {
+					if !paused {
+						if logText, ok := Find(container, "log-text").(*Text); ok {
+							logText.Add(generateLogLine())
+						}
+					}
+				})
 			}
 		}()
 		return true
@@ -1,6 +1,6 @@
 // Command zw scaffolds zeichenwerk projects.
 //
-// Two subcommands:
+// Subcommands:
 //
 //	zw init [--flat] [--theme=Name] [--name=binary]
 //	    Scaffold the current directory. Requires an existing go.mod;
@@ -11,6 +11,11 @@
 //	zw new <name> [--module=path] [--flat] [--theme=Name]
 //	    Convenience wrapper: mkdir, go mod init, then zw init.
 //
+//	zw theme lint [--aaa] [--min=ratio] [--ignore=patterns] <theme.json|name>...
+//	    Check theme files or built-in themes for low contrast, focus
+//	    states that look like normal states and undefined colour
+//	    variables. Exits with status 1 if any issue is found.
+//
 // Layouts:
 //
 //	default  cmd/<name>/main.go + internal/ui/{ui_gen,events}.go
@@ -44,6 +49,11 @@ func main() {
 			fmt.Fprintln(os.Stderr, "zw new:", err)
 			os.Exit(1)
 		}
+	case "theme":
+		if err := runTheme(os.Args[2:]); err != nil {
+			fmt.Fprintln(os.Stderr, "zw theme:", err)
+			os.Exit(1)
+		}
 	case "-h", "--help", "help":
 		usage(os.Stdout)
 	default:
@@ -54,11 +64,12 @@ func main() {
 }
 
 func usage(w *os.File) {
-	fmt.Fprintln(w, "zw — zeichenwerk project scaffolder")
+	fmt.Fprintln(w, "zw — zeichenwerk project scaffolder and theme linter")
 	fmt.Fprintln(w)
 	fmt.Fprintln(w, "Usage:")
 	fmt.Fprintln(w, "  zw init [--flat] [--theme=Name] [--name=binary] [--replace=path]")
 	fmt.Fprintln(w, "  zw new <name> [--module=path] [--flat] [--theme=Name] [--replace=path]")
+	fmt.Fprintln(w, "  zw theme lint [--aaa] [--min=ratio] [--ignore=patterns] <theme.json|name>...")
 }
 
 // ---- init -----------------------------------------------------------------
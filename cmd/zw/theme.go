@@ -0,0 +1,93 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+
+	. "github.com/tekugo/zeichenwerk/core"
+	"github.com/tekugo/zeichenwerk/themes"
+)
+
+// ---- theme ----------------------------------------------------------------
+
+func runTheme(args []string) error {
+	if len(args) == 0 || args[0] != "lint" {
+		return fmt.Errorf("expected subcommand lint")
+	}
+	return runLint(os.Stdout, args[1:])
+}
+
+// runLint checks theme files or built-in themes with themes.Lint and prints
+// one line per issue. It fails if any issue was found, so CI jobs can run it
+// directly.
+func runLint(w io.Writer, args []string) error {
+	fs := flag.NewFlagSet("theme lint", flag.ContinueOnError)
+	var (
+		aaa     bool
+		minimum float64
+		ignore  string
+	)
+	fs.BoolVar(&aaa, "aaa", false, "require WCAG AAA contrast (7:1) instead of AA (4.5:1)")
+	fs.Float64Var(&minimum, "min", 0, "minimum contrast ratio, overrides --aaa")
+	fs.StringVar(&ignore, "ignore", "", "comma-separated selector patterns to skip, e.g. 'bar-chart/*,terminal'")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() == 0 {
+		return fmt.Errorf("expected theme files or names (known: %s)", strings.Join(themes.Names(), ", "))
+	}
+	if minimum == 0 {
+		minimum = themes.ContrastAA
+		if aaa {
+			minimum = themes.ContrastAAA
+		}
+	}
+	var patterns []string
+	if ignore != "" {
+		patterns = strings.Split(ignore, ",")
+	}
+
+	count := 0
+	for _, source := range fs.Args() {
+		theme, err := loadLintTheme(source)
+		if err != nil {
+			return err
+		}
+		for _, issue := range themes.Lint(theme, minimum) {
+			if ignored(issue.Selector, patterns) {
+				continue
+			}
+			fmt.Fprintf(w, "%s: %s\n", source, issue)
+			count++
+		}
+	}
+	if count > 0 {
+		return fmt.Errorf("%d issues", count)
+	}
+	return nil
+}
+
+// loadLintTheme loads a theme file, or a registered theme if no file of
+// that name exists.
+func loadLintTheme(source string) (*Theme, error) {
+	if _, err := os.Stat(source); err != nil {
+		if theme, ok := themes.Named(source); ok {
+			return theme, nil
+		}
+	}
+	return themes.LoadThemeFile(source)
+}
+
+// ignored reports whether a selector matches one of the patterns.
+func ignored(selector string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(strings.TrimSpace(pattern), selector); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// lintTheme has one style below AA (#333333 on black, 1.66:1) and one that
+// passes AA but not AAA (#777777 on black, 4.69:1).
+const lintTheme = `{
+	"colors": {"$bg": "#000000", "$fg": "#ffffff"},
+	"styles": {
+		"": {"foreground": "$fg", "background": "$bg"},
+		"list": {"foreground": "#777777", "background": "$bg"},
+		"static.hint": {"foreground": "#333333"}
+	}
+}`
+
+func TestRunLint(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "theme.json")
+	if err := os.WriteFile(file, []byte(lintTheme), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		args []string
+		want []string // selectors reported, in order
+		err  string   // expected error, "" for success
+	}{
+		{"aa", []string{file}, []string{"static.hint"}, "1 issues"},
+		{"aaa", []string{"--aaa", file}, []string{"list", "static.hint"}, "2 issues"},
+		{"min", []string{"--min=1.5", file}, nil, ""},
+		{"min overrides aaa", []string{"--aaa", "--min=1.5", file}, nil, ""},
+		{"min above aaa", []string{"--min=10", file}, []string{"list", "static.hint"}, "2 issues"},
+		{"ignore", []string{"--ignore=static.*", file}, nil, ""},
+		{"ignore list", []string{"--aaa", "--ignore=list, static.hint", file}, nil, ""},
+		{"ignore other", []string{"--ignore=list", file}, []string{"static.hint"}, "1 issues"},
+		{"named", []string{"--min=1", "--ignore=terminal", "tokyo-night"}, nil, ""},
+		{"no sources", []string{"--aaa"}, nil, "expected theme files or names"},
+		{"unknown", []string{"no-such-theme"}, nil, "no-such-theme"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out strings.Builder
+			err := runLint(&out, tt.args)
+			switch {
+			case tt.err == "" && err != nil:
+				t.Fatalf("runLint() = %v; want success", err)
+			case tt.err != "" && (err == nil || !strings.Contains(err.Error(), tt.err)):
+				t.Fatalf("runLint() = %v; want an error containing %q", err, tt.err)
+			}
+
+			var got []string
+			for line := range strings.Lines(out.String()) {
+				_, issue, _ := strings.Cut(line, ": ")
+				selector, _, _ := strings.Cut(strings.TrimPrefix(issue, `"`), `"`)
+				got = append(got, selector)
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("reported %q; want %q\n%s", got, tt.want, out.String())
+			}
+		})
+	}
+}
+
+func TestIgnored(t *testing.T) {
+	patterns := []string{"bar-chart/*", " terminal "}
+	tests := []struct {
+		selector string
+		want     bool
+	}{
+		{"bar-chart/bar", true},
+		{"bar-chart", false},
+		{"terminal", true},
+		{"terminal:focused", false},
+		{"list", false},
+	}
+	for _, tt := range tests {
+		if got := ignored(tt.selector, patterns); got != tt.want {
+			t.Errorf("ignored(%q) = %v; want %v", tt.selector, got, tt.want)
+		}
+	}
+	if ignored("list", nil) {
+		t.Error("ignored without patterns")
+	}
+}
@@ -184,8 +184,8 @@ func (p *commandsPanel) Render(r *Renderer) {
 
 			// Name — truncated to leave room for the shortcut
 			shortcutW := 0
-			if item.cmd.Shortcut != "" {
-				shortcutW = utf8.RuneCountInString(item.cmd.Shortcut)
+			if item.shortcut != "" {
+				shortcutW = utf8.RuneCountInString(item.shortcut)
 			}
 			nameW := cw - 2
 			if shortcutW > 0 {
@@ -197,9 +197,9 @@ func (p *commandsPanel) Render(r *Renderer) {
 			r.Text(cx+2, y, item.cmd.Name, nameW)
 
 			// Shortcut — right-aligned in its own style
-			if item.cmd.Shortcut != "" && shortcutW > 0 {
+			if item.shortcut != "" && shortcutW > 0 {
 				r.Set(shortStyle.Foreground(), shortStyle.Background(), shortStyle.Font())
-				r.Text(cx+cw-shortcutW, y, item.cmd.Shortcut, shortcutW)
+				r.Text(cx+cw-shortcutW, y, item.shortcut, shortcutW)
 			}
 		}
 	}
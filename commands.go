@@ -14,11 +14,17 @@ import (
 
 // Command represents a named action that can be triggered from the commands
 // palette.
+//
+// When the registry belongs to a UI, every command is also a keymap action
+// named by Binding, and Shortcut is bound to it in the UI's keymap. The
+// palette then shows the keys currently bound to the action, so rebinding
+// through UI.Keymap is reflected immediately.
 type Command struct {
 	Name     string // display label; used for fuzzy matching
-	Shortcut string // hint string shown on the right ("Ctrl+O", ""); display only
+	Shortcut string // key sequence bound on registration ("Ctrl+O", ""); shown when there is no keymap
 	Group    string // optional section name; empty = ungrouped
 	Action   func() // executed when the command is confirmed
+	Binding  string // keymap action name ("command." + Name); empty without a UI
 }
 
 // rankedCommand is a display-list entry produced by filterCommands.
@@ -26,6 +32,7 @@ type Command struct {
 // (cmd.Name holds the group label).
 type rankedCommand struct {
 	cmd      *Command
+	shortcut string // shortcut text displayed on the right
 	score    int
 	isHeader bool
 }
@@ -71,9 +78,26 @@ func (c *Commands) IsOpen() bool {
 // *Command for chaining or later removal. group may be empty. Commands with the
 // same group string appear under a shared header in the palette. name must be
 // non-empty; shortcut and action may be empty/nil.
+//
+// If the registry belongs to a UI, the command is registered as the keymap
+// action "command." + name and shortcut is bound to it in the UI's keymap.
+// An unparsable shortcut is logged and left unbound.
 func (c *Commands) Register(group, name, shortcut string, action func()) *Command {
 	cmd := &Command{Name: name, Shortcut: shortcut, Group: group, Action: action}
 	c.entries = append(c.entries, cmd)
+	if c.ui != nil {
+		cmd.Binding = "command." + name
+		c.ui.SetAction(cmd.Binding, func() {
+			if cmd.Action != nil {
+				cmd.Action()
+			}
+		})
+		if shortcut != "" {
+			if err := c.ui.Keymap().Bind(shortcut, cmd.Binding); err != nil {
+				c.ui.Log(c.ui, core.Warning, "Command shortcut not bound", "command", name, "error", err)
+			}
+		}
+	}
 	return cmd
 }
 
@@ -94,6 +118,8 @@ func (c *Commands) SetWidth(n int) {
 
 // Unregister removes the first command with the matching name (exact,
 // case-sensitive). Returns true if found. No-op while the palette is open.
+// The command's keymap action and its key bindings are removed as well,
+// unless another command with the same name is still registered.
 func (c *Commands) Unregister(name string) bool {
 	if c.open {
 		return false
@@ -101,12 +127,28 @@ func (c *Commands) Unregister(name string) bool {
 	for i, cmd := range c.entries {
 		if cmd.Name == name {
 			c.entries = append(c.entries[:i], c.entries[i+1:]...)
+			if c.ui != nil && cmd.Binding != "" && !slices.ContainsFunc(c.entries, func(other *Command) bool {
+				return other.Binding == cmd.Binding
+			}) {
+				c.ui.SetAction(cmd.Binding, nil)
+				c.ui.Keymap().UnbindAction(cmd.Binding)
+			}
 			return true
 		}
 	}
 	return false
 }
 
+// shortcut returns the shortcut text shown for cmd in the palette: the key
+// sequences currently bound to the command in the UI's keymap, or the
+// registered Shortcut string when there is no UI.
+func (c *Commands) shortcut(cmd *Command) string {
+	if c.ui == nil || cmd.Binding == "" {
+		return cmd.Shortcut
+	}
+	return strings.Join(c.ui.Keymap().Keys(cmd.Binding), ", ")
+}
+
 // ---- Display --------------------------------------------------------------
 
 // Open displays the commands palette. No-op if already open.
@@ -235,7 +277,7 @@ func (c *Commands) computePopupSize(items []rankedCommand) (w, h int) {
 		if n := utf8.RuneCountInString(cmd.Name); n > nameW {
 			nameW = n
 		}
-		if s := utf8.RuneCountInString(cmd.Shortcut); s > shortcutW {
+		if s := utf8.RuneCountInString(c.shortcut(cmd)); s > shortcutW {
 			shortcutW = s
 		}
 	}
@@ -342,7 +384,7 @@ func (c *Commands) filterCommands(query string) []rankedCommand {
 		}
 		out := make([]rankedCommand, len(results))
 		for i, s := range results {
-			out[i] = rankedCommand{cmd: s.cmd, score: s.score}
+			out[i] = rankedCommand{cmd: s.cmd, shortcut: c.shortcut(s.cmd), score: s.score}
 		}
 		return out
 	}
@@ -396,7 +438,7 @@ func (c *Commands) filterCommands(query string) []rankedCommand {
 			})
 		}
 		for _, s := range items {
-			out = append(out, rankedCommand{cmd: s.cmd, score: s.score})
+			out = append(out, rankedCommand{cmd: s.cmd, shortcut: c.shortcut(s.cmd), score: s.score})
 		}
 	}
 	return out
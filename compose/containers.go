@@ -118,6 +118,27 @@ func HFlex(id, class string, alignment core.Alignment, spacing int, options ...O
 	}
 }
 
+// Responsive adds a container to the parent that shows one of its children,
+// the variants, depending on its size. Wrap variants in [Breakpoint] to set
+// the size from which they are used; a variant without one is the fallback.
+//
+//	Responsive("main", "",
+//	    VFlex("narrow", "", core.Stretch, 0, …),
+//	    Breakpoint(120, 0, HFlex("wide", "", core.Stretch, 1, …)),
+//	)
+func Responsive(id, class string, options ...Option) Option {
+	return func(theme *core.Theme, widget core.Widget) {
+		if container, ok := widget.(core.Container); ok {
+			w := widgets.NewResponsive(id, class)
+			w.Apply(theme)
+			container.Add(w)
+			for _, option := range options {
+				option(theme, w)
+			}
+		}
+	}
+}
+
 // Switcher adds a multi-pane container that shows one child at a time to the
 // parent. Call Select on the retrieved [zeichenwerk.Switcher] to change the
 // active pane. Pair with [Tabs] for a tabbed-panel layout.
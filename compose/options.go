@@ -29,6 +29,47 @@ func Cell(x, y, width, height int, option Option) Option {
 	}
 }
 
+// Breakpoint wraps a single widget option as a variant of a [Responsive]
+// container that is shown from the given content width and height on.
+//
+//	Responsive("main", "",
+//	    Static("narrow", "", "…"),
+//	    Breakpoint(120, 0, Static("wide", "", "…")),
+//	)
+func Breakpoint(minWidth, minHeight int, option Option) Option {
+	return func(theme *core.Theme, widget core.Widget) {
+		if container, ok := widget.(core.Container); ok {
+			dummy := widgets.NewBox("__breakpoint__", "", "")
+			option(theme, dummy)
+			children := dummy.Children()
+			if len(children) > 0 {
+				container.Add(children[0], widgets.Breakpoint{MinWidth: minWidth, MinHeight: minHeight})
+			}
+		}
+	}
+}
+
+// Item wraps a single widget option for a [HFlex] or [VFlex] container and
+// sets its layout parameters: basis, minimum and maximum size, grow and
+// shrink factors.
+//
+//	HFlex("row", "", core.Stretch, 1,
+//	    Item(widgets.FlexItem{Min: 20, Shrink: 1}, List("nav", "", nil)),
+//	    Item(widgets.FlexItem{Grow: 1}, Static("content", "", "…")),
+//	)
+func Item(item widgets.FlexItem, option Option) Option {
+	return func(theme *core.Theme, widget core.Widget) {
+		if container, ok := widget.(core.Container); ok {
+			dummy := widgets.NewBox("__item__", "", "")
+			option(theme, dummy)
+			children := dummy.Children()
+			if len(children) > 0 {
+				container.Add(children[0], item)
+			}
+		}
+	}
+}
+
 // Hint sets the size hint of the widget. A value of -1 means "fill remaining
 // space"; 0 means "auto-size"; positive values are fixed sizes in cells.
 // Hint is typically applied directly to container options or to a [Spacer]:
@@ -25,6 +25,21 @@ func BarChart(id, class string, options ...Option) Option {
 	}
 }
 
+// Board creates a Kanban board. Add columns and cards via [zeichenwerk.Find]
+// after construction.
+func Board(id, class string, options ...Option) Option {
+	return func(theme *core.Theme, widget core.Widget) {
+		if container, ok := widget.(core.Container); ok {
+			w := widgets.NewBoard(id, class)
+			w.Apply(theme)
+			container.Add(w)
+			for _, option := range options {
+				option(theme, w)
+			}
+		}
+	}
+}
+
 // Breadcrumb creates a Breadcrumb path-indicator widget. Configure segments
 // and display options via [zeichenwerk.Find] after construction.
 func Breadcrumb(id, class string, options ...Option) Option {
@@ -71,6 +86,21 @@ func Canvas(id, class string, pages, width, height int, options ...Option) Optio
 	}
 }
 
+// Calendar adds a month grid for picking a date to the parent. It shows the
+// current month with today selected.
+func Calendar(id, class string, options ...Option) Option {
+	return func(theme *core.Theme, widget core.Widget) {
+		if container, ok := widget.(core.Container); ok {
+			w := widgets.NewCalendar(id, class)
+			w.Apply(theme)
+			container.Add(w)
+			for _, option := range options {
+				option(theme, w)
+			}
+		}
+	}
+}
+
 // Checkbox adds a toggleable checkbox widget to the parent. checked sets the
 // initial state.
 func Checkbox(id, class, text string, checked bool, options ...Option) Option {
@@ -198,6 +228,22 @@ func Custom(id, class string, fn func(core.Widget, *core.Renderer), options ...O
 	}
 }
 
+// DatePicker adds a date input that opens a calendar popup to the parent.
+// placeholder is shown while no date is set.
+func DatePicker(id, class, placeholder string, options ...Option) Option {
+	return func(theme *core.Theme, widget core.Widget) {
+		if container, ok := widget.(core.Container); ok {
+			w := widgets.NewDatePicker(id, class)
+			w.SetPlaceholder(placeholder)
+			w.Apply(theme)
+			container.Add(w)
+			for _, option := range options {
+				option(theme, w)
+			}
+		}
+	}
+}
+
 // Deck adds a virtualised list widget to the parent that renders each item
 // with a custom render function. render is called for every visible item;
 // itemHeight is the fixed height of each item in cells. Populate the deck
@@ -378,6 +424,22 @@ func Input(id, class string, params []string, options ...Option) Option {
 	}
 }
 
+// LineChart creates a LineChart widget for displaying multi-series line and
+// area charts. Configure series, the x-axis and display options via
+// [zeichenwerk.Find] after construction.
+func LineChart(id, class string, options ...Option) Option {
+	return func(theme *core.Theme, widget core.Widget) {
+		if container, ok := widget.(core.Container); ok {
+			w := widgets.NewLineChart(id, class)
+			w.Apply(theme)
+			container.Add(w)
+			for _, option := range options {
+				option(theme, w)
+			}
+		}
+	}
+}
+
 // List adds a scrollable list widget to the parent. items is the initial set
 // of display strings.
 func List(id, class string, items []string, options ...Option) Option {
@@ -408,6 +470,22 @@ func Marquee(id, class string, options ...Option) Option {
 	}
 }
 
+// Notification adds a notification with a level icon, title and body text
+// to the parent. Clicking its close button dismisses it and dispatches
+// EvtHide. Use UI.Notify for notifications stacked on top of the screen.
+func Notification(id, class string, level core.Level, title, text string, options ...Option) Option {
+	return func(theme *core.Theme, widget core.Widget) {
+		if container, ok := widget.(core.Container); ok {
+			w := widgets.NewNotification(id, class).SetLevel(level).SetTitle(title).SetText(text)
+			w.Apply(theme)
+			container.Add(w)
+			for _, option := range options {
+				option(theme, w)
+			}
+		}
+	}
+}
+
 // PreviewPanel adds a fg/bg preview swatch with a WCAG contrast ratio readout
 // to the parent. The panel does not emit events and is normally driven by a
 // surrounding [ColorPicker].
@@ -567,6 +645,21 @@ func Sparkline(id, class string, options ...Option) Option {
 	}
 }
 
+// Splitter adds a draggable handle between the previous and the next child
+// of a Flex. Dragging it or pressing the arrow keys resizes both neighbours.
+func Splitter(id, class string, options ...Option) Option {
+	return func(theme *core.Theme, widget core.Widget) {
+		if container, ok := widget.(core.Container); ok {
+			w := widgets.NewSplitter(id, class)
+			w.Apply(theme)
+			container.Add(w)
+			for _, option := range options {
+				option(theme, w)
+			}
+		}
+	}
+}
+
 // Spinner adds an animated spinner widget to the parent. sequence is the
 // animation frame string; use one of the entries in [zeichenwerk.Spinners]
 // for a built-in sequence. Start and stop the animation imperatively:
@@ -700,6 +793,22 @@ func Tiles(id, class string, render widgets.ItemRender, tileWidth, tileHeight in
 	}
 }
 
+// TimeSpinner adds a compact "HH:MM" input for a time of day to the
+// parent.
+func TimeSpinner(id, class string, hour, minute int, options ...Option) Option {
+	return func(theme *core.Theme, widget core.Widget) {
+		if container, ok := widget.(core.Container); ok {
+			w := widgets.NewTimeSpinner(id, class)
+			w.SetTime(hour, minute)
+			w.Apply(theme)
+			container.Add(w)
+			for _, option := range options {
+				option(theme, w)
+			}
+		}
+	}
+}
+
 // Tree adds a hierarchical tree widget to the parent. Populate the tree and
 // expand nodes imperatively after construction via [zeichenwerk.Find]:
 //
@@ -735,6 +844,22 @@ func TreeFS(id, class, root string, dirsOnly bool, options ...Option) Option {
 	}
 }
 
+// TreeWidgets adds a tree mirroring the widget hierarchy below root to the
+// parent. As with the Builder, the embedded Tree is added, so Find returns a
+// *Tree for the id.
+func TreeWidgets(id, class string, root core.Widget, options ...Option) Option {
+	return func(theme *core.Theme, widget core.Widget) {
+		if container, ok := widget.(core.Container); ok {
+			w := widgets.NewTreeWidgets(id, class, root)
+			w.Apply(theme)
+			container.Add(w.Tree)
+			for _, option := range options {
+				option(theme, w.Tree)
+			}
+		}
+	}
+}
+
 // Typeahead adds a text input with autocomplete suggestions to the parent.
 // params is passed directly to the underlying constructor; the first element
 // is typically used as placeholder text.
@@ -12,33 +12,33 @@ import "unicode/utf8"
 // renderer rather than constructed ad hoc at render time.
 type Border struct {
 	// Outer border elements — the perimeter strokes of the widget.
-	Top    string // horizontal segment along the top edge
-	Right  string // vertical segment along the right edge
-	Bottom string // horizontal segment along the bottom edge
-	Left   string // vertical segment along the left edge
+	Top    string `json:"top,omitempty"`    // horizontal segment along the top edge
+	Right  string `json:"right,omitempty"`  // vertical segment along the right edge
+	Bottom string `json:"bottom,omitempty"` // horizontal segment along the bottom edge
+	Left   string `json:"left,omitempty"`   // vertical segment along the left edge
 
 	// Corner elements — connect the perpendicular edges at the four corners.
-	TopLeft     string // top-left corner glyph
-	TopRight    string // top-right corner glyph
-	BottomRight string // bottom-right corner glyph
-	BottomLeft  string // bottom-left corner glyph
+	TopLeft     string `json:"topLeft,omitempty"`     // top-left corner glyph
+	TopRight    string `json:"topRight,omitempty"`    // top-right corner glyph
+	BottomRight string `json:"bottomRight,omitempty"` // bottom-right corner glyph
+	BottomLeft  string `json:"bottomLeft,omitempty"`  // bottom-left corner glyph
 
 	// Outer T-connectors — used where an inner grid line meets an outer edge.
-	TopT    string // inner vertical meeting the top edge
-	RightT  string // inner horizontal meeting the right edge
-	BottomT string // inner vertical meeting the bottom edge
-	LeftT   string // inner horizontal meeting the left edge
+	TopT    string `json:"topT,omitempty"`    // inner vertical meeting the top edge
+	RightT  string `json:"rightT,omitempty"`  // inner horizontal meeting the right edge
+	BottomT string `json:"bottomT,omitempty"` // inner vertical meeting the bottom edge
+	LeftT   string `json:"leftT,omitempty"`   // inner horizontal meeting the left edge
 
 	// Inner grid elements — subdivide the widget into cells (for tables etc.).
-	InnerH string // horizontal grid line
-	InnerV string // vertical grid line
-	InnerX string // cross where two inner grid lines meet
+	InnerH string `json:"innerH,omitempty"` // horizontal grid line
+	InnerV string `json:"innerV,omitempty"` // vertical grid line
+	InnerX string `json:"innerX,omitempty"` // cross where two inner grid lines meet
 
 	// Inner T-connectors — used where three inner grid lines meet.
-	InnerTopT    string // inner T opening downwards
-	InnerRightT  string // inner T opening leftwards
-	InnerBottomT string // inner T opening upwards
-	InnerLeftT   string // inner T opening rightwards
+	InnerTopT    string `json:"innerTopT,omitempty"`    // inner T opening downwards
+	InnerRightT  string `json:"innerRightT,omitempty"`  // inner T opening leftwards
+	InnerBottomT string `json:"innerBottomT,omitempty"` // inner T opening upwards
+	InnerLeftT   string `json:"innerLeftT,omitempty"`   // inner T opening rightwards
 }
 
 // Horizontal returns the number of terminal cells consumed by the left and
@@ -0,0 +1,247 @@
+package core
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ColorProfile describes the colours a terminal can display. Profiles are
+// ordered from the most to the least capable, so a theme colour falls back
+// from ProfileTrueColor through Profile256 and Profile16 to ProfileMonochrome.
+type ColorProfile int
+
+const (
+	ProfileTrueColor  ColorProfile = iota // 24-bit colours, used as given
+	Profile256                            // xterm 256-colour palette
+	Profile16                             // The 16 ANSI colours
+	ProfileMonochrome                     // No colours, only font attributes
+)
+
+// profileNames are the names used by String and ParseColorProfile.
+var profileNames = []string{"truecolor", "256", "16", "mono"}
+
+// String returns the profile name: truecolor, 256, 16 or mono.
+func (p ColorProfile) String() string {
+	if p < ProfileTrueColor || p > ProfileMonochrome {
+		return fmt.Sprintf("ColorProfile(%d)", int(p))
+	}
+	return profileNames[p]
+}
+
+// ParseColorProfile returns the profile with the given name, as returned by
+// String. The second result is false for unknown names.
+func ParseColorProfile(name string) (ColorProfile, bool) {
+	i := slices.Index(profileNames, strings.ToLower(name))
+	return ColorProfile(max(i, 0)), i >= 0
+}
+
+// DetectColorProfile determines the profile of the terminal from the number
+// of colours it reports and the environment:
+//
+//   - NO_COLOR set to a non-empty value selects ProfileMonochrome (see
+//     no-color.org).
+//   - COLORTERM set to truecolor or 24bit selects ProfileTrueColor, as many
+//     terminals support it without announcing it in terminfo.
+//   - Otherwise, the number of colours decides.
+func DetectColorProfile(colors int) ColorProfile {
+	if os.Getenv("NO_COLOR") != "" {
+		return ProfileMonochrome
+	}
+	switch term := strings.ToLower(os.Getenv("COLORTERM")); {
+	case colors > 0 && (term == "truecolor" || term == "24bit"):
+		return ProfileTrueColor
+	case colors >= 1<<24:
+		return ProfileTrueColor
+	case colors >= 256:
+		return Profile256
+	case colors >= 8:
+		return Profile16
+	}
+	return ProfileMonochrome
+}
+
+// ---- Palette --------------------------------------------------------------
+
+// paletteNames are the names of the 16 ANSI colours, as understood by the
+// renderer.
+var paletteNames = [16]string{
+	"black", "maroon", "green", "olive", "navy", "purple", "teal", "silver",
+	"gray", "red", "lime", "yellow", "blue", "fuchsia", "aqua", "white",
+}
+
+// ansiColors are the xterm defaults of the 16 ANSI colours as 0xrrggbb.
+var ansiColors = [16]uint32{
+	0x000000, 0x800000, 0x008000, 0x808000, 0x000080, 0x800080, 0x008080, 0xc0c0c0,
+	0x808080, 0xff0000, 0x00ff00, 0xffff00, 0x0000ff, 0xff00ff, 0x00ffff, 0xffffff,
+}
+
+// PaletteName returns the name the renderer uses for a colour of the
+// 256-colour palette: one of the 16 ANSI names like "navy" for the first 16,
+// "color16" to "color255" for the rest.
+func PaletteName(index int) string {
+	if index >= 0 && index < 16 {
+		return paletteNames[index]
+	}
+	return "color" + strconv.Itoa(index)
+}
+
+// PaletteIndex returns the palette index of a colour name returned by
+// PaletteName. The second result is false for other colours.
+func PaletteIndex(name string) (int, bool) {
+	if i := slices.Index(paletteNames[:], strings.ToLower(name)); i >= 0 {
+		return i, true
+	}
+	if n, ok := strings.CutPrefix(name, "color"); ok {
+		if i, err := strconv.Atoi(n); err == nil && i >= 0 && i < 256 {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// PaletteRGB returns the colour of a palette index as defined by xterm. The
+// first 16 colours are commonly changed by terminal themes, so they are only
+// an approximation.
+func PaletteRGB(index int) (r, g, b uint8) {
+	switch {
+	case index < 0 || index > 255:
+		return 0, 0, 0
+	case index < 16:
+		c := ansiColors[index]
+		return uint8(c >> 16), uint8(c >> 8), uint8(c)
+	case index < 232:
+		level := func(n int) uint8 {
+			if n == 0 {
+				return 0
+			}
+			return uint8(55 + 40*n)
+		}
+		index -= 16
+		return level(index / 36), level(index / 6 % 6), level(index % 6)
+	default:
+		v := uint8(8 + 10*(index-232))
+		return v, v, v
+	}
+}
+
+// ColorRGB returns the components of a literal colour: a hex value in #rgb
+// or #rrggbb form or a palette colour name. The last result is false for
+// other colours, including theme variables.
+func ColorRGB(color string) (r, g, b uint8, ok bool) {
+	if strings.HasPrefix(color, "#") {
+		return ParseHexColor(strings.ToLower(color))
+	}
+	if index, ok := PaletteIndex(color); ok {
+		r, g, b = PaletteRGB(index)
+		return r, g, b, true
+	}
+	return 0, 0, 0, false
+}
+
+// Downsample returns the palette colour closest to a literal colour for a
+// profile. Colours are returned unchanged for ProfileTrueColor, and if they
+// are not hex or palette colours. For ProfileMonochrome, the result is
+// empty, which leaves the terminal's default colour.
+//
+// Profile256 picks from the colour cube and grey ramp of the palette
+// (16-255), whose colours do not depend on the terminal theme; Profile16
+// from the 16 ANSI colours.
+func Downsample(color string, profile ColorProfile) string {
+	switch profile {
+	case ProfileTrueColor:
+		return color
+	case ProfileMonochrome:
+		return ""
+	}
+	r, g, b, ok := ColorRGB(color)
+	if !ok {
+		return color
+	}
+	if index, ok := PaletteIndex(color); ok && index < paletteSize(profile) {
+		return color
+	}
+	best, dist := 0, math.Inf(1)
+	for _, i := range paletteRange(profile) {
+		pr, pg, pb := PaletteRGB(i)
+		if d := distance(r, g, b, pr, pg, pb); d < dist {
+			best, dist = i, d
+		}
+	}
+	return PaletteName(best)
+}
+
+// paletteSize returns the number of palette colours of a profile.
+func paletteSize(profile ColorProfile) int {
+	if profile == Profile16 {
+		return 16
+	}
+	return 256
+}
+
+// paletteRange returns the indices Downsample chooses from for a profile.
+func paletteRange(profile ColorProfile) []int {
+	first, last := 16, 256
+	if profile == Profile16 {
+		first, last = 0, 16
+	}
+	indices := make([]int, 0, last-first)
+	for i := first; i < last; i++ {
+		indices = append(indices, i)
+	}
+	return indices
+}
+
+// distance returns the perceptual distance between two colours using the
+// "redmean" approximation, which weighs the channels by the red level.
+func distance(r1, g1, b1, r2, g2, b2 uint8) float64 {
+	rm := (float64(r1) + float64(r2)) / 2
+	dr := float64(r1) - float64(r2)
+	dg := float64(g1) - float64(g2)
+	db := float64(b1) - float64(b2)
+	return math.Sqrt((2+rm/256)*dr*dr + 4*dg*dg + (2+(255-rm)/256)*db*db)
+}
+
+// Legible downsamples a foreground colour for a profile so that text stays
+// readable. fg and bg are the original colours, shown is the background as
+// it is displayed with the profile. If the nearest palette colour to fg has
+// less contrast with shown than fg had with bg, capped at the WCAG AA level
+// of 4.5, the closest palette colour that reaches it is used instead, or the
+// one with the most contrast if none does. Colours that are not hex or
+// palette colours are downsampled without a check.
+func Legible(fg, bg, shown string, profile ColorProfile) string {
+	down := Downsample(fg, profile)
+	if profile == ProfileTrueColor || profile == ProfileMonochrome {
+		return down
+	}
+	r1, g1, b1, ok1 := ColorRGB(fg)
+	r2, g2, b2, ok2 := ColorRGB(bg)
+	sr, sg, sb, ok3 := ColorRGB(shown)
+	dr, dg, db, ok4 := ColorRGB(down)
+	if !ok1 || !ok2 || !ok3 || !ok4 {
+		return down
+	}
+	target := math.Min(ContrastRatio(r1, g1, b1, r2, g2, b2), 4.5)
+	if ContrastRatio(dr, dg, db, sr, sg, sb) >= target {
+		return down
+	}
+
+	best, dist, most, ratio := -1, math.Inf(1), 0, 0.0
+	for _, i := range paletteRange(profile) {
+		pr, pg, pb := PaletteRGB(i)
+		c := ContrastRatio(pr, pg, pb, sr, sg, sb)
+		if c > ratio {
+			most, ratio = i, c
+		}
+		if d := distance(r1, g1, b1, pr, pg, pb); c >= target && d < dist {
+			best, dist = i, d
+		}
+	}
+	if best < 0 {
+		best = most
+	}
+	return PaletteName(best)
+}
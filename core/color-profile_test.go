@@ -0,0 +1,122 @@
+package core
+
+import "testing"
+
+// ── ColorProfile ─────────────────────────────────────────────────────────────
+
+func TestColorProfile_Names(t *testing.T) {
+	for p := ProfileTrueColor; p <= ProfileMonochrome; p++ {
+		got, ok := ParseColorProfile(p.String())
+		if !ok || got != p {
+			t.Errorf("ParseColorProfile(%q) = %v, %v; want %v", p.String(), got, ok, p)
+		}
+	}
+	if _, ok := ParseColorProfile("8"); ok {
+		t.Error("ParseColorProfile(\"8\") should fail")
+	}
+}
+
+func TestDetectColorProfile(t *testing.T) {
+	cases := []struct {
+		noColor, colorTerm string
+		colors             int
+		want               ColorProfile
+	}{
+		{"", "", 1 << 24, ProfileTrueColor},
+		{"", "truecolor", 256, ProfileTrueColor},
+		{"", "24bit", 8, ProfileTrueColor},
+		{"", "", 256, Profile256},
+		{"", "", 16, Profile16},
+		{"", "", 8, Profile16},
+		{"", "", 0, ProfileMonochrome},
+		{"", "truecolor", 0, ProfileMonochrome},
+		{"1", "truecolor", 1 << 24, ProfileMonochrome},
+	}
+	for _, tc := range cases {
+		t.Setenv("NO_COLOR", tc.noColor)
+		t.Setenv("COLORTERM", tc.colorTerm)
+		if got := DetectColorProfile(tc.colors); got != tc.want {
+			t.Errorf("NO_COLOR=%q COLORTERM=%q colors=%d: got %v; want %v",
+				tc.noColor, tc.colorTerm, tc.colors, got, tc.want)
+		}
+	}
+}
+
+// ── Palette ──────────────────────────────────────────────────────────────────
+
+func TestPaletteRGB(t *testing.T) {
+	cases := []struct {
+		index   int
+		r, g, b uint8
+	}{
+		{1, 0x80, 0, 0},
+		{12, 0, 0, 0xff},
+		{16, 0, 0, 0},
+		{21, 0, 0, 0xff},
+		{111, 0x87, 0xaf, 0xff},
+		{231, 0xff, 0xff, 0xff},
+		{232, 8, 8, 8},
+		{255, 0xee, 0xee, 0xee},
+	}
+	for _, tc := range cases {
+		r, g, b := PaletteRGB(tc.index)
+		if r != tc.r || g != tc.g || b != tc.b {
+			t.Errorf("PaletteRGB(%d) = (%d,%d,%d); want (%d,%d,%d)", tc.index, r, g, b, tc.r, tc.g, tc.b)
+		}
+	}
+}
+
+func TestPaletteIndex_RoundTrip(t *testing.T) {
+	for i := range 256 {
+		if got, ok := PaletteIndex(PaletteName(i)); !ok || got != i {
+			t.Errorf("PaletteIndex(%q) = %d, %v; want %d", PaletteName(i), got, ok, i)
+		}
+	}
+	for _, name := range []string{"color256", "color-1", "colour1", "#ff0000"} {
+		if _, ok := PaletteIndex(name); ok {
+			t.Errorf("PaletteIndex(%q) should fail", name)
+		}
+	}
+}
+
+// ── Downsample ───────────────────────────────────────────────────────────────
+
+func TestDownsample(t *testing.T) {
+	cases := []struct {
+		in      string
+		profile ColorProfile
+		want    string
+	}{
+		{"#7aa2f7", ProfileTrueColor, "#7aa2f7"},
+		{"#7aa2f7", Profile256, "color111"},
+		{"#ff0000", Profile256, "color196"},
+		{"#ff0000", Profile16, "red"},
+		{"color196", Profile16, "red"},
+		{"navy", Profile16, "navy"},
+		{"color111", Profile256, "color111"},
+		{"lightblue", Profile16, "lightblue"},
+		{"#7aa2f7", ProfileMonochrome, ""},
+	}
+	for _, tc := range cases {
+		if got := Downsample(tc.in, tc.profile); got != tc.want {
+			t.Errorf("Downsample(%q, %v) = %q; want %q", tc.in, tc.profile, got, tc.want)
+		}
+	}
+}
+
+func TestLegible_KeepsContrast(t *testing.T) {
+	// Both greys map to "gray" with 16 colours; the text must stay visible.
+	fg, bg := "#808080", "#767676"
+	shown := Downsample(bg, Profile16)
+	if Downsample(fg, Profile16) != shown {
+		t.Fatalf("test colours no longer collide: %q, %q", Downsample(fg, Profile16), shown)
+	}
+	got := Legible(fg, bg, shown, Profile16)
+	if got == shown {
+		t.Errorf("Legible(%q, %q) = %q; want a colour other than the background", fg, bg, got)
+	}
+	// Colours that keep their contrast are only downsampled.
+	if got := Legible("#7aa2f7", "#1a1b26", "color234", Profile256); got != "color111" {
+		t.Errorf("Legible = %q; want the nearest colour color111", got)
+	}
+}
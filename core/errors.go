@@ -37,4 +37,40 @@ var (
 	// Collapsible, Dialog, Card, Viewport) that already holds its single
 	// child and was asked to insert another at a non-replacing index.
 	ErrFull *MessageCode = NewErrorCode("container-full", "Container is full")
+
+	// ErrInvalidKey is returned when a key binding such as "Ctrl-X Ctrl-S"
+	// cannot be parsed, for example because of an unknown modifier or key
+	// name. The wrapped message names the offending chord.
+	ErrInvalidKey *MessageCode = NewErrorCode("invalid-key", "Invalid key binding")
+
+	// ErrInvalidPattern is returned when a search query in regular
+	// expression mode does not compile. The wrapped message carries the
+	// parser's explanation.
+	ErrInvalidPattern *MessageCode = NewErrorCode("invalid-pattern", "Invalid search pattern")
+
+	// ErrPty is returned by Terminal.Start when no pseudo-terminal can be
+	// allocated or configured, including on platforms without pty support.
+	// The wrapped error carries the underlying system error.
+	ErrPty *MessageCode = NewErrorCode("pty", "Pseudo-terminal unavailable")
+
+	// ErrRunning is returned when starting something that is already
+	// running, for example a second process in the same Terminal.
+	ErrRunning *MessageCode = NewErrorCode("running", "Already running")
+
+	// ErrOutOfRange is returned when a row, column or other index does not
+	// address an existing element, for example when setting a table cell
+	// that does not exist.
+	ErrOutOfRange *MessageCode = NewErrorCode("out-of-range", "Index out of range")
+
+	// ErrInvalidDocument is returned when a UI document cannot be decoded,
+	// for example because of a syntax error, an unknown widget kind or a
+	// field value of the wrong type. The wrapped message names the
+	// offending node.
+	ErrInvalidDocument *MessageCode = NewErrorCode("invalid-document", "Invalid UI document")
+
+	// ErrInvalidTheme is returned when a theme file cannot be loaded, for
+	// example because of a syntax error, an unknown base theme or a style
+	// referring to an undefined colour variable. The wrapped message names
+	// the offending selector or entry.
+	ErrInvalidTheme *MessageCode = NewErrorCode("invalid-theme", "Invalid theme")
 )
@@ -72,4 +72,8 @@ const (
 	// FlagVertical restricts a Viewport to vertical scrolling only. The child
 	// fills the viewport width; no horizontal scrollbar is shown.
 	FlagVertical Flag = "vertical"
+
+	// FlagWrap makes a Flex flow children that do not fit onto additional
+	// rows, or columns in a vertical Flex.
+	FlagWrap Flag = "wrap"
 )
@@ -27,6 +27,10 @@ type LayoutForm interface {
 	// Emit writes the chain prefix placed in front of the child's
 	// own call. In Builder mode this is typically a single chained
 	// method like "Cell(0, 1, 1, 1)." since the child's constructor
-	// follows on the same chain. Indentation isgofmt's responsibility.
+	// follows on the same chain. In Compose mode it opens a wrapper
+	// call like "Cell(0, 1, 1, 1, " that takes the child as last
+	// argument and is closed by the codegen walker. Writing nothing
+	// means the child needs no wrapper. Indentation isgofmt's
+	// responsibility.
 	Emit(w io.Writer, mode string) error
 }
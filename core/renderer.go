@@ -53,7 +53,9 @@ func (r *Renderer) Border(x, y, w, h int, border string) {
 // subsequent drawing calls. Colour arguments starting with "$" are resolved
 // through the theme's colour registry before being passed to the underlying
 // renderer; literal colours (such as "#ffffff" or named terminal colours)
-// are forwarded unchanged.
+// are forwarded unchanged. On terminals with fewer colours, both are mapped
+// to the theme's colour profile, see [Theme.Resolve].
 func (r *Renderer) Set(foreground, background, font string) {
-	r.Renderer.Set(r.Theme.Color(foreground), r.Theme.Color(background), font)
+	fg, bg := r.Theme.Resolve(foreground, background)
+	r.Renderer.Set(fg, bg, font)
 }
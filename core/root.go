@@ -36,6 +36,12 @@ type Root interface {
 	// knows that only the widget's own bounds need to change on screen.
 	Redraw(widget Widget)
 
+	// Post queues fn to run on the goroutine that handles events and
+	// renders. It is safe to call from any goroutine and never blocks;
+	// widgets changed from other goroutines, such as animation tickers,
+	// must do so through Post.
+	Post(fn func())
+
 	// Theme returns the currently active theme, used by widgets during
 	// Apply to resolve styles and by the renderer to resolve colour
 	// variables and named borders.
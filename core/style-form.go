@@ -106,6 +106,10 @@ func (f *StyleForm) Store(s *Style) *Style {
 	return s
 }
 
+// Fixed reports whether the loaded style was themed. Themed styles are
+// inherited from the active theme rather than owned by the widget.
+func (f *StyleForm) Fixed() bool { return f.fixed }
+
 // Validate runs per-field validation. Currently a stub; per-field
 // validators land here once the inspector's editor surface is wired up.
 func (f *StyleForm) Validate(field string) error { return nil }
@@ -155,6 +159,51 @@ func (f *StyleForm) EmitBuilderChain(w io.Writer) {
 	}
 }
 
+// EmitComposeOptions writes the compose options that set the non-zero
+// style fields, one per line and each ending with ",\n" so they can be
+// listed as arguments of the widget's constructor. Colours map to Fg and
+// Bg, the remaining fields to the options of the same name.
+//
+// As with EmitBuilderChain, themed (fixed) styles emit nothing, and
+// Cursor and Shadow surface as TODO comments since compose has no
+// options for them.
+func (f *StyleForm) EmitComposeOptions(w io.Writer) error {
+	if f.fixed {
+		return nil
+	}
+	var options []string
+	if pad, ok := parseInsets(f.Padding); ok && !pad.IsZero() {
+		options = append(options, fmt.Sprintf("Padding(%s),", pad.String(", ")))
+	}
+	if mar, ok := parseInsets(f.Margin); ok && !mar.IsZero() {
+		options = append(options, fmt.Sprintf("Margin(%s),", mar.String(", ")))
+	}
+	if f.Border != "" {
+		options = append(options, fmt.Sprintf("Border(%q),", f.Border))
+	}
+	if f.Foreground != "" {
+		options = append(options, fmt.Sprintf("Fg(%q),", f.Foreground))
+	}
+	if f.Background != "" {
+		options = append(options, fmt.Sprintf("Bg(%q),", f.Background))
+	}
+	if f.Font != "" {
+		options = append(options, fmt.Sprintf("Font(%q),", f.Font))
+	}
+	if f.Cursor != "" {
+		options = append(options, fmt.Sprintf("// TODO: cursor=%q (no compose option)", f.Cursor))
+	}
+	if f.Shadow != "" {
+		options = append(options, fmt.Sprintf("// TODO: shadow=%q (no compose option)", f.Shadow))
+	}
+	for _, option := range options {
+		if _, err := fmt.Fprintln(w, option); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // ---- helpers ----
 
 func parseInsets(s string) (*Insets, bool) {
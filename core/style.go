@@ -180,6 +180,29 @@ func (s *Style) OwnBackground() string { return s.background }
 // without cascading to the parent. Empty string means "not set on this style".
 func (s *Style) OwnFont() string { return s.font }
 
+// OwnBorder returns the border set directly on this style, without
+// cascading to the parent. Empty string means "not set on this style".
+func (s *Style) OwnBorder() string { return s.border }
+
+// OwnShadow returns the shadow set directly on this style, without
+// cascading to the parent. Empty string means "not set on this style".
+func (s *Style) OwnShadow() string { return s.shadow }
+
+// OwnCursor returns the cursor set directly on this style, without
+// cascading to the parent. Empty string means "not set on this style".
+func (s *Style) OwnCursor() string { return s.cursor }
+
+// OwnMargin returns the margin set directly on this style, without
+// cascading to the parent. nil means "not set on this style".
+func (s *Style) OwnMargin() *Insets { return s.margin }
+
+// OwnPadding returns the padding set directly on this style, without
+// cascading to the parent. nil means "not set on this style".
+func (s *Style) OwnPadding() *Insets { return s.padding }
+
+// Selector returns the selector the style was created with.
+func (s *Style) Selector() string { return s.selector }
+
 // Shadow returns the shadow style.
 // If the shadow is not set in this style, it inherits from the parent.
 // Returns an empty string if no shadow is set in the hierarchy.
@@ -5,6 +5,7 @@ import (
 	"regexp"
 	"slices"
 	"strings"
+	"sync"
 )
 
 // styleRegExp is the compiled regular expression used to parse CSS-like selectors.
@@ -29,6 +30,9 @@ import (
 // This dual placement provides compatibility with different styling conventions.
 var (
 	styleRegExp, _ = regexp.Compile(`([0-9A-Za-z_\-]*)/?([0-9A-Za-z_\-]*)\.?([0-9A-Za-z_\-]*)#?([0-9A-Za-z_\-]*)/?([0-9A-Za-z_\-]*):?([0-9A-Za-z_\-]*)`)
+
+	// selectorRegExp matches the characters allowed in selectors.
+	selectorRegExp = regexp.MustCompile(`^[0-9A-Za-z_\-/.#:]*$`)
 )
 
 // Theme provides a comprehensive styling system for widgets using CSS-like selectors.
@@ -68,11 +72,23 @@ var (
 // More specific styles override less specific ones, allowing for
 // hierarchical theming with sensible defaults and targeted overrides.
 type Theme struct {
-	borders map[string]*Border // Registry of border styles indexed by name
-	colors  map[string]string  // Registry of color variables (e.g., "$primary" -> "#007ACC")
-	flags   map[string]bool    // Registry of boolean configuration flags
-	strings map[string]string  // Registry of special Unicode characters for UI elements
-	styles  map[string]*Style  // Registry of style definitions indexed by CSS-like selectors
+	borders   map[string]*Border                 // Registry of border styles indexed by name
+	colors    map[string]string                  // Registry of color variables (e.g., "$primary" -> "#007ACC")
+	fallbacks map[ColorProfile]map[string]string // Color variables for fewer colors
+	flags     map[string]bool                    // Registry of boolean configuration flags
+	profile   ColorProfile                       // Colors the terminal can display
+	resolved  sync.Map                           // Resolve results by [foreground, background]
+	strings   map[string]string                  // Registry of special Unicode characters for UI elements
+	styles    map[string]*Style                  // Registry of style definitions indexed by CSS-like selectors
+}
+
+// monoFonts are the font attributes that mark widget states with
+// ProfileMonochrome, where colours cannot.
+var monoFonts = map[string]string{
+	"checked": "bold",
+	"focused": "reverse",
+	"hovered": "underline",
+	"pressed": "bold",
 }
 
 // NewTheme creates a new Theme with empty registries and ASCII default strings
@@ -88,11 +104,12 @@ type Theme struct {
 //	theme.SetStyles(NewStyle("button").WithColors("$primary", "white"))
 func NewTheme() *Theme {
 	theme := &Theme{
-		borders: make(map[string]*Border),
-		colors:  make(map[string]string),
-		flags:   make(map[string]bool),
-		strings: make(map[string]string),
-		styles:  make(map[string]*Style),
+		borders:   make(map[string]*Border),
+		colors:    make(map[string]string),
+		fallbacks: make(map[ColorProfile]map[string]string),
+		flags:     make(map[string]bool),
+		strings:   make(map[string]string),
+		styles:    make(map[string]*Style),
 	}
 
 	// Default strings (collapsible indicators + ASCII progress bar)
@@ -115,6 +132,8 @@ func NewTheme() *Theme {
 		"progress.v.middle.empty":  ".",
 		"progress.v.end.filled":    "#",
 		"progress.v.end.empty":     ".",
+		"table.sort.ascending":     "^",
+		"table.sort.descending":    "v",
 		"tree.branch":              "+-",
 		"tree.collapsed":           "> ",
 		"tree.expanded":            "v ",
@@ -177,6 +196,11 @@ func (t *Theme) AddStyles(styles ...*Style) {
 //   - selector: The base selector to resolve.
 //   - states:   Additional state variants to install (for example "focus",
 //     "hover", "disabled").
+//
+// With ProfileMonochrome, states are marked by font attributes instead of
+// colours: focused styles are shown reversed, hovered ones underlined and
+// pressed or checked ones bold. Parts with a background different from the
+// widget's, like the highlighted item of a list, are reversed as well.
 func (t *Theme) Apply(widget Widget, selector string, states ...string) {
 	parts := split(selector)
 	part := ""
@@ -187,22 +211,56 @@ func (t *Theme) Apply(widget Widget, selector string, states ...string) {
 	}
 
 	if part != "" {
-		style := t.Get(selector)
+		style := t.mono(t.Get(selector), selector, "")
 		widget.SetStyle(part, style)
 		for _, state := range states {
-			style := t.Get(selector + ":" + state)
+			style := t.mono(t.Get(selector+":"+state), selector, state)
 			widget.SetStyle(part+":"+state, style)
 		}
 	} else {
 		style := t.Get(selector)
 		widget.SetStyle("", style)
 		for _, state := range states {
-			style := t.Get(selector + ":" + state)
+			style := t.mono(t.Get(selector+":"+state), selector, state)
 			widget.SetStyle(":"+state, style)
 		}
 	}
 }
 
+// mono adds the font attributes that replace colours in ProfileMonochrome to a
+// style applied for a selector and state. Other profiles use the style
+// unchanged.
+func (t *Theme) mono(style *Style, selector, state string) *Style {
+	if t.profile != ProfileMonochrome {
+		return style
+	}
+	font := strings.Fields(style.Font())
+	add := strings.Fields(monoFonts[state])
+	if parts := split(selector); parts[2] != "" || parts[5] != "" {
+		// Selector of the widget itself, without the part
+		widget := selector
+		for _, part := range []string{parts[2], parts[5]} {
+			if part != "" {
+				widget = strings.Replace(widget, "/"+part, "", 1)
+			}
+		}
+		if style.Background() != t.Get(widget).Background() {
+			add = append(add, "reverse")
+		}
+	}
+	changed := false
+	for _, attr := range add {
+		if !slices.Contains(font, attr) {
+			font = append(font, attr)
+			changed = true
+		}
+	}
+	if !changed {
+		return style
+	}
+	return style.WithFont(strings.Join(font, " "))
+}
+
 // Border looks up a named border definition in the theme's border
 // registry. Returns nil if no border with that name is registered;
 // callers that render borders unconditionally should ensure the name is
@@ -254,6 +312,26 @@ func (t *Theme) Color(color string) string {
 	return color
 }
 
+// Fallbacks returns the colour variables used instead of the regular ones
+// with a less capable colour profile, as a live map like Colors. Fallbacks
+// are literal colours, usually palette colours like "color111" for
+// Profile256 or ANSI colour names like "navy" for Profile16. See Resolve.
+func (t *Theme) Fallbacks(profile ColorProfile) map[string]string {
+	if t.fallbacks == nil {
+		t.fallbacks = make(map[ColorProfile]map[string]string)
+	}
+	if t.fallbacks[profile] == nil {
+		t.fallbacks[profile] = make(map[string]string)
+	}
+	return t.fallbacks[profile]
+}
+
+// Borders returns the theme's border registry as a live map. Like Colors,
+// the map is shared with the theme.
+func (t *Theme) Borders() map[string]*Border {
+	return t.borders
+}
+
 // Colors returns the theme's colour-variable registry as a live map. The
 // map is shared with the theme, so callers may mutate it to add or remove
 // variables, but any mutation will be visible to every widget that uses
@@ -270,6 +348,78 @@ func (t *Theme) Flag(flag string) bool {
 	return t.flags[flag]
 }
 
+// Flags returns the theme's flag registry as a live map. Like Colors, the
+// map is shared with the theme.
+func (t *Theme) Flags() map[string]bool {
+	return t.flags
+}
+
+// Profile returns the colour profile the theme resolves colours for.
+func (t *Theme) Profile() ColorProfile {
+	return t.profile
+}
+
+// SetProfile sets the colour profile of the terminal the theme is used on.
+// The UI detects it when it starts and calls SetProfile, so applications
+// rarely need to. Widgets must be re-styled for a change to
+// ProfileMonochrome to take effect, which UI.SetTheme does.
+func (t *Theme) SetProfile(profile ColorProfile) {
+	t.profile = profile
+	t.resolved.Clear()
+}
+
+// Resolve returns the colours to display for a foreground and background
+// colour with the theme's profile. Variables are looked up like with Color.
+//
+// With ProfileTrueColor, colours are used as they are. With Profile256 and
+// Profile16, a variable's fallback for the profile is used if there is
+// one, else its fallback for the next more capable profile, downsampled,
+// else its regular colour, downsampled. Downsampled foregrounds are kept
+// legible on the background as described for [Legible]; fallbacks are
+// taken as they are. With ProfileMonochrome, both colours are empty and the
+// terminal's default colours apply.
+//
+// Downsampled pairs are cached, as Resolve runs for every cell drawn. The
+// cache is cleared by SetColors, SetFallbacks and SetProfile, but not when
+// the live maps of Colors or Fallbacks are changed.
+func (t *Theme) Resolve(foreground, background string) (string, string) {
+	switch t.profile {
+	case ProfileTrueColor:
+		return t.Color(foreground), t.Color(background)
+	case ProfileMonochrome:
+		return "", ""
+	}
+	key := [2]string{foreground, background}
+	if pair, ok := t.resolved.Load(key); ok {
+		pair := pair.([2]string)
+		return pair[0], pair[1]
+	}
+	bg, exact := t.fallback(background)
+	if !exact {
+		bg = Downsample(bg, t.profile)
+	}
+	fg, exact := t.fallback(foreground)
+	if !exact {
+		fg = Legible(fg, t.Color(background), bg, t.profile)
+	}
+	t.resolved.Store(key, [2]string{fg, bg})
+	return fg, bg
+}
+
+// fallback returns the best colour the theme defines for a colour with its
+// profile and whether it is meant for exactly that profile. Other colours
+// still need to be downsampled.
+func (t *Theme) fallback(color string) (string, bool) {
+	if strings.HasPrefix(color, "$") {
+		for profile := t.profile; profile > ProfileTrueColor; profile-- {
+			if value, ok := t.fallbacks[profile][color]; ok {
+				return value, profile == t.profile
+			}
+		}
+	}
+	return t.Color(color), false
+}
+
 // Get resolves the best-matching style for the given selector by walking
 // the cascade of progressively less specific selectors (see the selector
 // helper for the exact order). If no entry matches, DefaultStyle is
@@ -301,6 +451,12 @@ func (t *Theme) String(name string) string {
 	return t.strings[name]
 }
 
+// Strings returns the theme's string registry as a live map. Like Colors,
+// the map is shared with the theme.
+func (t *Theme) Strings() map[string]string {
+	return t.strings
+}
+
 // SetBorders replaces the theme's border style registry with the provided map.
 // This method is used for bulk border style configuration and theme initialization.
 //
@@ -324,6 +480,14 @@ func (t *Theme) SetBorders(borders map[string]*Border) {
 //   - colors: Map of color variable names to their color values
 func (t *Theme) SetColors(colors map[string]string) {
 	t.colors = colors
+	t.resolved.Clear()
+}
+
+// SetFallbacks replaces the fallback colour variables for a profile.
+func (t *Theme) SetFallbacks(profile ColorProfile, colors map[string]string) {
+	t.Fallbacks(profile)
+	t.fallbacks[profile] = colors
+	t.resolved.Clear()
 }
 
 // SetFlags replaces the theme's flag registry with the provided map.
@@ -350,6 +514,22 @@ func (t *Theme) Styles() []*Style {
 	return slices.Collect(maps.Values(t.styles))
 }
 
+// ValidSelector reports whether a string only consists of the characters
+// selectors are made of: letters, digits, "_" and "-" for names and "/",
+// ".", "#" and ":" as separators.
+func ValidSelector(s string) bool {
+	return selectorRegExp.MatchString(s)
+}
+
+// Specificity returns the cascade priority of a selector: lower values are
+// more specific. A style registered with Add only finds parents with a
+// higher value, so registering styles in order of decreasing specificity
+// value links every style to its closest parent.
+func Specificity(s string) int {
+	_, priority := selector(0, split(s))
+	return priority
+}
+
 // split parses a CSS-like selector string into its component parts using
 // the predefined regular expression. This is an internal utility function
 // that enables the hierarchical style resolution system.
@@ -127,3 +127,77 @@ func TestTheme_SetColors_ReplacesRegistry(t *testing.T) {
 		t.Errorf("Colors() len = %d; want 2", len(theme.Colors()))
 	}
 }
+
+// ── Selectors ─────────────────────────────────────────────────────────────────
+
+func TestValidSelector(t *testing.T) {
+	for _, s := range []string{"", "button", "list/item.selected:hover", "#submit/text", ":focused"} {
+		if !ValidSelector(s) {
+			t.Errorf("ValidSelector(%q) = false; want true", s)
+		}
+	}
+	for _, s := range []string{"box button", "button > text", "input!"} {
+		if ValidSelector(s) {
+			t.Errorf("ValidSelector(%q) = true; want false", s)
+		}
+	}
+}
+
+func TestSpecificity_ParentsFirst(t *testing.T) {
+	// Adding styles by decreasing Specificity links each to its closest parent.
+	order := []string{"", "button", "button.primary", "button:focused", "#ok"}
+	for i := 1; i < len(order); i++ {
+		if Specificity(order[i-1]) <= Specificity(order[i]) {
+			t.Errorf("Specificity(%q) = %d, Specificity(%q) = %d; want decreasing",
+				order[i-1], Specificity(order[i-1]), order[i], Specificity(order[i]))
+		}
+	}
+}
+
+// ── Resolve ──────────────────────────────────────────────────────────────────
+
+func TestTheme_Resolve_Fallbacks(t *testing.T) {
+	theme := NewTheme()
+	theme.SetColors(map[string]string{"$blue": "#7aa2f7", "$bg": "#1a1b26"})
+	theme.SetFallbacks(Profile256, map[string]string{"$blue": "color75"})
+	theme.Fallbacks(Profile16)["$bg"] = "navy"
+
+	cases := []struct {
+		profile ColorProfile
+		fg, bg  string
+	}{
+		{ProfileTrueColor, "#7aa2f7", "#1a1b26"},
+		{Profile256, "color75", "color234"},
+		{Profile16, "silver", "navy"}, // color75 downsampled
+		{ProfileMonochrome, "", ""},
+	}
+	for _, tc := range cases {
+		theme.SetProfile(tc.profile)
+		fg, bg := theme.Resolve("$blue", "$bg")
+		if fg != tc.fg || bg != tc.bg {
+			t.Errorf("%v: Resolve = %q, %q; want %q, %q", tc.profile, fg, bg, tc.fg, tc.bg)
+		}
+	}
+}
+
+func TestTheme_Resolve_Cache(t *testing.T) {
+	theme := NewTheme()
+	theme.SetProfile(Profile256)
+	theme.SetColors(map[string]string{"$fg": "#ffffff", "$bg": "#000000"})
+	if fg, bg := theme.Resolve("$fg", "$bg"); fg != "color231" || bg != "color16" {
+		t.Fatalf("Resolve = %q, %q; want color231, color16", fg, bg)
+	}
+
+	theme.SetColors(map[string]string{"$fg": "#000000", "$bg": "#ffffff"})
+	if fg, bg := theme.Resolve("$fg", "$bg"); fg != "color16" || bg != "color231" {
+		t.Errorf("after SetColors: Resolve = %q, %q; want color16, color231", fg, bg)
+	}
+	theme.SetFallbacks(Profile256, map[string]string{"$fg": "color75"})
+	if fg, _ := theme.Resolve("$fg", "$bg"); fg != "color75" {
+		t.Errorf("after SetFallbacks: foreground = %q; want color75", fg)
+	}
+	theme.SetProfile(Profile16)
+	if fg, bg := theme.Resolve("$fg", "$bg"); fg != "gray" || bg != "white" {
+		t.Errorf("after SetProfile: Resolve = %q, %q; want gray, white", fg, bg)
+	}
+}
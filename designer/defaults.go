@@ -67,6 +67,8 @@ func RegisterDefaults(d *Designer) {
 		func() WidgetForm { return &widgets.RadioForm{} })
 	reg(reflect.TypeOf((*widgets.Slider)(nil)),
 		func() WidgetForm { return &widgets.SliderForm{} })
+	reg(reflect.TypeOf((*widgets.Responsive)(nil)),
+		func() WidgetForm { return &widgets.ResponsiveForm{} })
 	reg(reflect.TypeOf((*widgets.Rule)(nil)),
 		func() WidgetForm { return &widgets.RuleForm{} })
 	reg(reflect.TypeOf((*widgets.Scanner)(nil)),
@@ -75,6 +77,8 @@ func RegisterDefaults(d *Designer) {
 		func() WidgetForm { return &widgets.SelectForm{} })
 	reg(reflect.TypeOf((*widgets.Shortcuts)(nil)),
 		func() WidgetForm { return &widgets.ShortcutsForm{} })
+	reg(reflect.TypeOf((*widgets.Splitter)(nil)),
+		func() WidgetForm { return &widgets.SplitterForm{} })
 	reg(reflect.TypeOf((*widgets.Spinner)(nil)),
 		func() WidgetForm { return &widgets.SpinnerForm{} })
 	reg(reflect.TypeOf((*widgets.Styled)(nil)),
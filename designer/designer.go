@@ -6,7 +6,9 @@ import (
 	"go/format"
 	"io"
 	"reflect"
+	"slices"
 	"sort"
+	"strings"
 
 	"github.com/tekugo/zeichenwerk/core"
 )
@@ -32,6 +34,17 @@ type Designer struct {
 	// the lexicographic Kinds() / KindNames() sort.
 	byType map[reflect.Type]Kind
 	kinds  []Kind
+
+	// Edit history (see history.go). done holds the changes Undo
+	// reverts, newest last; undone the ones Redo re-applies.
+	done   []change
+	undone []change
+
+	// Clipboard: the copied subtree as a document node, and the kind
+	// name of the container it was copied from, which decides whether
+	// its layout parameters survive a paste.
+	clipboard       *Node
+	clipboardParent string
 }
 
 // NewDesigner returns an empty Designer pointed at target. The driver
@@ -137,7 +150,8 @@ func (d *Designer) KindNames() []string {
 // per-child layout parameters. Containers that consume layout
 // params (Grid) fall back to their own defaults; for Grid that
 // means cell (0, 0, 1, 1). Callers wanting a specific position
-// should follow up with the matching LayoutForm.
+// should follow up with the matching LayoutForm. The addition is
+// recorded in the edit history (see Undo).
 //
 // Theme application is the caller's responsibility: Designer
 // has no Theme reference and the new widget is untouched by any
@@ -159,6 +173,12 @@ func (d *Designer) Add(parent core.Container, kindName string) (core.Widget, err
 		if err := parent.Add(child); err != nil {
 			return nil, fmt.Errorf("inspector: Add: parent.Add: %w", err)
 		}
+		at := d.placement(child)
+		d.record(change{
+			label: "add " + widgetKind(child) + idSuffix(child),
+			undo:  func() error { return detach(child) },
+			redo:  func() error { return d.place(child, at) },
+		})
 		return child, nil
 	}
 	return nil, fmt.Errorf("inspector: Add: no kind named %q", kindName)
@@ -167,18 +187,30 @@ func (d *Designer) Add(parent core.Container, kindName string) (core.Widget, err
 // Remove detaches child from its parent. Returns an error when child
 // is nil, when child has no parent (typically the target root, which
 // the user is not allowed to remove), or when the parent's
-// Container.Remove call fails.
+// Container.Remove call fails. Undo puts child back at its old index
+// with its layout parameters.
 func (d *Designer) Remove(child core.Widget) error {
 	if child == nil {
 		return fmt.Errorf("inspector: Remove: child is nil")
 	}
-	parent := child.Parent()
-	if parent == nil {
+	if child.Parent() == nil {
 		return fmt.Errorf("inspector: Remove: child %T has no parent", child)
 	}
-	if err := parent.Remove(child); err != nil {
-		return fmt.Errorf("inspector: Remove: %w", err)
-	}
+	return d.remove(child, "remove")
+}
+
+// remove detaches child and records the change under the given verb.
+// Undo re-inserts child at its old index with its layout parameters.
+func (d *Designer) remove(child core.Widget, verb string) error {
+	at := d.placement(child)
+	if err := at.parent.Remove(child); err != nil {
+		return fmt.Errorf("inspector: %s: %w", strings.ToUpper(verb[:1])+verb[1:], err)
+	}
+	d.record(change{
+		label: verb + " " + widgetKind(child) + idSuffix(child),
+		undo:  func() error { return d.place(child, at) },
+		redo:  func() error { return detach(child) },
+	})
 	return nil
 }
 
@@ -189,10 +221,11 @@ func (d *Designer) Remove(child core.Widget) error {
 //
 // pos is clamped to [0, len(newParent.Children())] by Insert.
 //
-// Layout parameters (e.g. Grid cell coordinates) are not preserved
-// across re-parenting because the new container may interpret params
-// differently. Callers wanting to preserve grid placement should
-// re-apply the relevant LayoutForm after the move.
+// A reorder keeps the child's layout parameters (e.g. its Grid cell).
+// They are not preserved across re-parenting because the new container
+// may interpret params differently. Callers wanting to preserve grid
+// placement should re-apply the relevant LayoutForm after the move.
+// Undo restores the old position, including the layout parameters.
 func (d *Designer) Move(child core.Widget, newParent core.Container, pos int) error {
 	if child == nil {
 		return fmt.Errorf("inspector: Move: child is nil")
@@ -230,24 +263,52 @@ func (d *Designer) Move(child core.Widget, newParent core.Container, pos int) er
 		if pos == curIdx {
 			return nil
 		}
+		from := d.placement(child)
 		if err := oldParent.Remove(child); err != nil {
 			return fmt.Errorf("inspector: Move: remove: %w", err)
 		}
 		if err := oldParent.Insert(pos, child); err != nil {
 			return fmt.Errorf("inspector: Move: re-insert: %w", err)
 		}
+		if from.layout != nil {
+			from.layout.Store(oldParent, child)
+		}
+		d.recordMove(child, from)
 		return nil
 	}
 
+	from := d.placement(child)
 	if err := oldParent.Remove(child); err != nil {
 		return fmt.Errorf("inspector: Move: remove from old parent: %w", err)
 	}
 	if err := newParent.Insert(pos, child); err != nil {
 		return fmt.Errorf("inspector: Move: insert into new parent: %w", err)
 	}
+	d.recordMove(child, from)
 	return nil
 }
 
+// recordMove records a completed move of child away from the given
+// placement.
+func (d *Designer) recordMove(child core.Widget, from placement) {
+	to := d.placement(child)
+	d.record(change{
+		label: "move " + widgetKind(child) + idSuffix(child),
+		undo: func() error {
+			if err := detach(child); err != nil {
+				return err
+			}
+			return d.place(child, from)
+		},
+		redo: func() error {
+			if err := detach(child); err != nil {
+				return err
+			}
+			return d.place(child, to)
+		},
+	})
+}
+
 // Generate writes Builder-mode source for d.target's tree to w.
 // Deprecated wrapper around GenerateFragment for the legacy
 // signature; new callers should use GenerateFragment or
@@ -270,18 +331,28 @@ func (d *Designer) Generate(mode string, w io.Writer) error {
 // which survives gofmt and gives the reader an anchor when the
 // chain is otherwise visually flat.
 //
+// In compose mode the fragment is a "Build(theme, ...)" call
+// returning the root widget, with the tree as nested compose
+// options; see emitCompose.
+//
 // The whole emitted string is run through go/format.Source before
 // being written, so callers always see canonical Go.
-//
-// Compose mode is reserved; today it returns an error.
 func (d *Designer) GenerateFragment(mode string, w io.Writer) error {
-	if mode != ModeBuilder {
-		return fmt.Errorf("inspector: unsupported codegen mode: %q", mode)
-	}
 	var buf bytes.Buffer
-	buf.WriteString("NewBuilder(theme).\n")
-	if err := d.emit(&buf, d.target, nil); err != nil {
-		return err
+	switch mode {
+	case ModeBuilder:
+		buf.WriteString("NewBuilder(theme).\n")
+		if err := d.emit(&buf, d.target, nil); err != nil {
+			return err
+		}
+	case ModeCompose:
+		buf.WriteString("Build(theme,\n")
+		if err := d.emitCompose(&buf, d.target, nil); err != nil {
+			return err
+		}
+		buf.WriteString(")")
+	default:
+		return fmt.Errorf("inspector: unsupported codegen mode: %q", mode)
 	}
 	formatted, err := formatExpr(buf.Bytes())
 	if err != nil {
@@ -299,28 +370,50 @@ func (d *Designer) GenerateFragment(mode string, w io.Writer) error {
 // pkg is the file's package name; funcName is the name of the
 // builder-returning function. The function signature is
 // "func <funcName>(theme *core.Theme) *zeichenwerk.UI"; the body
-// returns the result of calling .Build() on the chain.
+// returns the result of calling .Build() on the chain. The widgets
+// and time packages are only imported when the chain uses them.
+// Reading the file back with FormatGo restores the tree.
+//
+// In compose mode, the file dot-imports the compose package and
+// imports zeichenwerk as z and core by name; the function returns
+// "UI(theme, ...)" with the tree as nested compose options.
 //
 // As with GenerateFragment, the result is run through
 // go/format.Source before being written.
-//
-// Compose mode is reserved; today it returns an error.
 func (d *Designer) GenerateFile(mode string, w io.Writer, pkg, funcName string) error {
-	if mode != ModeBuilder {
+	switch mode {
+	case ModeBuilder:
+	case ModeCompose:
+		return d.generateComposeFile(w, pkg, funcName)
+	default:
 		return fmt.Errorf("inspector: unsupported codegen mode: %q", mode)
 	}
+	var body bytes.Buffer
+	if err := d.emit(&body, d.target, nil); err != nil {
+		return err
+	}
+	// The root container's End() closes the chain; continue it with
+	// Build(), placing the dot before the marker comment.
+	chain := body.Bytes()
+	if i := bytes.LastIndex(chain, []byte("End() //")); i >= 0 {
+		chain = slices.Concat(chain[:i], []byte("End()."), chain[i+len("End()"):])
+	}
+
 	var buf bytes.Buffer
 	fmt.Fprintf(&buf, "package %s\n\n", pkg)
 	buf.WriteString("import (\n")
+	if bytes.Contains(chain, []byte("time.")) {
+		buf.WriteString("\t\"time\"\n\n")
+	}
 	buf.WriteString("\t. \"github.com/tekugo/zeichenwerk\"\n")
 	buf.WriteString("\t. \"github.com/tekugo/zeichenwerk/core\"\n")
-	buf.WriteString("\t. \"github.com/tekugo/zeichenwerk/widgets\"\n")
+	if bytes.Contains(chain, []byte("FlexItem{")) {
+		buf.WriteString("\t. \"github.com/tekugo/zeichenwerk/widgets\"\n")
+	}
 	buf.WriteString(")\n\n")
 	fmt.Fprintf(&buf, "func %s(theme *Theme) *UI {\n", funcName)
 	buf.WriteString("\treturn NewBuilder(theme).\n")
-	if err := d.emit(&buf, d.target, nil); err != nil {
-		return err
-	}
+	buf.Write(chain)
 	buf.WriteString("Build()\n}\n")
 	formatted, err := format.Source(buf.Bytes())
 	if err != nil {
@@ -377,7 +470,8 @@ func (d *Designer) emit(w io.Writer, widget core.Widget, parent core.Container)
 		marker := closingMarker(wf, widget)
 		if parent == nil {
 			// Root container: chain terminates here, no
-			// trailing dot.
+			// trailing dot. GenerateFile continues it with
+			// Build() and adds the dot itself.
 			if _, err := fmt.Fprintf(w, "End() %s\n", marker); err != nil {
 				return err
 			}
@@ -395,6 +489,125 @@ func (d *Designer) emit(w io.Writer, widget core.Widget, parent core.Container)
 	return nil
 }
 
+// generateComposeFile is GenerateFile for ModeCompose. The widgets
+// and time packages are only imported when the generated tree uses
+// them, since unused imports do not compile.
+func (d *Designer) generateComposeFile(w io.Writer, pkg, funcName string) error {
+	var body bytes.Buffer
+	if err := d.emitCompose(&body, d.target, nil); err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "package %s\n\n", pkg)
+	buf.WriteString("import (\n")
+	if bytes.Contains(body.Bytes(), []byte("time.")) {
+		buf.WriteString("\t\"time\"\n\n")
+	}
+	buf.WriteString("\tz \"github.com/tekugo/zeichenwerk\"\n")
+	buf.WriteString("\t. \"github.com/tekugo/zeichenwerk/compose\"\n")
+	buf.WriteString("\t\"github.com/tekugo/zeichenwerk/core\"\n")
+	if bytes.Contains(body.Bytes(), []byte("widgets.")) {
+		buf.WriteString("\t\"github.com/tekugo/zeichenwerk/widgets\"\n")
+	}
+	buf.WriteString(")\n\n")
+	fmt.Fprintf(&buf, "func %s(theme *core.Theme) *z.UI {\n", funcName)
+	buf.WriteString("\treturn UI(theme,\n")
+	buf.Write(body.Bytes())
+	buf.WriteString(")\n}\n")
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("inspector: GenerateFile: %w\n--- raw output ---\n%s", err, buf.String())
+	}
+	_, err = w.Write(formatted)
+	return err
+}
+
+// emitCompose is the compose-mode counterpart of emit. It writes one
+// widget as a compose option expression, with its children nested
+// as further arguments, followed by ",\n" so the next sibling
+// continues the argument list.
+//
+// The parent's layout form opens a wrapper such as "Cell(0, 0, 1, 1, "
+// in front of the widget, which is closed together with the
+// widget's own call. The widget's form writes "Kind(args,\n" and one
+// line per option; emitCompose appends the children and the closing
+// parenthesis. Containers are closed with "), // Kind#id" like the
+// End() of builder mode. A widget that wrote nothing but its
+// constructor line and has no children is closed on the same line,
+// so a plain leaf reads "Static("a", "", "A"),". TODO comments the
+// form writes are moved in front of the widget, where gofmt keeps
+// them next to the call they annotate.
+func (d *Designer) emitCompose(w io.Writer, widget core.Widget, parent core.Container) error {
+	var wrap bytes.Buffer
+	if parent != nil {
+		if pf := d.FormFor(parent); pf != nil {
+			if cf, ok := pf.(ContainerForm); ok {
+				if lf := cf.LayoutForm(parent, widget); lf != nil {
+					if err := lf.Emit(&wrap, ModeCompose); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+
+	wf := d.FormFor(widget)
+	if wf == nil {
+		_, err := fmt.Fprintf(w, "// TODO: no form registered for %T\n", widget)
+		return err
+	}
+	var out bytes.Buffer
+	if err := wf.Emit(&out, ModeCompose); err != nil {
+		return err
+	}
+	var comments, head bytes.Buffer
+	for _, line := range bytes.SplitAfter(out.Bytes(), []byte("\n")) {
+		if bytes.HasPrefix(bytes.TrimSpace(line), []byte("//")) {
+			comments.Write(line)
+		} else {
+			head.Write(line)
+		}
+	}
+
+	var children []core.Widget
+	container, isContainer := widget.(core.Container)
+	if isContainer {
+		children = container.Children()
+	}
+	closing := ")"
+	if wrap.Len() > 0 {
+		closing += ")"
+	}
+
+	if _, err := w.Write(comments.Bytes()); err != nil {
+		return err
+	}
+	if _, err := w.Write(wrap.Bytes()); err != nil {
+		return err
+	}
+	if len(children) == 0 && bytes.Count(head.Bytes(), []byte("\n")) == 1 {
+		if _, err := w.Write(bytes.TrimSuffix(head.Bytes(), []byte(",\n"))); err != nil {
+			return err
+		}
+	} else {
+		if _, err := w.Write(head.Bytes()); err != nil {
+			return err
+		}
+		for _, child := range children {
+			if err := d.emitCompose(w, child, container); err != nil {
+				return err
+			}
+		}
+	}
+	if isContainer {
+		_, err := fmt.Fprintf(w, "%s, %s\n", closing, closingMarker(wf, widget))
+		return err
+	}
+	_, err := fmt.Fprintf(w, "%s,\n", closing)
+	return err
+}
+
 // closingMarker formats the trailing comment placed on a
 // container's closing End() — typically "// Kind#id" — so a reader
 // can match the close back to its opener after gofmt flattens the
@@ -234,3 +234,186 @@ func TestRegister_RejectsMismatch(t *testing.T) {
 		t.Errorf("error message should mention Register: %v", err)
 	}
 }
+
+// TestGenerateFragment_ComposeRoundTrip generates compose source for
+// canned trees and verifies, as TestGenerateFragment_RoundTrip does
+// for builder mode, that the output parses as a Go expression, that
+// the expected calls appear in order and that container closes carry
+// their "// Kind#id" marker.
+func TestGenerateFragment_ComposeRoundTrip(t *testing.T) {
+	cases := []struct {
+		name         string
+		root         func() Container
+		callsInOrder []string
+		markers      []string
+	}{
+		{
+			name: "single static",
+			root: func() Container {
+				root := NewFlex("root", "", Stretch, 0)
+				_ = root.Add(NewStatic("hello", "", "Hello"))
+				return root
+			},
+			callsInOrder: []string{
+				"Build(theme,",
+				`HFlex("root", "", core.Stretch, 0,`,
+				`Static("hello", "", "Hello"),`,
+				"),",
+			},
+			markers: []string{"// Flex#root"},
+		},
+		{
+			name: "grid children wrapped in Cell",
+			root: func() Container {
+				root := NewFlex("outer", "", Stretch, 0)
+				g := NewGrid("g", "", 2, 2, false)
+				g.Add(NewStatic("a", "", "A"), 0, 0, 1, 1)
+				g.Add(NewStatic("b", "highlight", "B"), 1, 0, 1, 1)
+				_ = root.Add(g)
+				return root
+			},
+			callsInOrder: []string{
+				`Grid("g", "", []int{-1, -1}, []int{-1, -1}, false,`,
+				`Cell(0, 0, 1, 1, Static("a", "", "A")),`,
+				`Cell(1, 0, 1, 1, Static("b", "highlight", "B")),`,
+			},
+			markers: []string{"// Grid#g", "// Flex#outer"},
+		},
+		{
+			name: "vflex with input params and flags",
+			root: func() Container {
+				root := NewFlex("col", "", Center, 1)
+				root.SetFlag(FlagVertical, true)
+				input := NewInput("name", "", "", "your name…")
+				input.SetFlag(FlagMasked, true)
+				input.SetHint(20, 1)
+				_ = root.Add(input)
+				return root
+			},
+			callsInOrder: []string{
+				`VFlex("col", "", core.Center, 1,`,
+				`Input("name", "", []string{"", "your name…"},`,
+				`Hint(20, 1),`,
+				`Flag(core.FlagMasked),`,
+			},
+			markers: []string{"// Flex#col"},
+		},
+		{
+			name: "style options",
+			root: func() Container {
+				root := NewFlex("frame", "", Stretch, 0)
+				s := NewStatic("hi", "", "Hi")
+				s.SetStyle("", NewStyle("").
+					WithPadding(0, 1).
+					WithForeground("$fg").
+					WithBackground("$blue").
+					WithFont("bold"))
+				_ = root.Add(s)
+				return root
+			},
+			callsInOrder: []string{
+				`Static("hi", "", "Hi",`,
+				`Padding(0, 1),`,
+				`Fg("$fg"),`,
+				`Bg("$blue"),`,
+				`Font("bold"),`,
+			},
+			markers: []string{"// Flex#frame"},
+		},
+		{
+			name: "flex items and breakpoints",
+			root: func() Container {
+				root := NewFlex("row", "", Stretch, 0)
+				_ = root.Add(NewStatic("fixed", "", "F"))
+				r := NewResponsive("r", "")
+				_ = r.Add(NewStatic("narrow", "", "N"))
+				_ = r.Add(NewStatic("wide", "", "W"), Breakpoint{MinWidth: 80})
+				_ = root.Add(r, FlexItem{Grow: 1})
+				return root
+			},
+			callsInOrder: []string{
+				`Static("fixed", "", "F"),`,
+				`Item(widgets.FlexItem{Grow: 1}, Responsive("r", "",`,
+				`Static("narrow", "", "N"),`,
+				`Breakpoint(80, 0, Static("wide", "", "W")),`,
+				`), // Responsive#r`,
+			},
+			markers: []string{"// Flex#row"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := designer.NewDesigner(tc.root())
+			registerKinds(t, d)
+			if err := d.Register(designer.Kind{
+				Type: reflect.TypeOf((*Responsive)(nil)),
+				Make: func() designer.WidgetForm { return &ResponsiveForm{} },
+			}); err != nil {
+				t.Fatalf("register Responsive: %v", err)
+			}
+
+			var buf bytes.Buffer
+			if err := d.GenerateFragment(designer.ModeCompose, &buf); err != nil {
+				t.Fatalf("GenerateFragment: %v", err)
+			}
+			out := buf.String()
+
+			src := "package _expr_test\n\nvar _ = " + out + "\n"
+			if _, err := parser.ParseFile(token.NewFileSet(), "", src, 0); err != nil {
+				t.Fatalf("output does not parse:\n%s\n--- error ---\n%v", out, err)
+			}
+
+			pos := 0
+			for _, want := range tc.callsInOrder {
+				idx := strings.Index(out[pos:], want)
+				if idx < 0 {
+					t.Errorf("expected call %q not found at or after pos %d in:\n%s",
+						want, pos, out)
+					continue
+				}
+				pos += idx + len(want)
+			}
+
+			for _, marker := range tc.markers {
+				if !strings.Contains(out, marker) {
+					t.Errorf("expected marker %q not found in:\n%s", marker, out)
+				}
+			}
+		})
+	}
+}
+
+// TestGenerateFile_Compose checks that a compose-mode file parses and
+// only imports the widgets package when the tree needs it.
+func TestGenerateFile_Compose(t *testing.T) {
+	root := NewFlex("root", "", Stretch, 0)
+	_ = root.Add(NewStatic("hi", "", "hi"))
+
+	d := designer.NewDesigner(root)
+	registerKinds(t, d)
+
+	var buf bytes.Buffer
+	if err := d.GenerateFile(designer.ModeCompose, &buf, "demo", "BuildUI"); err != nil {
+		t.Fatalf("GenerateFile: %v", err)
+	}
+	out := buf.String()
+
+	if _, err := parser.ParseFile(token.NewFileSet(), "", out, 0); err != nil {
+		t.Fatalf("file does not parse:\n%s\n--- error ---\n%v", out, err)
+	}
+	for _, want := range []string{
+		"package demo",
+		`. "github.com/tekugo/zeichenwerk/compose"`,
+		"func BuildUI(theme *core.Theme) *z.UI {",
+		"return UI(theme,",
+		`Static("hi", "", "hi"),`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "zeichenwerk/widgets") {
+		t.Errorf("unused widgets import in:\n%s", out)
+	}
+}
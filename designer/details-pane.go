@@ -75,7 +75,8 @@ func (s *session) fillPanesWithPlaceholder(msg string) {
 }
 
 // apply flushes the current form back to the live widget, then
-// (if a per-child layout form was loaded) flushes that too. The
+// (if a per-child layout form was loaded) flushes that too, through
+// Designer.Apply so the edit lands in the undo history. The
 // tree node label is re-derived so widget renames are reflected
 // without rebuilding the tree, and the details pane is rebuilt so
 // derived values like Computed bounds reflect the new state.
@@ -92,9 +93,9 @@ func (s *session) apply() {
 		s.setStatus("Apply: form drift (widget set but no form loaded)")
 		return
 	}
-	s.currentForm.Store(s.currentWidget)
-	if s.currentLayout != nil && s.currentParent != nil {
-		s.currentLayout.Store(s.currentParent, s.currentWidget)
+	if err := s.d.Apply(s.currentWidget, s.currentForm, s.currentLayout); err != nil {
+		s.setStatus("apply failed: " + err.Error())
+		return
 	}
 	widgets.Relayout(s.currentWidget)
 	if s.currentNode != nil {
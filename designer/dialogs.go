@@ -38,11 +38,53 @@ func (s *session) refreshHeader() {
 	widgets.Redraw(s.themeLabel)
 }
 
-// save writes the current target subtree as a complete Go source
-// file to proj.OutPath. Both the "Save" and "Generate" buttons
-// land here — they did the same thing in the PoC, and splitting
-// them would add a settings toggle without a clear use case.
+// save writes the current target subtree as a UI document to
+// proj.Path, in JSON or YAML depending on the file extension. Open
+// reads it back.
 func (s *session) save() {
+	if err := s.d.SaveFile(s.proj.Path, s.target); err != nil {
+		s.setStatus("save failed: " + err.Error())
+		return
+	}
+	s.setDirty(false)
+	s.setStatus("saved " + s.proj.Path)
+}
+
+// open replaces the target subtree with the UI document at
+// proj.Path. The target itself stays in place, so the document's
+// root must be of the same kind. The edit history refers to the
+// widgets the document replaced, so it is cleared.
+func (s *session) open() {
+	f, err := os.Open(s.proj.Path)
+	if err != nil {
+		s.setStatus("open failed: " + err.Error())
+		return
+	}
+	defer f.Close()
+	format, err := documentFormat(s.proj.Path)
+	if err != nil {
+		s.setStatus("open failed: " + err.Error())
+		return
+	}
+	node, err := ReadNode(f, format)
+	if err == nil {
+		err = s.d.DecodeInto(s.target, node, s.theme)
+	}
+	if err != nil {
+		s.setStatus("open failed: " + err.Error())
+		return
+	}
+	s.d.ClearHistory()
+	widgets.Relayout(s.target)
+	s.refreshTree()
+	s.selectAfterMutation(s.target)
+	s.setDirty(false)
+	s.setStatus("opened " + s.proj.Path)
+}
+
+// generate writes the current target subtree as a complete Go
+// source file to proj.OutPath.
+func (s *session) generate() {
 	var buf bytes.Buffer
 	if err := s.d.GenerateFile(ModeBuilder, &buf, s.proj.Package, s.proj.FuncName); err != nil {
 		s.setStatus("generate failed: " + err.Error())
@@ -52,7 +94,6 @@ func (s *session) save() {
 		s.setStatus("write failed: " + err.Error())
 		return
 	}
-	s.setDirty(false)
 	s.setStatus("wrote " + s.proj.OutPath)
 }
 
@@ -71,11 +112,15 @@ func (s *session) openSettingsDialog() {
 		Dialog("settings-dialog", "Project Settings").
 		Class("dialog").
 		VFlex("settings-body", core.Stretch, 1).Padding(1, 2).
-		Static("settings-prompt", "Edit codegen and chrome settings.").Hint(0, 1).
+		Static("settings-prompt", "Edit document, codegen and chrome settings.").Hint(0, 1).
 		HFlex("settings-name-row", core.Start, 1).Hint(0, 1).
 		Static("settings-name-label", "Name      ").Hint(12, 1).
 		Input("settings-name", s.proj.Name).Hint(40, 1).
 		End().
+		HFlex("settings-path-row", core.Start, 1).Hint(0, 1).
+		Static("settings-path-label", "Document  ").Hint(12, 1).
+		Input("settings-path", s.proj.Path).Hint(40, 1).
+		End().
 		HFlex("settings-out-row", core.Start, 1).Hint(0, 1).
 		Static("settings-out-label", "Output    ").Hint(12, 1).
 		Input("settings-out", s.proj.OutPath).Hint(40, 1).
@@ -103,6 +148,7 @@ func (s *session) openSettingsDialog() {
 
 	commit := func() {
 		nameIn := core.MustFind[*widgets.Input](dialog, "settings-name")
+		pathIn := core.MustFind[*widgets.Input](dialog, "settings-path")
 		outIn := core.MustFind[*widgets.Input](dialog, "settings-out")
 		pkgIn := core.MustFind[*widgets.Input](dialog, "settings-pkg")
 		fnIn := core.MustFind[*widgets.Input](dialog, "settings-fn")
@@ -113,6 +159,7 @@ func (s *session) openSettingsDialog() {
 			newName = filepath.Base(outIn.Get())
 		}
 		s.proj.Name = newName
+		s.proj.Path = strings.TrimSpace(pathIn.Get())
 		s.proj.OutPath = strings.TrimSpace(outIn.Get())
 		s.proj.Package = strings.TrimSpace(pkgIn.Get())
 		s.proj.FuncName = strings.TrimSpace(fnIn.Get())
@@ -0,0 +1,499 @@
+package designer
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/tekugo/zeichenwerk/core"
+)
+
+// Document formats accepted by Read and Write.
+const (
+	FormatJSON = "json"
+	FormatYAML = "yaml"
+)
+
+// Node is one widget of a UI document, the runtime counterpart of the
+// Go source GenerateFile emits. Documents are read and written as JSON
+// or YAML and built through the same Kind registry the designer edits
+// with, so every registered kind can be stored without extra code.
+//
+// Fields, Style and Layout hold the form fields of the kind's
+// WidgetForm, its StyleForm and the parent's LayoutForm (a Grid cell or
+// Flex item) keyed by field name in lower camel case ("text", "hintW",
+// "vertical"); names are matched case-insensitively on read. Write
+// stores only fields that differ from a freshly created widget, layout
+// fields that are not zero, and no themed styles, so documents stay
+// short.
+//
+//	kind: Grid
+//	id: main
+//	fields: {rows: "1, -1", columns: "-1"}
+//	children:
+//	  - kind: Static
+//	    id: title
+//	    fields: {text: Hello, hintH: 1}
+//	    style: {foreground: $cyan}
+//	    layout: {x: 0, y: 0, w: 1, h: 1}
+type Node struct {
+	Kind     string         `json:"kind" yaml:"kind"`
+	ID       string         `json:"id,omitempty" yaml:"id,omitempty"`
+	Class    string         `json:"class,omitempty" yaml:"class,omitempty"`
+	Fields   map[string]any `json:"fields,omitempty" yaml:"fields,omitempty"`
+	Style    map[string]any `json:"style,omitempty" yaml:"style,omitempty"`
+	Layout   map[string]any `json:"layout,omitempty" yaml:"layout,omitempty"`
+	Children []*Node        `json:"children,omitempty" yaml:"children,omitempty"`
+}
+
+// ---- Encoding ---------------------------------------------------------------
+
+// Encode returns the document node for widget and its subtree. Every
+// widget in the tree must have a registered kind.
+func (d *Designer) Encode(widget core.Widget) (*Node, error) {
+	return d.encode(widget, nil)
+}
+
+// encode builds the node of one widget; parent supplies the layout
+// form of the widget, if any.
+func (d *Designer) encode(widget core.Widget, parent core.Container) (*Node, error) {
+	k, ok := d.byType[reflect.TypeOf(widget)]
+	if !ok {
+		return nil, fmt.Errorf("inspector: Encode: no kind registered for %T", widget)
+	}
+	form := k.Make()
+	form.Load(widget)
+	// The defaults pass through Store once, like a decoded widget does,
+	// so values the form normalises (an empty margin becomes "0") compare
+	// equal.
+	defaults := k.Make()
+	fresh := defaults.New()
+	defaults.Load(fresh)
+	defaults.Store(fresh)
+	defaults.Load(fresh)
+
+	node := &Node{Kind: k.Name}
+	initial := formFields(defaults)
+	for i, field := range formFields(form) {
+		value := field.value.Interface()
+		switch field.name {
+		case "id":
+			node.ID = value.(string)
+		case "class":
+			node.Class = value.(string)
+		default:
+			if value != initial[i].value.Interface() {
+				node.Fields = setValue(node.Fields, field.name, value)
+			}
+		}
+	}
+
+	if style := form.Style(); !style.Fixed() {
+		initial := formFields(defaults.Style())
+		for i, field := range formFields(style) {
+			if value := field.value.Interface(); value != "" && value != initial[i].value.Interface() {
+				node.Style = setValue(node.Style, field.name, value)
+			}
+		}
+	}
+
+	if lf := d.layoutForm(parent, widget); lf != nil {
+		for _, field := range formFields(lf) {
+			if !field.value.IsZero() {
+				node.Layout = setValue(node.Layout, field.name, field.value.Interface())
+			}
+		}
+	}
+
+	if container, ok := widget.(core.Container); ok {
+		for _, child := range container.Children() {
+			childNode, err := d.encode(child, container)
+			if err != nil {
+				return nil, err
+			}
+			node.Children = append(node.Children, childNode)
+		}
+	}
+	return node, nil
+}
+
+// Write encodes widget and its subtree as a document in the given format.
+func (d *Designer) Write(w io.Writer, format string, widget core.Widget) error {
+	node, err := d.Encode(widget)
+	if err != nil {
+		return err
+	}
+	switch format {
+	case FormatJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(node)
+	case FormatYAML:
+		encoder := yaml.NewEncoder(w)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(node); err != nil {
+			return err
+		}
+		return encoder.Close()
+	}
+	return fmt.Errorf("inspector: Write: unsupported document format %q", format)
+}
+
+// SaveFile writes widget and its subtree to path. The format follows
+// the file extension: .json, .yaml or .yml.
+func (d *Designer) SaveFile(path string, widget core.Widget) error {
+	format, err := documentFormat(path)
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	if err := d.Write(&buf, format, widget); err != nil {
+		return err
+	}
+	return os.WriteFile(path, buf.Bytes(), 0o644)
+}
+
+// ---- Decoding ---------------------------------------------------------------
+
+// Decode builds a new widget tree from a document node. theme, if not
+// nil, is applied to every widget before the document's own styles, so
+// those override the theme like Builder's style methods do. Errors wrap
+// core.ErrInvalidDocument and name the offending node, e.g.
+// "children[1].fields.text".
+func (d *Designer) Decode(node *Node, theme *core.Theme) (core.Widget, error) {
+	return d.decode(node, nil, nil, theme, "root")
+}
+
+// DecodeInto replaces the children and settings of target with those of
+// a document node of the same kind. The designer uses it to open a
+// document into the tree it edits, which keeps its place in the host UI.
+// The document is built detached first, so target is left unchanged if
+// it has errors.
+func (d *Designer) DecodeInto(target core.Container, node *Node, theme *core.Theme) error {
+	k, ok := d.byType[reflect.TypeOf(target)]
+	if !ok || !strings.EqualFold(k.Name, node.Kind) {
+		return fmt.Errorf("%w: root: kind %q does not match %T", core.ErrInvalidDocument, node.Kind, target)
+	}
+	built, err := d.decode(node, nil, nil, theme, "root")
+	if err != nil {
+		return err
+	}
+	source := built.(core.Container)
+
+	for _, child := range slices.Clone(target.Children()) {
+		if err := target.Remove(child); err != nil {
+			return err
+		}
+	}
+	settings := *node
+	settings.Children = nil
+	if _, err := d.decode(&settings, target, target.Parent(), theme, "root"); err != nil {
+		return err
+	}
+	for _, child := range slices.Clone(source.Children()) {
+		lf := d.layoutForm(source, child)
+		if err := source.Remove(child); err != nil {
+			return err
+		}
+		if err := target.Add(child); err != nil {
+			return err
+		}
+		if lf != nil {
+			lf.Store(target, child)
+		}
+	}
+	return nil
+}
+
+// Read decodes a document in the given format and builds its widget
+// tree; see Decode.
+func (d *Designer) Read(r io.Reader, format string, theme *core.Theme) (core.Widget, error) {
+	node, err := ReadNode(r, format)
+	if err != nil {
+		return nil, err
+	}
+	return d.Decode(node, theme)
+}
+
+// LoadFile reads the document at path and builds its widget tree. The
+// format follows the file extension: .json, .yaml or .yml, or .go for
+// Go source (see FormatGo).
+func (d *Designer) LoadFile(path string, theme *core.Theme) (core.Widget, error) {
+	format, err := documentFormat(path)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return d.Read(f, format, theme)
+}
+
+// ReadNode parses a document without building it. Unknown keys are
+// rejected so that typos do not go unnoticed. FormatGo reads the widget
+// tree of Go source instead; see readSource.
+func ReadNode(r io.Reader, format string) (*Node, error) {
+	var node Node
+	switch format {
+	case FormatJSON:
+		decoder := json.NewDecoder(r)
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&node); err != nil {
+			return nil, fmt.Errorf("%w: %v", core.ErrInvalidDocument, err)
+		}
+	case FormatYAML:
+		decoder := yaml.NewDecoder(r)
+		decoder.KnownFields(true)
+		if err := decoder.Decode(&node); err != nil {
+			return nil, fmt.Errorf("%w: %v", core.ErrInvalidDocument, err)
+		}
+	case FormatGo:
+		return readSource(r)
+	default:
+		return nil, fmt.Errorf("inspector: Read: unsupported document format %q", format)
+	}
+	return &node, nil
+}
+
+// decode builds the widget of one node and its children. widget is the
+// existing widget to configure, or nil to create one; parent receives a
+// created widget.
+func (d *Designer) decode(node *Node, widget core.Widget, parent core.Container, theme *core.Theme, path string) (core.Widget, error) {
+	if node == nil {
+		return nil, fmt.Errorf("%w: %s: empty node", core.ErrInvalidDocument, path)
+	}
+	k, ok := d.kindNamed(node.Kind)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s: unknown kind %q", core.ErrInvalidDocument, path, node.Kind)
+	}
+	form := k.Make()
+	if widget == nil {
+		widget = form.New()
+	}
+	form.Load(widget)
+	fields := map[string]any{"id": node.ID, "class": node.Class}
+	for name, value := range node.Fields {
+		if strings.EqualFold(name, "id") || strings.EqualFold(name, "class") {
+			return nil, fmt.Errorf("%w: %s.fields.%s: set id and class on the node", core.ErrInvalidDocument, path, name)
+		}
+		fields[name] = value
+	}
+	if err := setFields(form, fields, path+".fields"); err != nil {
+		return nil, err
+	}
+	form.Store(widget)
+
+	if theme != nil {
+		widget.Apply(theme)
+	}
+	if len(node.Style) > 0 {
+		style := form.Style()
+		style.Load(widget.Style())
+		if err := setFields(style, node.Style, path+".style"); err != nil {
+			return nil, err
+		}
+		widget.SetStyle("", style.Store(widget.Style()))
+	}
+
+	if parent != nil && widget.Parent() != parent {
+		if err := parent.Add(widget); err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", core.ErrInvalidDocument, path, err)
+		}
+		lf := d.layoutForm(parent, widget)
+		if lf == nil && len(node.Layout) > 0 {
+			return nil, fmt.Errorf("%w: %s.layout: %s takes no layout parameters", core.ErrInvalidDocument, path, widgetKind(parent))
+		}
+		if lf != nil {
+			if err := setFields(lf, node.Layout, path+".layout"); err != nil {
+				return nil, err
+			}
+			lf.Store(parent, widget)
+		}
+	}
+
+	if len(node.Children) > 0 {
+		container, ok := widget.(core.Container)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s: %s cannot have children", core.ErrInvalidDocument, path, k.Name)
+		}
+		for i, child := range node.Children {
+			if _, err := d.decode(child, nil, container, theme, fmt.Sprintf("%s.children[%d]", path, i)); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return widget, nil
+}
+
+// ---- Helpers ----------------------------------------------------------------
+
+// kindNamed looks up a registered kind by name, ignoring case.
+func (d *Designer) kindNamed(name string) (Kind, bool) {
+	for _, k := range d.kinds {
+		if strings.EqualFold(k.Name, name) {
+			return k, true
+		}
+	}
+	return Kind{}, false
+}
+
+// layoutForm returns the loaded layout form of child on parent, or nil
+// if parent takes no per-child parameters.
+func (d *Designer) layoutForm(parent core.Container, child core.Widget) core.LayoutForm {
+	if parent == nil {
+		return nil
+	}
+	if cf, ok := d.FormFor(parent).(ContainerForm); ok {
+		return cf.LayoutForm(parent, child)
+	}
+	return nil
+}
+
+// documentFormat derives the document format from a file extension.
+func documentFormat(path string) (string, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return FormatJSON, nil
+	case ".yaml", ".yml":
+		return FormatYAML, nil
+	case ".go":
+		return FormatGo, nil
+	}
+	return "", fmt.Errorf("inspector: unknown document format for %q", path)
+}
+
+// formField is one editable field of a form struct.
+type formField struct {
+	name  string
+	value reflect.Value
+}
+
+// formFields returns the editable fields of a form, the exported fields
+// carrying a group tag like BuildFormGroup renders them, in declaration
+// order with embedded structs inlined. Read-only fields are skipped.
+func formFields(form any) []formField {
+	var fields []formField
+	var walk func(v reflect.Value)
+	walk = func(v reflect.Value) {
+		t := v.Type()
+		for i := range t.NumField() {
+			sf := t.Field(i)
+			if sf.Anonymous && sf.Type.Kind() == reflect.Struct {
+				walk(v.Field(i))
+				continue
+			}
+			if !sf.IsExported() || sf.Tag.Get("group") == "" {
+				continue
+			}
+			if _, readonly := sf.Tag.Lookup("readonly"); readonly {
+				continue
+			}
+			fields = append(fields, formField{name: fieldKey(sf.Name), value: v.Field(i)})
+		}
+	}
+	walk(reflect.ValueOf(form).Elem())
+	return fields
+}
+
+// setFields assigns document values to the fields of a form. Keys are
+// matched case-insensitively.
+func setFields(form any, values map[string]any, path string) error {
+	fields := formFields(form)
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		i := slices.IndexFunc(fields, func(f formField) bool { return strings.EqualFold(f.name, name) })
+		if i < 0 {
+			return fmt.Errorf("%w: %s.%s: unknown field", core.ErrInvalidDocument, path, name)
+		}
+		if err := assign(fields[i].value, values[name]); err != nil {
+			return fmt.Errorf("%w: %s.%s: %v", core.ErrInvalidDocument, path, name, err)
+		}
+	}
+	return nil
+}
+
+// assign stores a decoded JSON or YAML scalar in a form field. Numbers
+// and booleans are accepted for string fields, since YAML reads an
+// unquoted "-1" or "true" as such.
+func assign(field reflect.Value, value any) error {
+	switch field.Kind() {
+	case reflect.String:
+		switch v := value.(type) {
+		case string:
+			field.SetString(v)
+		case int, float64, bool:
+			field.SetString(fmt.Sprint(v))
+		default:
+			return fmt.Errorf("expected a string, got %T", value)
+		}
+	case reflect.Bool:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("expected a boolean, got %T", value)
+		}
+		field.SetBool(v)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		switch v := value.(type) {
+		case int:
+			field.SetInt(int64(v))
+		case float64:
+			if v != float64(int64(v)) {
+				return fmt.Errorf("expected an integer, got %v", v)
+			}
+			field.SetInt(int64(v))
+		case string:
+			n, err := strconv.Atoi(v)
+			if err != nil {
+				return fmt.Errorf("expected an integer, got %q", v)
+			}
+			field.SetInt(int64(n))
+		default:
+			return fmt.Errorf("expected an integer, got %T", value)
+		}
+	default:
+		return fmt.Errorf("unsupported field type %s", field.Type())
+	}
+	return nil
+}
+
+// setValue adds a key to a possibly nil map.
+func setValue(m map[string]any, key string, value any) map[string]any {
+	if m == nil {
+		m = make(map[string]any)
+	}
+	m[key] = value
+	return m
+}
+
+// fieldKey converts a Go field name to the lower camel case document
+// key: "HintW" → "hintW", "ID" → "id", "URLPath" → "urlPath".
+func fieldKey(name string) string {
+	runes := []rune(name)
+	n := 0
+	for n < len(runes) && unicode.IsUpper(runes[n]) {
+		n++
+	}
+	if n > 1 && n < len(runes) {
+		n-- // the last capital starts the next word
+	}
+	for i := range n {
+		runes[i] = unicode.ToLower(runes[i])
+	}
+	return string(runes)
+}
@@ -0,0 +1,193 @@
+package designer_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	. "github.com/tekugo/zeichenwerk/core"
+	"github.com/tekugo/zeichenwerk/designer"
+	. "github.com/tekugo/zeichenwerk/widgets"
+)
+
+// documentTree builds a small Grid with a titled Static and a Flex of
+// inputs, covering fields, hints, an own style and Grid cell layout.
+func documentTree() Container {
+	grid := NewGrid("main", "", 2, 1, false)
+	grid.Rows(1, -1)
+	title := NewStatic("title", "header", "Hello")
+	title.SetHint(20, 1)
+	title.SetStyle("", NewStyle().WithColors("$cyan", "$bg0"))
+	grid.Add(title, 0, 0, 1, 1)
+	form := NewFlex("form", "", Stretch, 1)
+	form.SetFlag(FlagVertical, true)
+	form.Add(NewInput("name", "", "Ada"), FlexItem{Min: 5, Grow: 1})
+	grid.Add(form, 0, 1, 1, 1)
+	return grid
+}
+
+func TestDocument_RoundTrip(t *testing.T) {
+	for _, format := range []string{designer.FormatJSON, designer.FormatYAML} {
+		t.Run(format, func(t *testing.T) {
+			d := designer.NewDesigner(nil)
+			registerKinds(t, d)
+
+			var buf bytes.Buffer
+			if err := d.Write(&buf, format, documentTree()); err != nil {
+				t.Fatalf("Write: %v", err)
+			}
+			w, err := d.Read(bytes.NewReader(buf.Bytes()), format, nil)
+			if err != nil {
+				t.Fatalf("Read: %v\n%s", err, buf.String())
+			}
+
+			grid, ok := w.(*Grid)
+			if !ok || grid.ID() != "main" {
+				t.Fatalf("root = %T %q; want *Grid main", w, w.ID())
+			}
+			title := Find(grid, "title").(*Static)
+			if title.Text != "Hello" || title.Class() != "header" {
+				t.Errorf("title = %q class %q", title.Text, title.Class())
+			}
+			if w, h := title.Hint(); w != 20 || h != 1 {
+				t.Errorf("title hint = %d,%d; want 20,1", w, h)
+			}
+			if fg := title.Style().Foreground(); fg != "$cyan" {
+				t.Errorf("title foreground = %q; want $cyan", fg)
+			}
+			form := Find(grid, "form").(*Flex)
+			if !form.Flag(FlagVertical) {
+				t.Error("form lost its vertical flag")
+			}
+			input := Find(form, "name").(*Input)
+			if input.Get() != "Ada" {
+				t.Errorf("input = %q; want Ada", input.Get())
+			}
+			if item := form.Item(input); item != (FlexItem{Min: 5, Grow: 1}) {
+				t.Errorf("input item = %+v; want Min 5, Grow 1", item)
+			}
+
+			// A second pass must produce the same document.
+			var again bytes.Buffer
+			if err := d.Write(&again, format, w); err != nil {
+				t.Fatalf("Write again: %v", err)
+			}
+			if again.String() != buf.String() {
+				t.Errorf("documents differ:\n%s\n---\n%s", buf.String(), again.String())
+			}
+		})
+	}
+}
+
+func TestDocument_GridLayout(t *testing.T) {
+	d := designer.NewDesigner(nil)
+	registerKinds(t, d)
+	node, err := d.Encode(documentTree())
+	if err != nil {
+		t.Fatal(err)
+	}
+	layout := node.Children[1].Layout
+	if _, ok := layout["x"]; ok || layout["y"] != 1 || layout["w"] != 1 || layout["h"] != 1 {
+		t.Errorf("form layout = %v; want y 1, w 1, h 1 and no zero x", layout)
+	}
+	item := node.Children[1].Children[0].Layout
+	if len(item) != 2 || item["min"] != 5 || item["grow"] != 1 {
+		t.Errorf("Flex item layout = %v; want min 5, grow 1", item)
+	}
+}
+
+func TestDocument_Errors(t *testing.T) {
+	cases := []struct {
+		name, doc, want string
+	}{
+		{"unknown kind", `{"kind": "Grid", "children": [{"kind": "Nope"}]}`, `root.children[0]: unknown kind "Nope"`},
+		{"unknown field", `{"kind": "Static", "fields": {"txt": "x"}}`, "root.fields.txt: unknown field"},
+		{"field type", `{"kind": "Static", "fields": {"hintW": "wide"}}`, "root.fields.hintW"},
+		{"fraction", `{"kind": "Static", "fields": {"hintW": 1.5}}`, "expected an integer"},
+		{"children", `{"kind": "Static", "children": [{"kind": "Static"}]}`, "Static cannot have children"},
+		{"layout", `{"kind": "Flex", "children": [{"kind": "Static", "layout": {"x": 1}}]}`, "root.children[0].layout.x: unknown field"},
+		{"unknown key", `{"kind": "Static", "text": "x"}`, "unknown field"},
+	}
+	d := designer.NewDesigner(nil)
+	registerKinds(t, d)
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			_, err := d.Read(strings.NewReader(c.doc), designer.FormatJSON, nil)
+			if !errors.Is(err, ErrInvalidDocument) {
+				t.Fatalf("err = %v; want ErrInvalidDocument", err)
+			}
+			if !strings.Contains(err.Error(), c.want) {
+				t.Errorf("err = %q; want it to contain %q", err, c.want)
+			}
+		})
+	}
+}
+
+func TestDocument_DecodeInto(t *testing.T) {
+	d := designer.NewDesigner(nil)
+	registerKinds(t, d)
+	target := NewGrid("target", "", 2, 1, false)
+	target.Add(NewStatic("keep1", "", "1"), 0, 0, 1, 1)
+	target.Add(NewStatic("keep2", "", "2"), 0, 1, 1, 1)
+
+	bad := `{"kind": "Grid", "children": [{"kind": "Static", "id": "new1"}, {"kind": "Static", "fields": {"txt": "x"}}]}`
+	node, err := designer.ReadNode(strings.NewReader(bad), designer.FormatJSON)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.DecodeInto(target, node, nil); !errors.Is(err, ErrInvalidDocument) {
+		t.Fatalf("DecodeInto = %v; want ErrInvalidDocument", err)
+	}
+	if ids := childIDs(target); ids != "keep1,keep2" {
+		t.Errorf("children after a failed decode = %s; want keep1,keep2", ids)
+	}
+
+	node, err = d.Encode(documentTree())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.DecodeInto(target, node, nil); err != nil {
+		t.Fatalf("DecodeInto: %v", err)
+	}
+	if ids := childIDs(target); target.ID() != "main" || ids != "title,form" {
+		t.Errorf("target = %s with %s; want main with title,form", target.ID(), ids)
+	}
+	again, err := d.Encode(target)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if layout := again.Children[1].Layout; layout["y"] != 1 || layout["h"] != 1 {
+		t.Errorf("form layout = %v; want y 1, h 1", layout)
+	}
+}
+
+// childIDs returns the IDs of the children of container, comma-separated.
+func childIDs(container Container) string {
+	var ids []string
+	for _, child := range container.Children() {
+		ids = append(ids, child.ID())
+	}
+	return strings.Join(ids, ",")
+}
+
+func TestDocument_YAMLScalars(t *testing.T) {
+	d := designer.NewDesigner(nil)
+	registerKinds(t, d)
+	doc := "kind: Grid\nfields:\n  rows: -1\n  columns: 10, -1\n  lines: true\nchildren:\n  - kind: Static\n    fields: {text: 42}\n    layout: {x: 1, y: 0, w: 1, h: 1}\n"
+	w, err := d.Read(strings.NewReader(doc), designer.FormatYAML, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	grid := w.(*Grid)
+	node, err := d.Encode(grid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if node.Fields["lines"] != true || node.Fields["columns"] != "10, -1" {
+		t.Errorf("fields = %v; want lines and columns 10, -1", node.Fields)
+	}
+	if text := grid.Children()[0].(*Static).Text; text != "42" {
+		t.Errorf("text = %q; want 42", text)
+	}
+}
@@ -0,0 +1,314 @@
+package designer
+
+import (
+	"fmt"
+	"maps"
+	"strconv"
+
+	"github.com/tekugo/zeichenwerk/core"
+)
+
+// historyLimit caps the number of changes Undo can step back through.
+// The oldest change is dropped once the limit is reached.
+const historyLimit = 100
+
+// change is one undoable mutation of the target tree. undo and redo
+// replay the mutation in either direction; both operate on the live
+// widgets the change was recorded against, so a redo after an undo
+// restores the very same widget values, not copies.
+type change struct {
+	label      string
+	undo, redo func() error
+}
+
+// placement records where a widget sits in the tree: its parent, its
+// index among the parent's children and, when the parent takes
+// per-child parameters, a loaded snapshot of the layout form.
+type placement struct {
+	parent core.Container
+	index  int
+	layout core.LayoutForm
+}
+
+// placement snapshots the current position of w. The zero placement
+// is returned for a widget without a parent.
+func (d *Designer) placement(w core.Widget) placement {
+	parent := w.Parent()
+	if parent == nil {
+		return placement{}
+	}
+	index := -1
+	for i, child := range parent.Children() {
+		if child == w {
+			index = i
+			break
+		}
+	}
+	return placement{parent: parent, index: index, layout: d.layoutForm(parent, w)}
+}
+
+// place inserts w at the recorded position and restores its layout
+// parameters.
+func (d *Designer) place(w core.Widget, p placement) error {
+	if p.parent == nil {
+		return fmt.Errorf("inspector: place: %T has no recorded parent", w)
+	}
+	if err := p.parent.Insert(p.index, w); err != nil {
+		return err
+	}
+	if p.layout != nil {
+		p.layout.Store(p.parent, w)
+	}
+	return nil
+}
+
+// detach removes w from its current parent, if it has one.
+func detach(w core.Widget) error {
+	if parent := w.Parent(); parent != nil {
+		return parent.Remove(w)
+	}
+	return nil
+}
+
+// record pushes a change onto the undo stack and discards the redo
+// stack, which no longer applies to the new state.
+func (d *Designer) record(c change) {
+	d.done = append(d.done, c)
+	if len(d.done) > historyLimit {
+		d.done = d.done[len(d.done)-historyLimit:]
+	}
+	d.undone = nil
+}
+
+// Undo reverts the most recent change and returns its label, e.g.
+// "add Static#title". The change moves to the redo stack. When the
+// revert fails the stacks are left untouched.
+func (d *Designer) Undo() (string, error) {
+	if len(d.done) == 0 {
+		return "", fmt.Errorf("inspector: Undo: nothing to undo")
+	}
+	c := d.done[len(d.done)-1]
+	if err := c.undo(); err != nil {
+		return "", fmt.Errorf("inspector: Undo: %s: %w", c.label, err)
+	}
+	d.done = d.done[:len(d.done)-1]
+	d.undone = append(d.undone, c)
+	return c.label, nil
+}
+
+// Redo re-applies the most recently undone change and returns its
+// label. The change moves back to the undo stack.
+func (d *Designer) Redo() (string, error) {
+	if len(d.undone) == 0 {
+		return "", fmt.Errorf("inspector: Redo: nothing to redo")
+	}
+	c := d.undone[len(d.undone)-1]
+	if err := c.redo(); err != nil {
+		return "", fmt.Errorf("inspector: Redo: %s: %w", c.label, err)
+	}
+	d.undone = d.undone[:len(d.undone)-1]
+	d.done = append(d.done, c)
+	return c.label, nil
+}
+
+// CanUndo reports whether there is a change to undo.
+func (d *Designer) CanUndo() bool { return len(d.done) > 0 }
+
+// CanRedo reports whether there is an undone change to redo.
+func (d *Designer) CanRedo() bool { return len(d.undone) > 0 }
+
+// ClearHistory forgets all recorded changes. Call it after replacing
+// the target tree wholesale, e.g. when a document is opened, since the
+// recorded changes refer to widgets that are no longer in the tree.
+// The clipboard is kept.
+func (d *Designer) ClearHistory() {
+	d.done = nil
+	d.undone = nil
+}
+
+// Apply stores form and, when non-nil, layout into w and its parent,
+// recording the change so it can be undone. form is typically the
+// form FormFor returned for w after the user edited it; layout the
+// matching LayoutForm of w's current parent.
+//
+// Undo reloads the values w had before the call, including its style:
+// a themed style that the form replaced by a widget-specific copy is
+// reinstated as is.
+func (d *Designer) Apply(w core.Widget, form WidgetForm, layout core.LayoutForm) error {
+	if w == nil {
+		return fmt.Errorf("inspector: Apply: widget is nil")
+	}
+	if form == nil {
+		return fmt.Errorf("inspector: Apply: form is nil")
+	}
+	before := d.FormFor(w)
+	if before == nil {
+		return fmt.Errorf("inspector: Apply: no kind registered for %T", w)
+	}
+	parent := w.Parent()
+	beforeLayout := d.layoutForm(parent, w)
+	style := w.Style()
+
+	form.Store(w)
+	if layout != nil && parent != nil {
+		layout.Store(parent, w)
+	}
+
+	after := d.FormFor(w)
+	afterLayout := d.layoutForm(parent, w)
+	d.record(change{
+		label: "apply " + widgetKind(w) + idSuffix(w),
+		undo: func() error {
+			before.Store(w)
+			if style.Fixed() {
+				w.SetStyle("", style)
+			}
+			if beforeLayout != nil {
+				beforeLayout.Store(parent, w)
+			}
+			return nil
+		},
+		redo: func() error {
+			after.Store(w)
+			if afterLayout != nil {
+				afterLayout.Store(parent, w)
+			}
+			return nil
+		},
+	})
+	return nil
+}
+
+// ---- Clipboard --------------------------------------------------------------
+
+// Copy puts a deep copy of w and its subtree on the designer's
+// clipboard. The copy is kept as a document node, so later edits of w
+// do not leak into it, and pasting it several times yields independent
+// widgets. Copy does not change the tree and is not recorded.
+func (d *Designer) Copy(w core.Widget) error {
+	if w == nil {
+		return fmt.Errorf("inspector: Copy: widget is nil")
+	}
+	parent := w.Parent()
+	node, err := d.encode(w, parent)
+	if err != nil {
+		return fmt.Errorf("inspector: Copy: %w", err)
+	}
+	d.clipboard = node
+	d.clipboardParent = ""
+	if parent != nil {
+		d.clipboardParent = d.Kind(parent).Name
+	}
+	return nil
+}
+
+// Cut copies w to the clipboard and removes it from its parent. The
+// removal is recorded; undoing it puts w back where it was.
+func (d *Designer) Cut(w core.Widget) error {
+	if w == nil {
+		return fmt.Errorf("inspector: Cut: widget is nil")
+	}
+	if w.Parent() == nil {
+		return fmt.Errorf("inspector: Cut: %T has no parent", w)
+	}
+	if err := d.Copy(w); err != nil {
+		return err
+	}
+	return d.remove(w, "cut")
+}
+
+// Clipboard returns the node on the clipboard, or nil when nothing has
+// been copied yet. The node is shared; callers must not modify it.
+func (d *Designer) Clipboard() *Node {
+	return d.clipboard
+}
+
+// Paste builds a fresh widget tree from the clipboard and appends it
+// to parent, applying theme when non-nil. The layout parameters of the
+// copied widget are kept when parent is of the same kind as the
+// container it was copied from and dropped otherwise. IDs that already
+// exist in the target tree get a numeric suffix ("name" becomes
+// "name-2") so the pasted widgets stay addressable.
+func (d *Designer) Paste(parent core.Container, theme *core.Theme) (core.Widget, error) {
+	if parent == nil {
+		return nil, fmt.Errorf("inspector: Paste: parent is nil")
+	}
+	if d.clipboard == nil {
+		return nil, fmt.Errorf("inspector: Paste: clipboard is empty")
+	}
+	node := cloneNode(d.clipboard)
+	if d.Kind(parent).Name != d.clipboardParent {
+		node.Layout = nil
+	}
+	root := d.target
+	if root == nil {
+		root = parent
+	}
+	ids := map[string]bool{}
+	if root.ID() != "" {
+		ids[root.ID()] = true
+	}
+	core.Traverse(root, func(w core.Widget) bool {
+		if w.ID() != "" {
+			ids[w.ID()] = true
+		}
+		return true
+	})
+	uniqueIDs(node, ids)
+
+	// The subtree is decoded detached, so a failure leaves parent as
+	// it was, and attached afterwards.
+	layout := node.Layout
+	node.Layout = nil
+	w, err := d.decode(node, nil, nil, theme, "clipboard")
+	if err != nil {
+		return nil, fmt.Errorf("inspector: Paste: %w", err)
+	}
+	if err := parent.Add(w); err != nil {
+		return nil, fmt.Errorf("inspector: Paste: parent.Add: %w", err)
+	}
+	if lf := d.layoutForm(parent, w); lf != nil && len(layout) > 0 {
+		if err := setFields(lf, layout, "clipboard.layout"); err != nil {
+			_ = detach(w)
+			return nil, fmt.Errorf("inspector: Paste: %w", err)
+		}
+		lf.Store(parent, w)
+	}
+	at := d.placement(w)
+	d.record(change{
+		label: "paste " + widgetKind(w) + idSuffix(w),
+		undo:  func() error { return detach(w) },
+		redo:  func() error { return d.place(w, at) },
+	})
+	return w, nil
+}
+
+// cloneNode returns a deep copy of node. Field values are scalars, so
+// cloning the maps is enough.
+func cloneNode(node *Node) *Node {
+	out := *node
+	out.Fields = maps.Clone(node.Fields)
+	out.Style = maps.Clone(node.Style)
+	out.Layout = maps.Clone(node.Layout)
+	out.Children = nil
+	for _, child := range node.Children {
+		out.Children = append(out.Children, cloneNode(child))
+	}
+	return &out
+}
+
+// uniqueIDs renames the IDs in node's subtree that are already taken,
+// adding each ID it hands out to taken.
+func uniqueIDs(node *Node, taken map[string]bool) {
+	if node.ID != "" {
+		id := node.ID
+		for i := 2; taken[id]; i++ {
+			id = node.ID + "-" + strconv.Itoa(i)
+		}
+		node.ID = id
+		taken[id] = true
+	}
+	for _, child := range node.Children {
+		uniqueIDs(child, taken)
+	}
+}
@@ -0,0 +1,224 @@
+package designer_test
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/tekugo/zeichenwerk/core"
+	"github.com/tekugo/zeichenwerk/designer"
+	"github.com/tekugo/zeichenwerk/themes"
+	. "github.com/tekugo/zeichenwerk/widgets"
+)
+
+const historyDocument = `
+kind: Flex
+id: root
+fields: {vertical: true}
+children:
+  - kind: Grid
+    id: grid
+    fields: {rows: "1, 1", columns: "-1, -1"}
+    children:
+      - kind: Static
+        id: a
+        fields: {text: A}
+        layout: {x: 0, y: 0, w: 2, h: 1}
+      - kind: Static
+        id: b
+        fields: {text: B}
+        layout: {x: 1, y: 1, w: 1, h: 1}
+  - kind: Box
+    id: box
+`
+
+// historyTree decodes historyDocument into a fresh designer pointed at
+// the resulting tree.
+func historyTree(t *testing.T) (*designer.Designer, Container) {
+	t.Helper()
+	reader := designer.NewDesigner(nil)
+	designer.RegisterDefaults(reader)
+	tree, err := reader.Read(strings.NewReader(historyDocument), designer.FormatYAML, themes.TokyoNight())
+	if err != nil {
+		t.Fatalf("Read: %v", err)
+	}
+	d := designer.NewDesigner(tree.(Container))
+	designer.RegisterDefaults(d)
+	return d, tree.(Container)
+}
+
+// checkUndoRedo undoes the last change, expecting the tree to match
+// before, then redoes it, expecting it to match after.
+func checkUndoRedo(t *testing.T, d *designer.Designer, root Container, before, after, label string) {
+	t.Helper()
+	got, err := d.Undo()
+	if err != nil {
+		t.Fatalf("Undo: %v", err)
+	}
+	if got != label {
+		t.Errorf("Undo label = %q; want %q", got, label)
+	}
+	if doc := documentJSON(t, d, root); doc != before {
+		t.Errorf("after Undo:\n%s\nwant:\n%s", doc, before)
+	}
+	if _, err := d.Redo(); err != nil {
+		t.Fatalf("Redo: %v", err)
+	}
+	if doc := documentJSON(t, d, root); doc != after {
+		t.Errorf("after Redo:\n%s\nwant:\n%s", doc, after)
+	}
+}
+
+func TestHistory_Structure(t *testing.T) {
+	d, root := historyTree(t)
+	grid := Find(root, "grid").(Container)
+	b := Find(root, "b")
+
+	cases := []struct {
+		label  string
+		mutate func() error
+	}{
+		{"add Static", func() error { _, err := d.Add(grid, "Static"); return err }},
+		{"move Static#b", func() error { return d.Move(b, grid, 0) }},
+		{"move Static#b", func() error { return d.Move(b, Find(root, "box").(Container), 0) }},
+		{"remove Static#a", func() error { return d.Remove(Find(root, "a")) }},
+	}
+	for _, c := range cases {
+		before := documentJSON(t, d, root)
+		if err := c.mutate(); err != nil {
+			t.Fatalf("%s: %v", c.label, err)
+		}
+		after := documentJSON(t, d, root)
+		if after == before {
+			t.Fatalf("%s did not change the tree", c.label)
+		}
+		checkUndoRedo(t, d, root, before, after, c.label)
+	}
+
+	// Undo everything: the original document comes back.
+	for d.CanUndo() {
+		if _, err := d.Undo(); err != nil {
+			t.Fatalf("Undo: %v", err)
+		}
+	}
+	_, original := historyTree(t)
+	if got, want := documentJSON(t, d, root), documentJSON(t, d, original); got != want {
+		t.Errorf("after undoing all:\n%s\nwant:\n%s", got, want)
+	}
+	if _, err := d.Undo(); err == nil || !strings.Contains(err.Error(), "nothing to undo") {
+		t.Errorf("Undo on empty history = %v", err)
+	}
+}
+
+// TestHistory_MoveKeepsCell checks that a reorder within a Grid keeps
+// the child's cell.
+func TestHistory_MoveKeepsCell(t *testing.T) {
+	d, root := historyTree(t)
+	grid := Find(root, "grid").(Container)
+	if err := d.Move(Find(root, "b"), grid, 0); err != nil {
+		t.Fatalf("Move: %v", err)
+	}
+	node, err := d.Encode(grid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if moved := node.Children[0]; moved.ID != "b" || moved.Layout["x"] != 1 || moved.Layout["y"] != 1 {
+		t.Errorf("moved child = %s %v; want b at 1,1", moved.ID, moved.Layout)
+	}
+}
+
+func TestHistory_Apply(t *testing.T) {
+	d, root := historyTree(t)
+	a := Find(root, "a").(*Static)
+	themed := a.Style()
+	before := documentJSON(t, d, root)
+
+	form := d.FormFor(a).(*StaticForm)
+	form.Text = "Changed"
+	form.Style().Foreground = "$red"
+	if err := d.Apply(a, form, nil); err != nil {
+		t.Fatalf("Apply: %v", err)
+	}
+	if a.Text != "Changed" || a.Style() == themed {
+		t.Fatalf("Apply did not store the form: %q", a.Text)
+	}
+	after := documentJSON(t, d, root)
+	checkUndoRedo(t, d, root, before, after, "apply Static#a")
+
+	if _, err := d.Undo(); err != nil {
+		t.Fatal(err)
+	}
+	if a.Style() != themed {
+		t.Error("Undo did not reinstate the themed style")
+	}
+	// A new change discards the redo stack.
+	if _, err := d.Add(root, "Static"); err != nil {
+		t.Fatal(err)
+	}
+	if d.CanRedo() {
+		t.Error("CanRedo after a new change")
+	}
+}
+
+func TestHistory_Clipboard(t *testing.T) {
+	d, root := historyTree(t)
+	grid := Find(root, "grid").(Container)
+	box := Find(root, "box").(Container)
+
+	if _, err := d.Paste(grid, nil); err == nil || !strings.Contains(err.Error(), "clipboard is empty") {
+		t.Errorf("Paste on empty clipboard = %v", err)
+	}
+	if err := d.Copy(grid); err != nil {
+		t.Fatalf("Copy: %v", err)
+	}
+	Find(root, "a").(*Static).Text = "Edited"
+
+	pasted, err := d.Paste(box, themes.TokyoNight())
+	if err != nil {
+		t.Fatalf("Paste: %v", err)
+	}
+	if pasted == grid || pasted.Parent() != box {
+		t.Fatalf("pasted = %v under %v; want a copy under the box", pasted, pasted.Parent())
+	}
+	if pasted.ID() != "grid-2" {
+		t.Errorf("pasted ID = %q; want grid-2", pasted.ID())
+	}
+	if a, ok := Find(pasted.(Container), "a-2").(*Static); !ok || a.Text != "A" {
+		t.Errorf("pasted child a-2 = %v; want a copy of a as it was copied", Find(pasted.(Container), "a-2"))
+	}
+
+	// Pasting the same subtree again yields fresh IDs; b-2 is taken
+	// by the copy of the grid.
+	if err := d.Cut(Find(root, "b")); err != nil {
+		t.Fatalf("Cut: %v", err)
+	}
+	if Find(root, "b") != nil {
+		t.Fatal("Cut left b in the tree")
+	}
+	first, err := d.Paste(grid, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := d.Paste(grid, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.ID() != "b" || second.ID() != "b-3" {
+		t.Errorf("pasted IDs = %q, %q; want b, b-3", first.ID(), second.ID())
+	}
+	node, err := d.Encode(grid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if last := node.Children[len(node.Children)-1]; last.Layout["x"] != 1 || last.Layout["y"] != 1 {
+		t.Errorf("pasted layout = %v; want the cell of b", last.Layout)
+	}
+
+	for _, want := range []string{"paste Static#b-3", "paste Static#b", "cut Static#b"} {
+		if got, err := d.Undo(); err != nil || got != want {
+			t.Fatalf("Undo = %q, %v; want %q", got, err, want)
+		}
+	}
+	if Find(root, "b") == nil || Find(root, "b-3") != nil {
+		t.Error("undoing the cut and pastes did not restore b")
+	}
+}
@@ -12,11 +12,13 @@
 //   - popup.go (this file) — public Open entrypoint, session state,
 //     popup chrome layout, Ctrl+Space key binding.
 //   - tree-pane.go — left pane: widget tree + add/remove/move
-//     toolbar actions.
+//     toolbar actions, undo/redo and cut/copy/paste.
+//   - history.go — headless edit history (Undo / Redo) and the
+//     subtree clipboard the tree-pane actions drive.
 //   - details-pane.go, pane-*.go — right pane: General / Layout /
 //     Style / Info tabs; rebuildPane orchestrator.
 //   - dialogs.go — add-child picker and project-settings dialogs;
-//     save and header/dirty/status mutators.
+//     open/save/generate and header/dirty/status mutators.
 //   - project.go, defaults.go — Project codegen-output settings and
 //     the 37-entry default kind table.
 //   - helpers.go — pure helpers (widgetKind, idSuffix, treeLabel, …)
@@ -78,7 +80,9 @@ type session struct {
 }
 
 // Open attaches a designer popup to ui that edits target.
-// Ctrl+Space toggles the popup; ESC closes it. The popup is built
+// Ctrl+Space toggles the popup; ESC closes it. Inside the popup
+// Ctrl+Z / Ctrl+Y undo and redo edits, Ctrl+X / Ctrl+C / Ctrl+V cut,
+// copy and paste the selected subtree. The popup is built
 // eagerly so the first Ctrl+Space is instantaneous.
 //
 // theme is applied to freshly-built form widgets inside the popup.
@@ -143,6 +147,7 @@ func (s *session) buildPopup() {
 		Static("header-spacer-1", "  ").
 		Static("header-theme", s.proj.Theme).
 		Spacer().Hint(-1, 0).
+		Button("open-btn", "Open").
 		Button("save-btn", "Save").
 		Button("generate-btn", "Generate").
 		Button("run-btn", "Run").
@@ -212,12 +217,16 @@ func (s *session) wireActions() {
 		s.reset()
 		return false
 	})
+	core.MustFind[*widgets.Button](s.popup, "open-btn").On(widgets.EvtActivate, func(_ core.Widget, _ core.Event, _ ...any) bool {
+		s.open()
+		return false
+	})
 	core.MustFind[*widgets.Button](s.popup, "save-btn").On(widgets.EvtActivate, func(_ core.Widget, _ core.Event, _ ...any) bool {
 		s.save()
 		return false
 	})
 	core.MustFind[*widgets.Button](s.popup, "generate-btn").On(widgets.EvtActivate, func(_ core.Widget, _ core.Event, _ ...any) bool {
-		s.save()
+		s.generate()
 		return false
 	})
 	core.MustFind[*widgets.Button](s.popup, "run-btn").On(widgets.EvtActivate, func(_ core.Widget, _ core.Event, _ ...any) bool {
@@ -248,36 +257,45 @@ func (s *session) wireActions() {
 		return false
 	})
 
-	// Alt+1..4 jumps to the matching detail tab. Bound to the popup
-	// so it works anywhere inside; the handler returns true on a
-	// match so the framework doesn't treat the digit as input to a
-	// focused field.
+	// Alt+1..4 jumps to the matching detail tab; Ctrl+Z / Ctrl+Y
+	// undo and redo, Ctrl+X / Ctrl+C / Ctrl+V cut, copy and paste the
+	// selected subtree. Bound to the popup so the keys work anywhere
+	// inside; inputs and editors consume their own undo and clipboard
+	// keys first, so these only fire when the focused widget passes
+	// them on. The handler returns true on a match so the framework
+	// doesn't treat the digit as input to a focused field, and so
+	// Ctrl+C doesn't reach the global quit binding.
 	s.popup.On(widgets.EvtKey, func(_ core.Widget, _ core.Event, data ...any) bool {
 		if len(data) == 0 {
 			return false
 		}
 		ev, ok := data[0].(*tcell.EventKey)
-		if !ok || ev.Key() != tcell.KeyRune {
-			return false
-		}
-		if ev.Modifiers()&tcell.ModAlt == 0 {
+		if !ok {
 			return false
 		}
-		switch ev.Str() {
-		case "1":
+		switch zw.KeyName(ev) {
+		case "Alt-1":
 			s.tabs.Set(0)
-			return true
-		case "2":
+		case "Alt-2":
 			s.tabs.Set(1)
-			return true
-		case "3":
+		case "Alt-3":
 			s.tabs.Set(2)
-			return true
-		case "4":
+		case "Alt-4":
 			s.tabs.Set(3)
-			return true
+		case "Ctrl-Z":
+			s.undo()
+		case "Ctrl-Y", "Ctrl-Shift-Z":
+			s.redo()
+		case "Ctrl-X":
+			s.cut()
+		case "Ctrl-C":
+			s.copy()
+		case "Ctrl-V":
+			s.paste()
+		default:
+			return false
 		}
-		return false
+		return true
 	})
 
 	// Empty-state placeholders so the popup is well-formed before
@@ -1,11 +1,12 @@
 package designer
 
-// Project holds the codegen output settings the designer's Settings
+// Project holds the document and codegen output settings the designer's Settings
 // dialog edits. Fields are mutated in place; pass a *Project to Open
 // so edits survive across the popup's lifetime.
 type Project struct {
 	Name     string // shown in the popup header band
-	OutPath  string // file written by Save / Generate
+	Path     string // UI document written by Save and read by Open
+	OutPath  string // Go source written by Generate
 	Package  string // emitted package declaration
 	FuncName string // emitted func wrapper name
 	Theme    string // theme label shown in the header (display only)
@@ -17,6 +18,7 @@ type Project struct {
 func DefaultProject() *Project {
 	return &Project{
 		Name:     "untitled.go",
+		Path:     "/tmp/designer-out.json",
 		OutPath:  "/tmp/designer-out.go",
 		Package:  "main",
 		FuncName: "BuildUI",
@@ -0,0 +1,826 @@
+package designer
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tekugo/zeichenwerk/core"
+)
+
+// FormatGo reads Go source as a document: the widget tree built by the
+// first builder chain or compose tree in the file, such as the
+// BuildUI function GenerateFile writes and zw init scaffolds. Go
+// source can only be read; GenerateFile writes it.
+const FormatGo = "go"
+
+// constructor describes how the arguments of one widget constructor
+// map to the fields of its kind. Parameters name the document field an
+// argument is stored in and follow the id (builder) or the id and
+// class (compose):
+//
+//   - ""        the argument is not part of the form and is skipped,
+//     e.g. the render function of a Deck
+//   - "name"    a scalar value
+//   - "name#"   a count, stored as that many "-1" sizes (Grid)
+//   - "name*"   a string list: the remaining arguments of a builder
+//     call, a []string literal in compose
+type constructor struct {
+	kind      string
+	container bool
+	noID      bool           // HRule and VRule take no id
+	builder   []string       // parameters in builder chains
+	compose   []string       // parameters in compose trees
+	set       map[string]any // fields implied by the constructor name
+}
+
+// constructors maps the Builder methods and compose functions that
+// create widgets to their kinds. Both APIs share the names; where their
+// parameters differ, the entry lists both.
+var constructors = map[string]constructor{}
+
+func init() {
+	add := func(name, kind string, container bool, params ...string) {
+		constructors[name] = constructor{kind: kind, container: container, builder: params, compose: params}
+	}
+	for _, name := range []string{"Box", "Card", "Dialog", "Viewport"} {
+		add(name, name, true, "title")
+	}
+	add("Collapsible", "Collapsible", true, "title", "expanded")
+	add("Responsive", "Responsive", true)
+	constructors["Switcher"] = constructor{kind: "Switcher", container: true, builder: []string{""}}
+	constructors["Grid"] = constructor{kind: "Grid", container: true,
+		builder: []string{"rows#", "columns#", "lines"},
+		compose: []string{"rows", "columns", "lines"}}
+	constructors["HFlex"] = constructor{kind: "Flex", container: true,
+		builder: []string{"alignment", "spacing"}, compose: []string{"alignment", "spacing"},
+		set: map[string]any{"vertical": false}}
+	constructors["VFlex"] = constructor{kind: "Flex", container: true,
+		builder: []string{"alignment", "spacing"}, compose: []string{"alignment", "spacing"},
+		set: map[string]any{"vertical": true}}
+	constructors["HRule"] = constructor{kind: "Rule", noID: true,
+		builder: []string{"borderStyle"}, compose: []string{"borderStyle"},
+		set: map[string]any{"horizontal": true}}
+	constructors["VRule"] = constructor{kind: "Rule", noID: true,
+		builder: []string{"borderStyle"}, compose: []string{"borderStyle"},
+		set: map[string]any{"horizontal": false}}
+
+	for _, name := range []string{"Static", "Button", "Digits", "Styled"} {
+		add(name, name, false, "text")
+	}
+	for _, name := range []string{"Breadcrumb", "Editor", "Filter", "Marquee", "Slider", "Splitter", "Terminal", "Tree", "Typewriter"} {
+		add(name, name, false)
+	}
+	add("Checkbox", "Checkbox", false, "text", "checked")
+	add("Clock", "Clock", false, "interval", "format", "prefix")
+	add("Indicator", "Indicator", false, "level", "label")
+	add("Progress", "Progress", false, "horizontal")
+	add("Scanner", "Scanner", false, "width", "glyphs")
+	add("Spinner", "Spinner", false, "sequence")
+	add("Text", "Text", false, "content", "follow", "max")
+	add("Table", "Table", false, "", "cellNav")
+	add("Deck", "Deck", false, "", "itemHeight")
+	add("Tiles", "Tiles", false, "", "tileWidth", "tileHeight")
+	add("TreeFS", "TreeFS", false, "path", "dirsOnly")
+	add("TreeWidgets", "TreeWidgets", false, "")
+	add("Input", "Input", false, "params*")
+	add("Typeahead", "Typeahead", false, "params*")
+	add("List", "List", false, "itemsRaw*")
+	add("Combo", "Combo", false, "itemsRaw*")
+	add("Radio", "Radio", false, "optionsRaw*")
+	add("Select", "Select", false, "optionsRaw*")
+	add("Shortcuts", "Shortcuts", false, "pairsRaw*")
+	constructors["Tabs"] = constructor{kind: "Tabs", builder: []string{"tabsRaw*"}}
+}
+
+// readSource parses Go source and returns the document node of the
+// first widget tree it finds: a builder chain starting with NewBuilder,
+// or a compose UI or Build call. Identifiers may be package-qualified
+// (z.NewBuilder, core.Stretch), so hand-written files that import the
+// packages by name are read as well as the dot-importing generated
+// ones. Calls the forms cannot represent, such as event handlers, are
+// rejected rather than dropped, since the designer writes the file back
+// without them; comments, including the TODO notes of the code
+// generator, are ignored.
+func readSource(r io.Reader) (*Node, error) {
+	src, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", src, 0)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", core.ErrInvalidDocument, err)
+	}
+	p := &sourceParser{fset: fset}
+	var node *Node
+	ast.Inspect(file, func(n ast.Node) bool {
+		if node != nil || err != nil {
+			return false
+		}
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		if calls := chain(call); len(calls) > 1 && callName(calls[0]) == "NewBuilder" {
+			node, err = p.builder(calls[1:])
+			return false
+		}
+		if name, ok := funcName(call); ok && (name == "UI" || name == "Build") && len(call.Args) > 1 {
+			node, err = p.compose(call)
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	if node == nil {
+		return nil, fmt.Errorf("%w: no builder chain or compose tree found", core.ErrInvalidDocument)
+	}
+	return node, nil
+}
+
+// sourceParser holds the file set for error positions.
+type sourceParser struct {
+	fset *token.FileSet
+}
+
+// ---- Builder chains ---------------------------------------------------------
+
+// builder replays the calls of a builder chain following NewBuilder the
+// way Builder does: the class is kept for all following widgets, a cell
+// until the next Cell call, and an item or breakpoint for the next
+// widget only.
+func (p *sourceParser) builder(calls []*ast.CallExpr) (*Node, error) {
+	var root, current *Node
+	var stack []*Node
+	var class string
+	var cell, item, point map[string]any
+
+	for _, call := range calls {
+		name := callName(call)
+		if c, ok := constructors[name]; ok {
+			node, _, err := p.construct(call, c, false)
+			if err != nil {
+				return nil, err
+			}
+			node.Class = class
+			switch {
+			case root == nil:
+				root = node
+			case len(stack) == 0:
+				return nil, p.errorf(call, "%s outside of a container", name)
+			default:
+				parent := stack[len(stack)-1]
+				switch parent.Kind {
+				case "Grid":
+					node.Layout = maps.Clone(cell)
+				case "Flex":
+					node.Layout = item
+				case "Responsive":
+					node.Layout = point
+				}
+				parent.Children = append(parent.Children, node)
+			}
+			item, point = nil, nil
+			if c.container {
+				stack = append(stack, node)
+			}
+			current = node
+			continue
+		}
+
+		var err error
+		switch name {
+		case "Class":
+			class, err = p.str(call, 0)
+		case "Cell":
+			cell, err = p.layout(call, "x", "y", "w", "h")
+		case "Item":
+			item, err = p.flexItem(call)
+		case "Breakpoint":
+			point, err = p.layout(call, "minWidth", "minHeight")
+		case "End":
+			if len(stack) > 1 {
+				current = stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+			}
+		case "Build", "Container", "Run":
+		case "Rows", "Columns":
+			if current == nil || current.Kind != "Grid" {
+				return nil, p.errorf(call, "%s outside of a Grid", name)
+			}
+			var sizes []any
+			if sizes, err = p.values(call.Args); err == nil {
+				current.Fields = setValue(current.Fields, strings.ToLower(name), join(sizes, ", "))
+			}
+		default:
+			if current == nil {
+				return nil, p.errorf(call, "%s before the first widget", name)
+			}
+			err = p.option(current, call, false)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if root == nil {
+		return nil, fmt.Errorf("%w: builder chain creates no widget", core.ErrInvalidDocument)
+	}
+	return root, nil
+}
+
+// ---- Compose trees ----------------------------------------------------------
+
+// compose reads the option tree of a compose UI or Build call, which
+// must create exactly one root widget.
+func (p *sourceParser) compose(call *ast.CallExpr) (*Node, error) {
+	var holder Node
+	for _, arg := range call.Args[1:] {
+		if err := p.composeOption(&holder, arg, nil); err != nil {
+			return nil, err
+		}
+	}
+	if len(holder.Children) != 1 || holder.Children[0].Layout != nil {
+		return nil, p.errorf(call, "compose tree must have exactly one root widget")
+	}
+	return holder.Children[0], nil
+}
+
+// composeOption applies one option expression to widget: a constructor
+// adds a child with the given layout, Cell, Item and Breakpoint wrap a
+// child in a layout, anything else sets a field or style of widget. The
+// holder of the root widget has no kind and takes no other options.
+func (p *sourceParser) composeOption(widget *Node, expr ast.Expr, layout map[string]any) error {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok {
+		return p.errorf(expr, "unsupported option %s", p.source(expr))
+	}
+	name := callName(call)
+	if c, ok := constructors[name]; ok {
+		node, options, err := p.construct(call, c, true)
+		if err != nil {
+			return err
+		}
+		node.Layout = layout
+		widget.Children = append(widget.Children, node)
+		for _, option := range options {
+			if err := p.composeOption(node, option, nil); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	var err error
+	switch name {
+	case "Cell":
+		layout, err = p.layout(call, "x", "y", "w", "h", "")
+	case "Item":
+		layout, err = p.flexItem(call)
+	case "Breakpoint":
+		layout, err = p.layout(call, "minWidth", "minHeight", "")
+	default:
+		if layout != nil || widget.Kind == "" {
+			return p.errorf(call, "%s outside of a widget", name)
+		}
+		return p.option(widget, call, true)
+	}
+	if err != nil {
+		return err
+	}
+	return p.composeOption(widget, call.Args[len(call.Args)-1], layout)
+}
+
+// ---- Widgets and options ----------------------------------------------------
+
+// construct creates the node of a constructor call and returns the
+// arguments following its parameters, the options of a compose call.
+func (p *sourceParser) construct(call *ast.CallExpr, c constructor, compose bool) (*Node, []ast.Expr, error) {
+	node := &Node{Kind: c.kind}
+	args := call.Args
+	params := c.builder
+	if compose {
+		params = c.compose
+	}
+	// Compose options follow the parameters, so all of them must be
+	// given; builder calls may leave out variadic trailing ones.
+	required := 0
+	if !c.noID {
+		required++
+	}
+	if compose {
+		required += 1 + len(params)
+	}
+	if len(args) < required {
+		return nil, nil, p.errorf(call, "%s: expected %d arguments, got %d", callName(call), required, len(args))
+	}
+
+	var err error
+	if !c.noID {
+		if node.ID, err = p.str(call, 0); err != nil {
+			return nil, nil, err
+		}
+		args = args[1:]
+	}
+	if compose {
+		if node.Class, err = p.strExpr(args[0]); err != nil {
+			return nil, nil, err
+		}
+		args = args[1:]
+	}
+
+	for _, param := range params {
+		if name, ok := strings.CutSuffix(param, "*"); ok {
+			var items []any
+			if compose {
+				items, err = p.list(args[0])
+				args = args[1:]
+			} else {
+				items, err = p.values(args)
+				args = nil
+			}
+			if err != nil {
+				return nil, nil, err
+			}
+			setList(node, name, items)
+			continue
+		}
+		if len(args) == 0 {
+			break // optional trailing builder arguments
+		}
+		arg := args[0]
+		args = args[1:]
+		switch {
+		case param == "":
+		case strings.HasSuffix(param, "#"):
+			n, err := p.intExpr(arg)
+			if err != nil {
+				return nil, nil, err
+			}
+			node.Fields = setValue(node.Fields, strings.TrimSuffix(param, "#"), join(slices.Repeat([]any{-1}, n), ", "))
+		default:
+			v, err := p.value(arg)
+			if err != nil {
+				return nil, nil, err
+			}
+			setField(node, param, v)
+		}
+	}
+	for name, v := range c.set {
+		node.Fields = setValue(node.Fields, name, v)
+	}
+	if !compose && len(args) > 0 {
+		return nil, nil, p.errorf(args[0], "%s: too many arguments", callName(call))
+	}
+	return node, args, nil
+}
+
+// option applies a chained builder method or a compose option that sets
+// a field or style of node.
+func (p *sourceParser) option(node *Node, call *ast.CallExpr, compose bool) error {
+	name := callName(call)
+	switch name {
+	case "Hint":
+		layout, err := p.layout(call, "hintW", "hintH")
+		if err != nil {
+			return err
+		}
+		maps.Copy(ensure(&node.Fields), layout)
+		return nil
+	case "Flag":
+		if len(call.Args) == 0 || len(call.Args) > 2 {
+			return p.errorf(call, "Flag: expected a flag and an optional value")
+		}
+		flag, ok := funcName(&ast.CallExpr{Fun: call.Args[0]})
+		if !ok || !strings.HasPrefix(flag, "Flag") {
+			return p.errorf(call.Args[0], "Flag: unsupported flag %s", p.source(call.Args[0]))
+		}
+		value := true
+		if len(call.Args) == 2 {
+			v, err := p.value(call.Args[1])
+			if err != nil {
+				return err
+			}
+			if value, ok = v.(bool); !ok {
+				return p.errorf(call.Args[1], "Flag: expected a boolean")
+			}
+		}
+		node.Fields = setValue(node.Fields, fieldKey(strings.TrimPrefix(flag, "Flag")), value)
+		return nil
+	case "Padding", "Margin":
+		sides, err := p.values(call.Args)
+		if err != nil {
+			return err
+		}
+		node.Style = setValue(node.Style, strings.ToLower(name), join(sides, " "))
+		return nil
+	case "Border", "Font", "Foreground", "Background", "Fg", "Bg":
+		if len(call.Args) != 1 {
+			return p.errorf(call, "%s: only the style of the widget itself is supported", name)
+		}
+		v, err := p.str(call, 0)
+		if err != nil {
+			return err
+		}
+		key := map[string]string{"Fg": "foreground", "Bg": "background"}[name]
+		if key == "" {
+			key = strings.ToLower(name)
+		}
+		node.Style = setValue(node.Style, key, v)
+		return nil
+	}
+
+	if compose {
+		switch name {
+		case "Total", "Value", "Step":
+			layout, err := p.layout(call, strings.ToLower(name))
+			if err == nil {
+				maps.Copy(ensure(&node.Fields), layout)
+			}
+			return err
+		case "Range":
+			layout, err := p.layout(call, "minimum", "maximum")
+			if err == nil {
+				maps.Copy(ensure(&node.Fields), layout)
+			}
+			return err
+		case "LineNumbers":
+			v, err := p.value(call.Args[0])
+			if err != nil {
+				return err
+			}
+			width := 0
+			if v == true {
+				width = 3 // Editor.ShowLineNumbers' default width
+			}
+			node.Fields = setValue(node.Fields, "lineNumbers", width)
+			return nil
+		}
+	}
+	return p.errorf(call, "unsupported call %s", name)
+}
+
+// layout reads the integer arguments of call into the given keys. An
+// empty key skips the argument, such as the wrapped option of a compose
+// Cell.
+func (p *sourceParser) layout(call *ast.CallExpr, keys ...string) (map[string]any, error) {
+	if len(call.Args) != len(keys) {
+		return nil, p.errorf(call, "%s: expected %d arguments, got %d", callName(call), len(keys), len(call.Args))
+	}
+	m := make(map[string]any)
+	for i, key := range keys {
+		if key == "" {
+			continue
+		}
+		n, err := p.intExpr(call.Args[i])
+		if err != nil {
+			return nil, err
+		}
+		m[key] = n
+	}
+	return m, nil
+}
+
+// flexItem reads the FlexItem literal of an Item call.
+func (p *sourceParser) flexItem(call *ast.CallExpr) (map[string]any, error) {
+	if len(call.Args) == 0 {
+		return nil, p.errorf(call, "Item: missing FlexItem")
+	}
+	lit, ok := call.Args[0].(*ast.CompositeLit)
+	if !ok {
+		return nil, p.errorf(call.Args[0], "Item: expected a FlexItem literal")
+	}
+	m := make(map[string]any)
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			return nil, p.errorf(elt, "Item: FlexItem fields must be named")
+		}
+		key, ok := kv.Key.(*ast.Ident)
+		if !ok {
+			return nil, p.errorf(kv.Key, "Item: unsupported field %s", p.source(kv.Key))
+		}
+		v, err := p.value(kv.Value)
+		if err != nil {
+			return nil, err
+		}
+		m[fieldKey(key.Name)] = v
+	}
+	return m, nil
+}
+
+// ---- Values -----------------------------------------------------------------
+
+// value evaluates a constant argument: string, integer and boolean
+// literals, durations built from the time package, []string and []int
+// literals, and named constants such as Stretch or core.Info, which
+// yield their name in lower case as the forms store them.
+func (p *sourceParser) value(expr ast.Expr) (any, error) {
+	switch e := expr.(type) {
+	case *ast.BasicLit:
+		switch e.Kind {
+		case token.STRING:
+			return strconv.Unquote(e.Value)
+		case token.INT:
+			return strconv.Atoi(e.Value)
+		}
+	case *ast.ParenExpr:
+		return p.value(e.X)
+	case *ast.UnaryExpr:
+		if v, err := p.value(e.X); err == nil && e.Op == token.SUB {
+			switch n := v.(type) {
+			case int:
+				return -n, nil
+			case time.Duration:
+				return -n, nil
+			}
+		}
+	case *ast.Ident:
+		switch e.Name {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		case "nil":
+			return nil, nil
+		}
+		return strings.ToLower(e.Name), nil
+	case *ast.SelectorExpr:
+		if pkg, ok := e.X.(*ast.Ident); ok {
+			if pkg.Name != "time" {
+				return strings.ToLower(e.Sel.Name), nil
+			}
+			if d, ok := durations[e.Sel.Name]; ok {
+				return d, nil
+			}
+		}
+	case *ast.BinaryExpr:
+		if e.Op == token.MUL {
+			x, err := p.value(e.X)
+			if err != nil {
+				return nil, err
+			}
+			y, err := p.value(e.Y)
+			if err != nil {
+				return nil, err
+			}
+			if product, ok := multiply(x, y); ok {
+				return product, nil
+			}
+		}
+	case *ast.CallExpr:
+		// Conversions: time.Duration(n) and Level("name").
+		if name, ok := funcName(e); ok && len(e.Args) == 1 {
+			v, err := p.value(e.Args[0])
+			if err != nil {
+				return nil, err
+			}
+			switch n := v.(type) {
+			case int:
+				if name == "Duration" {
+					return time.Duration(n), nil
+				}
+			case string:
+				if name == "Level" {
+					return n, nil
+				}
+			}
+		}
+	case *ast.CompositeLit:
+		return p.values(e.Elts)
+	}
+	return nil, p.errorf(expr, "unsupported value %s", p.source(expr))
+}
+
+// values evaluates a list of arguments.
+func (p *sourceParser) values(exprs []ast.Expr) ([]any, error) {
+	values := make([]any, 0, len(exprs))
+	for _, expr := range exprs {
+		v, err := p.value(expr)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, v)
+	}
+	return values, nil
+}
+
+// list evaluates a []string literal or nil.
+func (p *sourceParser) list(expr ast.Expr) ([]any, error) {
+	v, err := p.value(expr)
+	if err != nil {
+		return nil, err
+	}
+	items, ok := v.([]any)
+	if v != nil && !ok {
+		return nil, p.errorf(expr, "expected a list, got %s", p.source(expr))
+	}
+	return items, nil
+}
+
+// str evaluates argument i of call as a string.
+func (p *sourceParser) str(call *ast.CallExpr, i int) (string, error) {
+	if i >= len(call.Args) {
+		return "", p.errorf(call, "%s: missing argument", callName(call))
+	}
+	return p.strExpr(call.Args[i])
+}
+
+func (p *sourceParser) strExpr(expr ast.Expr) (string, error) {
+	v, err := p.value(expr)
+	if err != nil {
+		return "", err
+	}
+	s, ok := v.(string)
+	if !ok {
+		return "", p.errorf(expr, "expected a string, got %s", p.source(expr))
+	}
+	return s, nil
+}
+
+func (p *sourceParser) intExpr(expr ast.Expr) (int, error) {
+	v, err := p.value(expr)
+	if err != nil {
+		return 0, err
+	}
+	n, ok := v.(int)
+	if !ok {
+		return 0, p.errorf(expr, "expected an integer, got %s", p.source(expr))
+	}
+	return n, nil
+}
+
+// errorf returns an error wrapping core.ErrInvalidDocument that starts
+// with the line and column of node. Calls are located by their name,
+// since a chained call starts where the whole chain does.
+func (p *sourceParser) errorf(node ast.Node, format string, args ...any) error {
+	pos := node.Pos()
+	if call, ok := node.(*ast.CallExpr); ok {
+		if sel, ok := call.Fun.(*ast.SelectorExpr); ok {
+			pos = sel.Sel.Pos()
+		}
+	}
+	return fmt.Errorf("%w: %s: %s", core.ErrInvalidDocument, p.fset.Position(pos), fmt.Sprintf(format, args...))
+}
+
+// source returns a short description of an expression for messages.
+func (p *sourceParser) source(expr ast.Expr) string {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return e.Name
+	case *ast.SelectorExpr:
+		return p.source(e.X) + "." + e.Sel.Name
+	case *ast.CallExpr:
+		return p.source(e.Fun) + "(…)"
+	}
+	return fmt.Sprintf("%T", expr)
+}
+
+// ---- Helpers ----------------------------------------------------------------
+
+// durations holds the units of the time package.
+var durations = map[string]time.Duration{
+	"Nanosecond":  time.Nanosecond,
+	"Microsecond": time.Microsecond,
+	"Millisecond": time.Millisecond,
+	"Second":      time.Second,
+	"Minute":      time.Minute,
+	"Hour":        time.Hour,
+}
+
+// multiply evaluates n * unit for integers and durations.
+func multiply(x, y any) (any, bool) {
+	switch a := x.(type) {
+	case int:
+		switch b := y.(type) {
+		case int:
+			return a * b, true
+		case time.Duration:
+			return time.Duration(a) * b, true
+		}
+	case time.Duration:
+		if b, ok := y.(int); ok {
+			return a * time.Duration(b), true
+		}
+	}
+	return nil, false
+}
+
+// chain unwinds a method chain a(…).b(…).c(…) into its calls, innermost
+// first.
+func chain(call *ast.CallExpr) []*ast.CallExpr {
+	calls := []*ast.CallExpr{call}
+	for {
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			break
+		}
+		if call, ok = sel.X.(*ast.CallExpr); !ok {
+			break
+		}
+		calls = append(calls, call)
+	}
+	slices.Reverse(calls)
+	return calls
+}
+
+// callName returns the name of the called function or method.
+func callName(call *ast.CallExpr) string {
+	switch fun := call.Fun.(type) {
+	case *ast.Ident:
+		return fun.Name
+	case *ast.SelectorExpr:
+		return fun.Sel.Name
+	}
+	return ""
+}
+
+// funcName returns the name of a plain or package-qualified function
+// call, and false for method calls on the result of another call.
+func funcName(call *ast.CallExpr) (string, bool) {
+	switch fun := call.Fun.(type) {
+	case *ast.Ident:
+		return fun.Name, true
+	case *ast.SelectorExpr:
+		if _, ok := fun.X.(*ast.Ident); ok {
+			return fun.Sel.Name, true
+		}
+	}
+	return "", false
+}
+
+// setField stores a constructor argument. Durations are stored in the
+// notation time.ParseDuration reads, lists joined the way the forms
+// show them: the lines of a Text on separate lines, Grid sizes comma-
+// separated.
+func setField(node *Node, name string, v any) {
+	switch value := v.(type) {
+	case nil:
+		return
+	case time.Duration:
+		v = value.String()
+	case []any:
+		if name == "content" {
+			v = join(value, "\n")
+		} else {
+			v = join(value, ", ")
+		}
+	}
+	node.Fields = setValue(node.Fields, name, v)
+}
+
+// setList stores the string list of a List, Radio, Shortcuts, Tabs or
+// Input constructor. Radio and Select options and Shortcuts pairs are
+// read in pairs; Input parameters are text, placeholder and mask.
+func setList(node *Node, name string, items []any) {
+	switch name {
+	case "params":
+		// Without a mask parameter NewInput masks with "*".
+		params := []any{"", "", "*"}
+		copy(params, items)
+		for i, key := range []string{"text", "placeholder", "mask"} {
+			node.Fields = setValue(node.Fields, key, fmt.Sprint(params[i]))
+		}
+		return
+	case "optionsRaw", "pairsRaw":
+		var pairs []any
+		for i := 0; i+1 < len(items); i += 2 {
+			key, text := fmt.Sprint(items[i]), fmt.Sprint(items[i+1])
+			if name == "optionsRaw" && key == text {
+				pairs = append(pairs, key)
+			} else {
+				pairs = append(pairs, key+":"+text)
+			}
+		}
+		items = pairs
+	}
+	if len(items) > 0 {
+		node.Fields = setValue(node.Fields, name, join(items, ", "))
+	}
+}
+
+// join formats values and joins them with sep.
+func join(values []any, sep string) string {
+	parts := make([]string, len(values))
+	for i, v := range values {
+		parts[i] = fmt.Sprint(v)
+	}
+	return strings.Join(parts, sep)
+}
+
+// ensure returns *m, creating the map if necessary.
+func ensure(m *map[string]any) map[string]any {
+	if *m == nil {
+		*m = make(map[string]any)
+	}
+	return *m
+}
@@ -0,0 +1,195 @@
+package designer_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	. "github.com/tekugo/zeichenwerk/core"
+	"github.com/tekugo/zeichenwerk/designer"
+	. "github.com/tekugo/zeichenwerk/widgets"
+)
+
+// sourceDocument describes a tree touching the constructor parameters,
+// chain calls and layouts the Go reader maps back to forms. It is built
+// from a document so that every widget starts from its form's defaults,
+// as widgets created in the designer do.
+const sourceDocument = `
+kind: Flex
+id: root
+fields: {vertical: true, alignment: stretch, spacing: 1}
+children:
+  - kind: Grid
+    id: grid
+    fields: {rows: "1, -1", columns: "-1, -1", lines: true}
+    children:
+      - kind: Static
+        id: title
+        fields: {text: Hello, hintW: 20, hintH: 1}
+        style: {foreground: $cyan, padding: "0 1"}
+        layout: {x: 0, y: 0, w: 2, h: 1}
+      - kind: Input
+        id: name
+        fields: {placeholder: Your name, mask: "*", masked: true}
+        layout: {x: 0, y: 1, w: 1, h: 1}
+      - kind: Checkbox
+        id: agree
+        fields: {text: Agree, checked: true}
+        layout: {x: 1, y: 1, w: 1, h: 1}
+  - kind: List
+    id: items
+    fields: {itemsRaw: "a, b, c"}
+    layout: {grow: 1, min: 3}
+  - kind: Clock
+    id: clock
+    fields: {interval: 500ms, format: "15:04:05"}
+  - kind: Indicator
+    id: state
+    fields: {level: warning, label: Busy}
+  - kind: Radio
+    id: size
+    fields: {optionsRaw: "s:Small, l:Large", hidden: true}
+  - kind: Rule
+    fields: {horizontal: true, borderStyle: thin}
+  - kind: Button
+    id: ok
+    class: primary
+    fields: {text: OK}
+`
+
+// documentJSON encodes a widget tree as a comparable JSON document.
+func documentJSON(t *testing.T, d *designer.Designer, w Widget) string {
+	t.Helper()
+	node, err := d.Encode(w)
+	if err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+	out, err := json.MarshalIndent(node, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(out)
+}
+
+// TestSource_RoundTrip reads the file GenerateFile writes back and
+// checks that it builds the same document as the original tree.
+func TestSource_RoundTrip(t *testing.T) {
+	for _, mode := range []string{designer.ModeBuilder, designer.ModeCompose} {
+		t.Run(mode, func(t *testing.T) {
+			reader := designer.NewDesigner(nil)
+			designer.RegisterDefaults(reader)
+			tree, err := reader.Read(strings.NewReader(sourceDocument), designer.FormatYAML, nil)
+			if err != nil {
+				t.Fatalf("Read: %v", err)
+			}
+			d := designer.NewDesigner(tree.(Container))
+			designer.RegisterDefaults(d)
+
+			var src bytes.Buffer
+			if err := d.GenerateFile(mode, &src, "ui", "BuildUI"); err != nil {
+				t.Fatalf("GenerateFile: %v", err)
+			}
+			node, err := designer.ReadNode(bytes.NewReader(src.Bytes()), designer.FormatGo)
+			if err != nil {
+				t.Fatalf("ReadNode: %v\n%s", err, src.String())
+			}
+			w, err := d.Decode(node, nil)
+			if err != nil {
+				t.Fatalf("Decode: %v\n%s", err, src.String())
+			}
+			if got, want := documentJSON(t, d, w), documentJSON(t, d, tree); got != want {
+				t.Errorf("documents differ:\n%s\n---\n%s\n--- source ---\n%s", got, want, src.String())
+			}
+		})
+	}
+}
+
+// TestSource_HandWritten reads the file zw init scaffolds and a compose
+// variant that imports the packages by name.
+func TestSource_HandWritten(t *testing.T) {
+	cases := []struct {
+		name, src string
+	}{
+		{"scaffold", `package ui
+
+import (
+	. "github.com/tekugo/zeichenwerk"
+	. "github.com/tekugo/zeichenwerk/core"
+	"github.com/tekugo/zeichenwerk/themes"
+)
+
+func BuildUI() *UI {
+	return NewBuilder(themes.TokyoNight()).
+		VFlex("root", Stretch, 0).
+			Static("title", "Hello, zeichenwerk").
+		End(). // VFlex#root
+		Build()
+}
+`},
+		{"compose", `package ui
+
+import (
+	"github.com/tekugo/zeichenwerk/compose"
+	"github.com/tekugo/zeichenwerk/core"
+)
+
+func header(theme *core.Theme) core.Widget {
+	return compose.Build(theme,
+		compose.VFlex("root", "", core.Stretch, 0,
+			compose.Static("title", "", "Hello, zeichenwerk",
+				compose.Font("bold"),
+			),
+		),
+	)
+}
+`},
+	}
+	d := designer.NewDesigner(nil)
+	designer.RegisterDefaults(d)
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			node, err := designer.ReadNode(strings.NewReader(c.src), designer.FormatGo)
+			if err != nil {
+				t.Fatalf("ReadNode: %v", err)
+			}
+			if node.Kind != "Flex" || node.ID != "root" || node.Fields["vertical"] != true || node.Fields["alignment"] != "stretch" {
+				t.Errorf("root = %s %q %v; want vertical stretch Flex root", node.Kind, node.ID, node.Fields)
+			}
+			if len(node.Children) != 1 || node.Children[0].Fields["text"] != "Hello, zeichenwerk" {
+				t.Fatalf("children = %v; want the title", node.Children)
+			}
+			w, err := d.Decode(node, nil)
+			if err != nil {
+				t.Fatalf("Decode: %v", err)
+			}
+			if title, ok := Find(w.(Container), "title").(*Static); !ok || title.Text != "Hello, zeichenwerk" {
+				t.Errorf("title = %v", Find(w.(Container), "title"))
+			}
+		})
+	}
+}
+
+func TestSource_Errors(t *testing.T) {
+	cases := []struct {
+		name, src, want string
+	}{
+		{"no tree", "package ui\n\nfunc f() int { return 1 }\n", "no builder chain or compose tree"},
+		{"syntax", "package ui\n\nfunc f( {\n", "expected"},
+		{"handler", "package ui\n\nvar _ = NewBuilder(theme).\n\tButton(\"ok\", \"OK\").\n\tOn(EvtActivate, h).\n\tBuild()\n", "5:2: unsupported call On"},
+		{"selector style", "package ui\n\nvar _ = UI(theme, Static(\"a\", \"\", \"A\", Fg(\":focused\", \"$red\")))\n", "only the style of the widget itself"},
+		{"two roots", "package ui\n\nvar _ = Build(theme, Static(\"a\", \"\", \"A\"), Static(\"b\", \"\", \"B\"))\n", "exactly one root widget"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			_, err := designer.ReadNode(strings.NewReader(c.src), designer.FormatGo)
+			if !errors.Is(err, ErrInvalidDocument) {
+				t.Fatalf("err = %v; want ErrInvalidDocument", err)
+			}
+			if !strings.Contains(err.Error(), c.want) {
+				t.Errorf("err = %q; want it to contain %q", err, c.want)
+			}
+		})
+	}
+}
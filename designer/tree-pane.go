@@ -185,3 +185,104 @@ func findTreeNodeFor(tree *widgets.Tree, w core.Widget) *widgets.TreeNode {
 	}
 	return walk(root)
 }
+
+// undo reverts the most recent recorded change and redo re-applies
+// the last undone one. Both relayout the whole target, since a change
+// may have touched any container, and keep the selection on the
+// current widget while it is still part of the tree.
+func (s *session) undo() {
+	label, err := s.d.Undo()
+	if err != nil {
+		s.setStatus("undo failed: " + err.Error())
+		return
+	}
+	s.afterHistory("undid " + label)
+}
+
+func (s *session) redo() {
+	label, err := s.d.Redo()
+	if err != nil {
+		s.setStatus("redo failed: " + err.Error())
+		return
+	}
+	s.afterHistory("redid " + label)
+}
+
+// afterHistory is the shared tail of undo and redo.
+func (s *session) afterHistory(status string) {
+	widgets.Relayout(s.target)
+	s.refreshTree()
+	s.setDirty(true)
+	s.setStatus(status)
+	w := s.currentWidget
+	if w == nil || !s.inTree(w) {
+		w = s.target
+	}
+	s.selectAfterMutation(w)
+}
+
+// inTree reports whether w is the target or one of its descendants.
+func (s *session) inTree(w core.Widget) bool {
+	for ; w != nil; w = w.Parent() {
+		if w == s.target {
+			return true
+		}
+	}
+	return false
+}
+
+// cut moves the current selection to the clipboard. Like delete it
+// refuses the root and snaps the selection up to the parent.
+func (s *session) cut() {
+	if s.currentWidget == nil {
+		s.setStatus("Cut: no widget selected")
+		return
+	}
+	if s.currentWidget == s.target {
+		s.setStatus("Cut: cannot remove the root")
+		return
+	}
+	victim := s.currentWidget
+	parent := victim.Parent()
+	if err := s.d.Cut(victim); err != nil {
+		s.setStatus("cut failed: " + err.Error())
+		return
+	}
+	widgets.Relayout(parent)
+	s.refreshTree()
+	s.setDirty(true)
+	s.setStatus(fmt.Sprintf("cut %s%s", widgetKind(victim), idSuffix(victim)))
+	s.selectAfterMutation(parent)
+}
+
+// copy puts the current selection and its subtree on the clipboard.
+// The tree is unchanged, so nothing is marked dirty.
+func (s *session) copy() {
+	if s.currentWidget == nil {
+		s.setStatus("Copy: no widget selected")
+		return
+	}
+	if err := s.d.Copy(s.currentWidget); err != nil {
+		s.setStatus("copy failed: " + err.Error())
+		return
+	}
+	s.setStatus(fmt.Sprintf("copied %s%s", widgetKind(s.currentWidget), idSuffix(s.currentWidget)))
+}
+
+// paste appends a fresh copy of the clipboard under the container
+// resolveAddParent picks, the same place Add would put a new widget,
+// and selects it.
+func (s *session) paste() {
+	parent := s.resolveAddParent()
+	child, err := s.d.Paste(parent, s.theme)
+	if err != nil {
+		s.setStatus("paste failed: " + err.Error())
+		return
+	}
+	widgets.Relayout(parent)
+	s.refreshTree()
+	s.setDirty(true)
+	s.setStatus(fmt.Sprintf("pasted %s%s under %s%s",
+		widgetKind(child), idSuffix(child), widgetKind(parent), idSuffix(parent)))
+	s.selectAfterMutation(child)
+}
@@ -60,6 +60,10 @@ type WidgetForm interface {
 	// only the constructor + chain + style; the codegen walker
 	// writes children and the closing ".End()" with a trailing
 	// "// Kind#id" marker.
+	//
+	// In ModeCompose, Emit writes the open constructor call
+	// "Kind(id, class, args,\n" and one option per line ending with
+	// ",\n"; the walker appends the children and closes the call.
 	Emit(w io.Writer, mode string) error
 }
 
@@ -0,0 +1,308 @@
+package zeichenwerk
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/gdamore/tcell/v3"
+	. "github.com/tekugo/zeichenwerk/core"
+	"github.com/tekugo/zeichenwerk/renderer"
+)
+
+// UpdateGolden makes Headless.Golden rewrite golden files instead of
+// comparing against them. It is initialised from the ZW_UPDATE_GOLDEN
+// environment variable, so `ZW_UPDATE_GOLDEN=1 go test ./...` refreshes
+// all snapshots.
+var UpdateGolden = os.Getenv("ZW_UPDATE_GOLDEN") != ""
+
+// Tester is the subset of testing.TB used by Headless.Golden. It keeps the
+// testing package out of the library while accepting *testing.T and
+// *testing.B directly.
+type Tester interface {
+	Helper()
+	Errorf(format string, args ...any)
+	Fatalf(format string, args ...any)
+}
+
+// Headless drives a UI without a terminal. The UI renders into an
+// in-memory screen of a fixed size, events are injected programmatically,
+// and every injection is followed by a synchronous drain of the event,
+// redraw and refresh queues, so the screen content is deterministic once
+// a call returns. This makes it possible to write end-to-end tests of
+// whole screens, popups and focus flows without a TTY.
+//
+// Usage:
+//
+//	ui := NewUI(theme, root)
+//	h := NewHeadless(ui, 80, 24)
+//	h.Type("hello").Key(tcell.KeyTab)
+//	h.Golden(t, "testdata/form.golden")
+type Headless struct {
+	ui     *UI
+	screen *renderer.MemoryScreen
+}
+
+// NewHeadless attaches an in-memory screen of the given size to ui,
+// performs the initial layout, focuses the first focusable widget and
+// renders the first frame. The UI must not be started with Run.
+//
+// Parameters:
+//   - ui:            The UI to drive.
+//   - width, height: Screen size in cells.
+func NewHeadless(ui *UI, width, height int) *Headless {
+	h := &Headless{
+		ui:     ui,
+		screen: renderer.NewMemoryScreen(width, height),
+	}
+	ui.memory = h.screen
+	ui.renderer = NewRenderer(h.screen, ui.theme)
+	ui.renderer.Set("white", "black", "")
+	ui.SetBounds(0, 0, width, height)
+	ui.Layout()
+	ui.SetFocus("first")
+	ui.drain()
+	return h
+}
+
+// ---- Accessors ------------------------------------------------------------
+
+// Cell returns the cell at the absolute screen position (x, y).
+func (h *Headless) Cell(x, y int) renderer.Cell {
+	return h.screen.Cell(x, y)
+}
+
+// Focus returns the currently focused widget, or nil.
+func (h *Headless) Focus() Widget {
+	return h.ui.focus
+}
+
+// Layers returns the number of layers on the UI's layer stack (base layer
+// plus open popups).
+func (h *Headless) Layers() int {
+	return len(h.ui.layers)
+}
+
+// Quit reports whether the application requested to quit, for example by
+// calling UI.Quit or through a quit key binding.
+func (h *Headless) Quit() bool {
+	select {
+	case <-h.ui.quit:
+		return true
+	default:
+		return false
+	}
+}
+
+// Screen returns the underlying in-memory screen.
+func (h *Headless) Screen() *renderer.MemoryScreen {
+	return h.screen
+}
+
+// UI returns the driven UI.
+func (h *Headless) UI() *UI {
+	return h.ui
+}
+
+// ---- Event Injection ------------------------------------------------------
+
+// Click moves the mouse to (x, y), presses and releases the primary
+// button. The initial move is needed because the UI only treats a press
+// as a click on the widget that is already hovered.
+func (h *Headless) Click(x, y int) *Headless {
+	return h.Send(
+		tcell.NewEventMouse(x, y, tcell.ButtonNone, tcell.ModNone),
+		tcell.NewEventMouse(x, y, tcell.Button1, tcell.ModNone),
+		tcell.NewEventMouse(x, y, tcell.ButtonNone, tcell.ModNone),
+	)
+}
+
+// Key injects a special key (arrows, Enter, Escape, Ctrl-keys, ...)
+// optionally combined with modifiers.
+func (h *Headless) Key(key tcell.Key, mods ...tcell.ModMask) *Headless {
+	mod := tcell.ModNone
+	for _, m := range mods {
+		mod |= m
+	}
+	return h.Send(tcell.NewEventKey(key, "", mod))
+}
+
+// Mouse injects a single mouse event at (x, y) with the given button mask.
+func (h *Headless) Mouse(x, y int, buttons tcell.ButtonMask, mods ...tcell.ModMask) *Headless {
+	mod := tcell.ModNone
+	for _, m := range mods {
+		mod |= m
+	}
+	return h.Send(tcell.NewEventMouse(x, y, buttons, mod))
+}
+
+// Paste injects text as a bracketed paste: a paste-start event, one rune
+// key event per character and a paste-end event.
+func (h *Headless) Paste(text string) *Headless {
+	events := []tcell.Event{tcell.NewEventPaste(true)}
+	for _, ch := range text {
+		events = append(events, runeEvent(ch))
+	}
+	events = append(events, tcell.NewEventPaste(false))
+	return h.Send(events...)
+}
+
+// Resize changes the size of the in-memory screen and delivers the
+// corresponding resize event, which re-lays out the UI.
+func (h *Headless) Resize(width, height int) *Headless {
+	h.screen.Resize(width, height)
+	return h.Send(tcell.NewEventResize(width, height))
+}
+
+// Send hands each event to UI.Handle and drains all queued work after
+// every event. Events are ignored once the UI has been asked to quit.
+func (h *Headless) Send(events ...tcell.Event) *Headless {
+	for _, event := range events {
+		if h.Quit() {
+			break
+		}
+		h.ui.Handle(event)
+		h.ui.drain()
+	}
+	return h
+}
+
+// Settle drains all pending events, redraws and refreshes. Injection
+// methods already settle; call it after changing widgets directly.
+func (h *Headless) Settle() *Headless {
+	h.ui.drain()
+	return h
+}
+
+// Type injects one key event per rune of text. Newlines and tabs are sent
+// as Enter and Tab keys.
+func (h *Headless) Type(text string) *Headless {
+	events := make([]tcell.Event, 0, len(text))
+	for _, ch := range text {
+		events = append(events, runeEvent(ch))
+	}
+	return h.Send(events...)
+}
+
+// runeEvent converts a rune into the key event a terminal would report.
+func runeEvent(ch rune) *tcell.EventKey {
+	switch ch {
+	case '\n', '\r':
+		return tcell.NewEventKey(tcell.KeyEnter, "", tcell.ModNone)
+	case '\t':
+		return tcell.NewEventKey(tcell.KeyTab, "", tcell.ModNone)
+	}
+	return tcell.NewEventKey(tcell.KeyRune, string(ch), tcell.ModNone)
+}
+
+// ---- Snapshots ------------------------------------------------------------
+
+// Text returns the glyphs of the screen, one line per row with trailing
+// blanks removed.
+func (h *Headless) Text() string {
+	return h.screen.String()
+}
+
+// Snapshot renders the complete screen state as text suitable for golden
+// files. It consists of a header with size and cursor, the glyph grid, a
+// grid of style keys of the same shape and a legend mapping each key to
+// its foreground, background and font. Rows are framed with '|' so that
+// trailing blanks survive editors and diffs.
+//
+// Example:
+//
+//	screen 12x2 cursor=5,0 bar
+//	-- text --
+//	|Name: Bob   |
+//	|            |
+//	-- style --
+//	|aaaaaabbbbbb|
+//	|aaaaaaaaaaaa|
+//	-- legend --
+//	a fg=white bg=black font=
+//	b fg=black bg=cyan font=bold
+func (h *Headless) Snapshot() string {
+	const keys = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+	width, height := h.screen.Size()
+	var b strings.Builder
+
+	cx, cy, cursor := h.screen.Cursor()
+	if cursor == "" {
+		fmt.Fprintf(&b, "screen %dx%d cursor=none\n", width, height)
+	} else {
+		fmt.Fprintf(&b, "screen %dx%d cursor=%d,%d %s\n", width, height, cx, cy, cursor)
+	}
+
+	b.WriteString("-- text --\n")
+	for y := range height {
+		b.WriteByte('|')
+		for x := range width {
+			b.WriteString(h.screen.Cell(x, y).Ch)
+		}
+		b.WriteString("|\n")
+	}
+
+	type style struct{ fg, bg, font string }
+	index := make(map[style]int)
+	var legend []style
+
+	b.WriteString("-- style --\n")
+	for y := range height {
+		b.WriteByte('|')
+		for x := range width {
+			cell := h.screen.Cell(x, y)
+			s := style{cell.Fg, cell.Bg, cell.Font}
+			i, ok := index[s]
+			if !ok {
+				i = len(legend)
+				index[s] = i
+				legend = append(legend, s)
+			}
+			if i < len(keys) {
+				b.WriteByte(keys[i])
+			} else {
+				b.WriteByte('?')
+			}
+		}
+		b.WriteString("|\n")
+	}
+
+	b.WriteString("-- legend --\n")
+	for i, s := range legend {
+		key := "?"
+		if i < len(keys) {
+			key = keys[i : i+1]
+		}
+		fmt.Fprintf(&b, "%s fg=%s bg=%s font=%s\n", key, s.fg, s.bg, s.font)
+	}
+	return b.String()
+}
+
+// Golden compares Snapshot against the golden file at path. When
+// UpdateGolden is set, the file (and missing parent directories) is
+// written instead and the comparison always succeeds.
+func (h *Headless) Golden(t Tester, path string) {
+	t.Helper()
+	actual := []byte(h.Snapshot())
+
+	if UpdateGolden {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("golden %s: %v", path, err)
+		}
+		if err := os.WriteFile(path, actual, 0o644); err != nil {
+			t.Fatalf("golden %s: %v", path, err)
+		}
+		return
+	}
+
+	expected, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("golden %s: %v (run with ZW_UPDATE_GOLDEN=1 to create it)", path, err)
+	}
+	if !bytes.Equal(expected, actual) {
+		t.Errorf("golden %s mismatch\n--- expected ---\n%s\n--- actual ---\n%s", path, expected, actual)
+	}
+}
@@ -0,0 +1,629 @@
+package zeichenwerk
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gdamore/tcell/v3"
+	. "github.com/tekugo/zeichenwerk/core"
+	"github.com/tekugo/zeichenwerk/themes"
+	. "github.com/tekugo/zeichenwerk/widgets"
+)
+
+// headlessTheme returns a small theme with distinct colours so snapshots
+// show focus changes without depending on a built-in theme.
+func headlessTheme() *Theme {
+	theme := NewTheme()
+	theme.SetColors(map[string]string{
+		"$bg": "black",
+		"$fg": "white",
+		"$hl": "yellow",
+	})
+	theme.AddStyles(
+		NewStyle("").WithColors("$fg", "$bg"),
+		NewStyle("input").WithColors("$fg", "blue"),
+		NewStyle("input:focused").WithColors("$bg", "$hl").WithCursor("bar"),
+		NewStyle("button:focused").WithColors("$bg", "$hl").WithFont("bold"),
+	)
+	return theme
+}
+
+func newHeadlessForm(t *testing.T) *Headless {
+	t.Helper()
+	ui := NewBuilder(headlessTheme()).
+		VFlex("root", Stretch, 0).
+		Static("title", "Name").
+		Input("name").Hint(10, 1).
+		Button("ok", "OK").
+		End().
+		Build()
+	return NewHeadless(ui, 12, 4)
+}
+
+func TestHeadless_InitialFrame(t *testing.T) {
+	h := newHeadlessForm(t)
+	if !strings.HasPrefix(h.Text(), "Name") {
+		t.Errorf("Text() = %q; want first line to start with Name", h.Text())
+	}
+	if ID(h.Focus()) != "name" {
+		t.Errorf("focus = %q; want %q", ID(h.Focus()), "name")
+	}
+	if _, _, cursor := h.Screen().Cursor(); cursor == "" {
+		t.Error("cursor should be visible in the focused input")
+	}
+}
+
+func TestHeadless_TypeAndTab(t *testing.T) {
+	h := newHeadlessForm(t)
+	h.Type("Bob").Key(tcell.KeyTab)
+
+	input := MustFind[*Input](h.UI(), "name")
+	if input.Get() != "Bob" {
+		t.Errorf("input = %q; want %q", input.Get(), "Bob")
+	}
+	if ID(h.Focus()) != "ok" {
+		t.Errorf("focus = %q after Tab; want %q", ID(h.Focus()), "ok")
+	}
+	if got := h.Screen().Line(1); !strings.HasPrefix(got, "Bob") {
+		t.Errorf("line 1 = %q; want it to start with Bob", got)
+	}
+}
+
+func TestHeadless_Click(t *testing.T) {
+	h := newHeadlessForm(t)
+	clicked := false
+	Find(h.UI(), "ok").On(EvtActivate, func(_ Widget, _ Event, _ ...any) bool {
+		clicked = true
+		return true
+	})
+	x, y, _, _ := Find(h.UI(), "ok").Bounds()
+	h.Click(x, y)
+	if ID(h.Focus()) != "ok" {
+		t.Errorf("focus = %q after click; want %q", ID(h.Focus()), "ok")
+	}
+	h.Key(tcell.KeyEnter)
+	if !clicked {
+		t.Error("Enter on focused button should activate it")
+	}
+}
+
+func TestHeadless_PopupAndEscape(t *testing.T) {
+	h := newHeadlessForm(t)
+	h.UI().Confirm("Sure?", "Really?", nil, nil)
+	h.Settle()
+	if h.Layers() != 2 {
+		t.Fatalf("Layers() = %d after Confirm; want 2", h.Layers())
+	}
+	h.Key(tcell.KeyEscape)
+	if h.Layers() != 1 {
+		t.Errorf("Layers() = %d after Escape; want 1", h.Layers())
+	}
+	if ID(h.Focus()) != "name" {
+		t.Errorf("focus = %q after closing popup; want %q", ID(h.Focus()), "name")
+	}
+}
+
+func TestHeadless_DatePicker(t *testing.T) {
+	ui := NewBuilder(headlessTheme()).
+		VFlex("root", Stretch, 0).
+		DatePicker("due").Hint(16, 1).
+		End().
+		Build()
+	h := NewHeadless(ui, 40, 20)
+	picker := Find(ui, "due").(*DatePicker)
+	picker.SetShowTime(true)
+	picker.SetDate(time.Date(2026, time.March, 10, 9, 0, 0, 0, time.Local))
+	var changed time.Time
+	picker.On(EvtChange, func(_ Widget, _ Event, data ...any) bool {
+		changed = data[0].(time.Time)
+		return true
+	})
+
+	h.Key(tcell.KeyEnter)
+	if h.Layers() != 2 || ID(h.Focus()) != "date-picker-calendar" {
+		t.Fatalf("layers = %d, focus = %q after Enter; want the calendar popup", h.Layers(), ID(h.Focus()))
+	}
+	if _, y, _, _ := Find(ui, "date-picker-popup").Bounds(); y != 1 {
+		t.Errorf("popup at y = %d; want below the picker", y)
+	}
+
+	// Esc closes the popup without a change.
+	h.Key(tcell.KeyEscape)
+	if h.Layers() != 1 || !changed.IsZero() || ID(h.Focus()) != "due" {
+		t.Fatalf("after Esc: layers = %d, focus = %q; want the popup closed", h.Layers(), ID(h.Focus()))
+	}
+
+	// The date comes from the calendar, the time from the spinner.
+	h.Key(tcell.KeyEnter).Key(tcell.KeyRight).Key(tcell.KeyTab).Type("1730").Key(tcell.KeyEnter)
+	want := time.Date(2026, time.March, 11, 17, 30, 0, 0, time.Local)
+	if h.Layers() != 1 || !changed.Equal(want) || !picker.Date().Equal(want) {
+		t.Errorf("picked %s; want %s", changed, want)
+	}
+}
+
+func TestHeadless_Quit(t *testing.T) {
+	ui := NewBuilder(headlessTheme()).
+		VFlex("root", Stretch, 0).
+		Static("title", "Nothing to focus").
+		End().
+		Build()
+	h := NewHeadless(ui, 20, 2)
+	if h.Quit() {
+		t.Fatal("Quit() should be false initially")
+	}
+	h.Type("q")
+	if !h.Quit() {
+		t.Error("Quit() should be true after pressing q")
+	}
+}
+
+func TestHeadless_Resize(t *testing.T) {
+	h := newHeadlessForm(t)
+	h.Resize(20, 6)
+	_, _, w, hh := h.UI().Bounds()
+	if w != 20 || hh != 6 {
+		t.Errorf("UI bounds = %dx%d after resize; want 20x6", w, hh)
+	}
+	_, _, w, _ = Find(h.UI(), "name").Bounds()
+	if w != 20 {
+		t.Errorf("stretched input width = %d; want 20", w)
+	}
+}
+
+func TestHeadless_Snapshot_Legend(t *testing.T) {
+	h := newHeadlessForm(t)
+	snap := h.Snapshot()
+	for _, want := range []string{"screen 12x4", "-- text --", "|Name        |", "-- legend --", "bg=yellow"} {
+		if !strings.Contains(snap, want) {
+			t.Errorf("snapshot missing %q:\n%s", want, snap)
+		}
+	}
+}
+
+func TestHeadless_Golden(t *testing.T) {
+	h := newHeadlessForm(t)
+	h.Type("Bob").Key(tcell.KeyTab)
+	h.Golden(t, "testdata/headless-form.golden")
+}
+
+func TestHeadless_EditorFindBar(t *testing.T) {
+	ui := NewBuilder(headlessTheme()).
+		VFlex("root", Stretch, 0).
+		Editor("editor").Hint(0, -1).
+		End().
+		Build()
+	editor := MustFind[*Editor](ui, "editor")
+	editor.Load("alpha beta\nbeta gamma")
+	h := NewHeadless(ui, 20, 4)
+
+	h.Key(tcell.KeyCtrlF)
+	if h.Layers() != 2 {
+		t.Fatalf("Layers() = %d after Ctrl+F; want 2", h.Layers())
+	}
+	h.Type("beta")
+	if editor.SelectionText() != "beta" {
+		t.Fatalf("selection = %q after typing the query; want %q", editor.SelectionText(), "beta")
+	}
+	if got := h.Screen().Line(3); !strings.Contains(got, "1/2") {
+		t.Errorf("find bar = %q; want status 1/2", got)
+	}
+
+	h.Key(tcell.KeyEnter)
+	if got := h.Screen().Line(3); !strings.Contains(got, "2/2") {
+		t.Errorf("find bar = %q after Enter; want status 2/2", got)
+	}
+
+	h.Key(tcell.KeyTab).Type("delta")
+	h.Send(tcell.NewEventKey(tcell.KeyRune, "a", tcell.ModAlt))
+	if got := editor.Text(); got != "alpha delta\ndelta gamma" {
+		t.Errorf("text after Alt+A = %q; want all matches replaced", got)
+	}
+
+	h.Key(tcell.KeyEscape)
+	if h.Layers() != 1 || ID(h.Focus()) != "editor" {
+		t.Errorf("after Esc: layers=%d focus=%q; want 1, editor", h.Layers(), ID(h.Focus()))
+	}
+	if query, _ := editor.Search(); query != "" {
+		t.Errorf("closing the find bar should clear the active search; got %q", query)
+	}
+}
+
+func TestHeadless_TerminalCopy(t *testing.T) {
+	ui := NewBuilder(headlessTheme()).
+		VFlex("root", Stretch, 0).
+		Terminal("term").Hint(0, -1).
+		End().
+		Build()
+	term := MustFind[*Terminal](ui, "term")
+	h := NewHeadless(ui, 20, 3)
+	term.Write([]byte("hello world"))
+
+	h.Mouse(6, 0, tcell.Button1).Mouse(10, 0, tcell.Button1).Mouse(10, 0, tcell.ButtonNone)
+	if got := string(h.Screen().Clipboard()); got != "world" {
+		t.Errorf("clipboard = %q after dragging; want %q", got, "world")
+	}
+}
+
+func TestHeadless_TableEdit(t *testing.T) {
+	provider := NewArrayTableProvider([]string{"Name", "Age"}, [][]string{{"Alice", "30"}, {"Bob", "25"}})
+	provider.SetEditable(true, 1)
+	ui := NewBuilder(headlessTheme()).
+		VFlex("root", Stretch, 0).
+		Table("table", provider, true).Hint(0, -1).
+		End().
+		Build()
+	table := MustFind[*Table](ui, "table")
+	table.SetValidator(func(_, col int, value string) error {
+		if col == 1 && strings.Trim(value, "0123456789") != "" {
+			return errors.New("not a number")
+		}
+		return nil
+	})
+	var changes []any
+	table.On(EvtChange, func(_ Widget, _ Event, data ...any) bool {
+		changes = append(changes, data...)
+		return true
+	})
+	h := NewHeadless(ui, 20, 6)
+
+	h.Key(tcell.KeyDown).Key(tcell.KeyRight).Key(tcell.KeyF2)
+	if h.Layers() != 2 || !table.Editing() {
+		t.Fatalf("Layers() = %d after F2; want the cell editor open", h.Layers())
+	}
+	h.Type("x").Key(tcell.KeyEnter)
+	if h.Layers() != 2 {
+		t.Fatal("invalid value closed the editor")
+	}
+	if got := h.Screen().Line(4); !strings.Contains(got, "not a number") {
+		t.Errorf("row below the cell = %q; want the validation error", got)
+	}
+
+	h.Key(tcell.KeyBackspace).Key(tcell.KeyBackspace).Type("6").Key(tcell.KeyEnter)
+	if h.Layers() != 1 || ID(h.Focus()) != "table" {
+		t.Fatalf("after Enter: layers=%d focus=%q; want 1, table", h.Layers(), ID(h.Focus()))
+	}
+	if got := provider.Str(1, 1); got != "26" {
+		t.Errorf("provider value = %q; want %q", got, "26")
+	}
+	if want := []any{1, 1, "25", "26"}; len(changes) != 4 || changes[0] != want[0] || changes[1] != want[1] || changes[2] != want[2] || changes[3] != want[3] {
+		t.Errorf("EvtChange data = %v; want %v", changes, want)
+	}
+
+	h.Key(tcell.KeyEnter).Type("7").Key(tcell.KeyEscape)
+	if h.Layers() != 1 || provider.Str(1, 1) != "26" {
+		t.Errorf("Esc: layers=%d value=%q; want the edit discarded", h.Layers(), provider.Str(1, 1))
+	}
+}
+
+func TestHeadless_Post(t *testing.T) {
+	h := newHeadlessForm(t)
+	title := MustFind[*Static](h.UI(), "title")
+
+	var wg sync.WaitGroup
+	ran := 0
+	for range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			h.UI().Post(func() { ran++ })
+		}()
+	}
+	wg.Wait()
+
+	var order []int
+	for i := range 3 {
+		h.UI().Post(func() { order = append(order, i) })
+	}
+	h.UI().Post(func() { title.Set("Done") })
+	h.Settle()
+
+	if ran != 20 {
+		t.Errorf("ran %d posted closures; want 20", ran)
+	}
+	if len(order) != 3 || order[0] != 0 || order[1] != 1 || order[2] != 2 {
+		t.Errorf("order = %v; want [0 1 2]", order)
+	}
+	if got := h.Screen().Line(0); !strings.HasPrefix(got, "Done") {
+		t.Errorf("line 0 = %q; want the posted label", got)
+	}
+}
+
+func TestHeadless_QueueUpdate(t *testing.T) {
+	h := newHeadlessForm(t)
+	title := MustFind[*Static](h.UI(), "title")
+	done := make(chan struct{})
+	go func() {
+		h.UI().QueueUpdate(func() { title.Text = "Set" })
+		close(done)
+	}()
+	<-done
+	h.Settle()
+	if got := h.Screen().Line(0); !strings.HasPrefix(got, "Set") {
+		t.Errorf("line 0 = %q; QueueUpdate should refresh the screen", got)
+	}
+}
+
+func TestHeadless_AnimationOnEventLoop(t *testing.T) {
+	ui := NewBuilder(headlessTheme()).
+		VFlex("root", Stretch, 0).
+		Spinner("spinner", "a b c").
+		End().
+		Build()
+	h := NewHeadless(ui, 4, 1)
+	spinner := MustFind[*Spinner](ui, "spinner")
+	spinner.Start(time.Millisecond)
+	defer spinner.Stop()
+
+	// Frames are posted to the loop and only advance while it runs.
+	deadline := time.Now().Add(2 * time.Second)
+	for spinner.Current() == "a" && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+		h.Settle()
+	}
+	if got := spinner.Current(); got == "a" {
+		t.Fatal("spinner did not advance")
+	}
+	if got := h.Screen().Line(0); !strings.HasPrefix(got, spinner.Current()) {
+		t.Errorf("line 0 = %q; want the current frame %q", got, spinner.Current())
+	}
+}
+
+func TestHeadless_FlexCollapse(t *testing.T) {
+	ui := NewBuilder(headlessTheme()).
+		HFlex("root", Stretch, 1).
+		Item(FlexItem{Basis: 12, Min: 8, Shrink: 1, Collapse: true}).
+		Static("sidebar", "Sidebar").
+		Item(FlexItem{Min: 20, Grow: 1}).
+		Static("content", "Content").
+		End().
+		Build()
+	h := NewHeadless(ui, 40, 2)
+	if got := h.Screen().Line(0); !strings.HasPrefix(got, "Sidebar") {
+		t.Errorf("wide: line 0 = %q; want the sidebar first", got)
+	}
+
+	h.Resize(25, 2)
+	if got := h.Screen().Line(0); !strings.HasPrefix(got, "Content") {
+		t.Errorf("narrow: line 0 = %q; want the sidebar collapsed", got)
+	}
+
+	h.Resize(40, 2)
+	if got := h.Screen().Line(0); !strings.HasPrefix(got, "Sidebar") {
+		t.Errorf("wide again: line 0 = %q; want the sidebar back", got)
+	}
+}
+
+func TestHeadless_ResponsiveSwitch(t *testing.T) {
+	ui := NewBuilder(headlessTheme()).
+		Responsive("root").
+		VFlex("narrow", Stretch, 0).
+		Static("title", "Narrow").
+		Input("name").
+		End().
+		Breakpoint(40, 0).
+		HFlex("wide", Stretch, 1).
+		Static("title", "Wide").
+		Input("name").
+		End().
+		End().
+		Build()
+	narrow := MustFind[*Flex](ui, "narrow")
+	wide := MustFind[*Flex](ui, "wide")
+	h := NewHeadless(ui, 30, 4)
+	if got := h.Screen().Line(0); !strings.HasPrefix(got, "Narrow") {
+		t.Fatalf("line 0 = %q; want the narrow variant", got)
+	}
+
+	h.UI().Focus(Find(narrow, "name"))
+	h.Type("Ada")
+	h.Resize(60, 4)
+	if got := h.Screen().Line(0); !strings.HasPrefix(got, "Wide") {
+		t.Fatalf("line 0 = %q; want the wide variant", got)
+	}
+	input := Find(wide, "name").(*Input)
+	if h.Focus() != input {
+		t.Errorf("focus = %v; want the input of the wide variant", h.Focus())
+	}
+	if input.Get() != "Ada" {
+		t.Errorf("wide input = %q; want the value carried over", input.Get())
+	}
+
+	h.Type("!")
+	h.Resize(30, 4)
+	if got := Find(narrow, "name").(*Input).Get(); got != "Ada!" {
+		t.Errorf("narrow input = %q; want Ada!", got)
+	}
+}
+
+func TestHeadless_WatchTheme(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "theme.json")
+	write := func(doc string, at time.Time) {
+		if err := os.WriteFile(path, []byte(doc), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		// Bump the time so coarse file system clocks still see a change.
+		if err := os.Chtimes(path, at, at); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write(`{"styles": {"": {"foreground": "white", "background": "black"}}}`, time.Now().Add(-time.Hour))
+	theme, err := themes.LoadThemeFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ui := NewBuilder(theme).
+		VFlex("root", Stretch, 0).
+		Static("title", "Title").
+		End().
+		Build().
+		Debug()
+	h := NewHeadless(ui, 20, 2)
+	stop := ui.WatchTheme(path, 5*time.Millisecond)
+	defer stop()
+
+	// settle runs the loop until cond holds or the deadline passes.
+	settle := func(cond func() bool) bool {
+		deadline := time.Now().Add(2 * time.Second)
+		for !cond() && time.Now().Before(deadline) {
+			time.Sleep(5 * time.Millisecond)
+			h.Settle()
+		}
+		return cond()
+	}
+
+	write(`{"styles": {"": {"foreground": "white", "background": "black"}, "static": {"padding": [0, 0, 0, 2]}}}`, time.Now())
+	if !settle(func() bool { return ui.Theme() != theme }) {
+		t.Fatal("theme was not reloaded")
+	}
+	if got := h.Screen().Line(0); !strings.HasPrefix(got, "  Title") {
+		t.Errorf("line 0 = %q; want the padding of the new theme", got)
+	}
+
+	reloaded := ui.Theme()
+	write(`{"styles": {"static": {"foreground": "no-such-colour"}}}`, time.Now().Add(time.Hour))
+	logged := func() bool {
+		logs := ui.Logs()
+		return logs.Length() > 0 && logs.Str(0, 1) == "ERROR" &&
+			strings.HasPrefix(logs.Str(0, 3), "Theme reload failed")
+	}
+	if !settle(logged) {
+		t.Fatal("invalid theme was not logged")
+	}
+	if ui.Theme() != reloaded {
+		t.Error("invalid theme replaced the current one")
+	}
+}
+
+func TestHeadless_Monochrome(t *testing.T) {
+	ui := NewBuilder(headlessTheme()).
+		VFlex("root", Stretch, 0).
+		Input("name").
+		Button("ok", "OK").
+		End().
+		Build()
+	ui.SetColorProfile(ProfileMonochrome)
+	h := NewHeadless(ui, 20, 2)
+	h.UI().Focus(Find(ui, "name"))
+	h.Settle()
+
+	cell := h.Screen().Cell(0, 0)
+	if cell.Fg != "" || cell.Bg != "" {
+		t.Errorf("input colours = %q on %q; want none", cell.Fg, cell.Bg)
+	}
+	if !strings.Contains(cell.Font, "reverse") {
+		t.Errorf("focused input font = %q; want reverse", cell.Font)
+	}
+	if font := h.Screen().Cell(0, 1).Font; strings.Contains(font, "reverse") {
+		t.Errorf("button font = %q; only the focused widget should be reversed", font)
+	}
+}
+
+// TestHeadless_SplitterDrag drags a splitter into the neighbouring pane:
+// the UI routes the mouse events to the splitter while it holds the
+// capture.
+func TestHeadless_SplitterDrag(t *testing.T) {
+	ui := NewBuilder(headlessTheme()).
+		HFlex("root", Stretch, 0).
+		Static("left", "L").Hint(10, 0).
+		Splitter("split").
+		Static("right", "R").Hint(-1, 0).
+		End().
+		Build()
+	h := NewHeadless(ui, 31, 3)
+	var ratio float64
+	Find(ui, "split").On(EvtChange, func(_ Widget, _ Event, data ...any) bool {
+		ratio = data[0].(float64)
+		return true
+	})
+
+	h.Mouse(10, 1, tcell.ButtonNone).
+		Mouse(10, 1, tcell.Button1).
+		Mouse(18, 1, tcell.Button1).
+		Mouse(20, 1, tcell.ButtonNone)
+	if _, _, w, _ := Find(ui, "left").Bounds(); w != 20 {
+		t.Errorf("left width = %d; want 20", w)
+	}
+	if ratio != 20.0/30 {
+		t.Errorf("EvtChange ratio = %g; want 20/30", ratio)
+	}
+
+	// The capture ended with the drag: clicks reach the pane again.
+	h.Click(25, 1)
+	if _, _, w, _ := Find(ui, "left").Bounds(); w != 20 {
+		t.Errorf("left width after click = %d; want 20", w)
+	}
+}
+
+// TestHeadless_SplitterRatioNoSpace sets the ratio of a splitter whose
+// neighbours get no space: the deferred call gives up instead of posting
+// itself again, so Settle returns.
+func TestHeadless_SplitterRatioNoSpace(t *testing.T) {
+	ui := NewBuilder(headlessTheme()).
+		HFlex("root", Stretch, 0).
+		Static("left", "").Hint(-1, 0).
+		Splitter("split").
+		Static("right", "").Hint(-1, 0).
+		End().
+		Build()
+	h := NewHeadless(ui, 1, 3)
+	split := Find(ui, "split").(*Splitter)
+	if split.Ratio() != 0 {
+		t.Fatalf("Ratio = %g; want 0 without space", split.Ratio())
+	}
+
+	done := make(chan struct{})
+	go func() {
+		split.SetRatio(0.5)
+		h.Settle()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Settle did not return after SetRatio")
+	}
+}
+
+// TestHeadless_BoardDrag drags a card past the right edge of a board: the
+// board holds the mouse capture during the drag, so the release outside
+// still drops the card into the last column.
+func TestHeadless_BoardDrag(t *testing.T) {
+	ui := NewBuilder(headlessTheme()).
+		HFlex("root", Stretch, 0).
+		Board("board").Hint(21, 0).
+		Static("side", "S").Hint(-1, 0).
+		End().
+		Build()
+	h := NewHeadless(ui, 40, 10)
+	board := Find(ui, "board").(*Board)
+	board.SetColumnWidth(10)
+	todo := board.AddColumn("todo", "To Do")
+	todo.AddCard(&BoardCard{Title: "a"})
+	todo.AddCard(&BoardCard{Title: "b"})
+	done := board.AddColumn("done", "Done")
+	var change BoardChange
+	board.On(EvtChange, func(_ Widget, _ Event, data ...any) bool {
+		change = data[0].(BoardChange)
+		return true
+	})
+
+	h.Mouse(2, 2, tcell.ButtonNone).
+		Mouse(2, 2, tcell.Button1).
+		Mouse(12, 2, tcell.Button1).
+		Mouse(30, 2, tcell.Button1).
+		Mouse(30, 2, tcell.ButtonNone)
+	if todo.Len() != 1 || done.Len() != 1 || done.Cards()[0].Title != "a" {
+		t.Fatalf("columns after drop = %d, %d cards; want a moved to done", todo.Len(), done.Len())
+	}
+	if change.FromColumn != todo || change.ToColumn != done || change.ToIndex != 0 {
+		t.Errorf("EvtChange = %+v; want todo[0] → done[0]", change)
+	}
+	if board.FocusedCard() == nil || board.FocusedCard().Title != "a" {
+		t.Error("the cursor should follow the dropped card")
+	}
+}
@@ -0,0 +1,355 @@
+package zeichenwerk
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gdamore/tcell/v3"
+	. "github.com/tekugo/zeichenwerk/core"
+)
+
+// Built-in action names. The UI registers a handler for each of them; key
+// bindings refer to actions by name so that apps can rebind keys without
+// touching the code that implements the action.
+const (
+	ActionAppQuit       = "app.quit"       // quit the application
+	ActionCommandsOpen  = "commands.open"  // open the commands palette
+	ActionFocusNext     = "focus.next"     // move focus to the next widget
+	ActionFocusPrevious = "focus.previous" // move focus to the previous widget
+	ActionLayerClose    = "layer.close"    // close the topmost popup layer
+	ActionNone          = "none"           // swallow the key without doing anything
+
+	ActionNotificationsDismiss = "notifications.dismiss" // dismiss the newest notification
+	ActionNotificationsHistory = "notifications.history" // show the notification history
+)
+
+// Keymap is a table of key bindings. Each binding maps a key sequence —
+// one or more chords separated by spaces, such as "Ctrl-Q", "g g" or
+// "Ctrl-X Ctrl-S" — to the name of an action. Key sequences are stored in
+// the canonical form produced by ParseKeys, so "ctrl+x" and "Ctrl-X" name
+// the same binding.
+//
+// The UI consults the keymap of the topmost layer (see UI.SetLayerKeymap)
+// before the global keymap, and only for keys that were not consumed by
+// the focused widget or its parents. Binding a key to ActionNone in a
+// layer keymap hides a global binding while that layer is on top.
+type Keymap struct {
+	bindings map[string]string // canonical key sequence → action
+}
+
+// NewKeymap creates an empty keymap.
+func NewKeymap() *Keymap {
+	return &Keymap{bindings: make(map[string]string)}
+}
+
+// DefaultKeymap returns a new keymap with the framework's standard
+// bindings:
+//
+//   - Tab, Right, Down:        focus.next
+//   - Shift-Tab, Left, Up:     focus.previous
+//   - Esc:                     layer.close
+//   - Ctrl-C, Ctrl-Q, q, Q:    app.quit
+//
+// commands.open, notifications.dismiss and notifications.history are not
+// bound by default.
+func DefaultKeymap() *Keymap {
+	k := NewKeymap()
+	for keys, action := range map[string]string{
+		"Tab":       ActionFocusNext,
+		"Right":     ActionFocusNext,
+		"Down":      ActionFocusNext,
+		"Shift-Tab": ActionFocusPrevious,
+		"Left":      ActionFocusPrevious,
+		"Up":        ActionFocusPrevious,
+		"Esc":       ActionLayerClose,
+		"Ctrl-C":    ActionAppQuit,
+		"Ctrl-Q":    ActionAppQuit,
+		"q":         ActionAppQuit,
+		"Q":         ActionAppQuit,
+	} {
+		k.bindings[keys] = action
+	}
+	return k
+}
+
+// Action returns the action bound to the key sequence and whether a
+// binding exists.
+func (k *Keymap) Action(keys string) (string, bool) {
+	seq, err := ParseKeys(keys)
+	if err != nil {
+		return "", false
+	}
+	action, ok := k.bindings[seq]
+	return action, ok
+}
+
+// Bind binds a key sequence to an action, replacing any existing binding
+// for the same sequence. It returns an error wrapping ErrInvalidKey if the
+// sequence cannot be parsed.
+//
+// When both a sequence and one of its prefixes are bound (for example
+// "g" and "g g"), the shorter binding wins as soon as it is typed.
+func (k *Keymap) Bind(keys, action string) error {
+	seq, err := ParseKeys(keys)
+	if err != nil {
+		return err
+	}
+	k.bindings[seq] = action
+	return nil
+}
+
+// Bindings returns a copy of all bindings, keyed by canonical key
+// sequence.
+func (k *Keymap) Bindings() map[string]string {
+	return maps.Clone(k.bindings)
+}
+
+// Clone returns an independent copy of the keymap.
+func (k *Keymap) Clone() *Keymap {
+	return &Keymap{bindings: maps.Clone(k.bindings)}
+}
+
+// Extend copies every binding of other into the keymap, overriding
+// bindings for the same key sequence.
+func (k *Keymap) Extend(other *Keymap) {
+	maps.Copy(k.bindings, other.bindings)
+}
+
+// Keys returns all key sequences bound to action in canonical form,
+// shortest first and alphabetically within the same length.
+func (k *Keymap) Keys(action string) []string {
+	var result []string
+	for seq, a := range k.bindings {
+		if a == action {
+			result = append(result, seq)
+		}
+	}
+	slices.SortFunc(result, func(a, b string) int {
+		if len(a) != len(b) {
+			return len(a) - len(b)
+		}
+		return strings.Compare(a, b)
+	})
+	return result
+}
+
+// Unbind removes the binding for the key sequence. It reports whether a
+// binding was removed.
+func (k *Keymap) Unbind(keys string) bool {
+	seq, err := ParseKeys(keys)
+	if err != nil {
+		return false
+	}
+	if _, ok := k.bindings[seq]; !ok {
+		return false
+	}
+	delete(k.bindings, seq)
+	return true
+}
+
+// UnbindAction removes every binding of the action and returns the number
+// of bindings removed.
+func (k *Keymap) UnbindAction(action string) int {
+	n := 0
+	for seq, a := range k.bindings {
+		if a == action {
+			delete(k.bindings, seq)
+			n++
+		}
+	}
+	return n
+}
+
+// match looks up a canonical key sequence. It returns the bound action if
+// the sequence is bound exactly, and reports whether it is the prefix of a
+// longer bound sequence.
+func (k *Keymap) match(seq string) (action string, exact, prefix bool) {
+	action, exact = k.bindings[seq]
+	for bound := range k.bindings {
+		if strings.HasPrefix(bound, seq+" ") {
+			prefix = true
+			break
+		}
+	}
+	return action, exact, prefix
+}
+
+// ---- Key Names ------------------------------------------------------------
+
+// keyNames maps lower-case key names accepted by ParseKeys to their
+// canonical spelling. Single characters are not listed; they stand for
+// themselves.
+var keyNames = map[string]string{
+	"backspace": "Backspace",
+	"bs":        "Backspace",
+	"delete":    "Delete",
+	"del":       "Delete",
+	"down":      "Down",
+	"end":       "End",
+	"enter":     "Enter",
+	"return":    "Enter",
+	"esc":       "Esc",
+	"escape":    "Esc",
+	"home":      "Home",
+	"insert":    "Insert",
+	"ins":       "Insert",
+	"left":      "Left",
+	"pgdn":      "PgDn",
+	"pagedown":  "PgDn",
+	"pgup":      "PgUp",
+	"pageup":    "PgUp",
+	"right":     "Right",
+	"space":     "Space",
+	"tab":       "Tab",
+	"backtab":   "Shift-Tab",
+	"up":        "Up",
+}
+
+// ParseKeys parses a key sequence and returns it in canonical form. Chords
+// are separated by spaces; within a chord, modifiers and key are joined
+// with '-' or '+'. Modifiers (ctrl, alt, shift, case-insensitive) are
+// emitted in the order Ctrl, Alt, Shift. Named keys are case-insensitive
+// ("enter", "PgDn", "F5"); single characters are case-sensitive except
+// with Ctrl, where letters are upper-cased. Shift with a single character
+// is folded into the character itself ("Shift-a" becomes "A").
+//
+// Examples:
+//
+//	ParseKeys("ctrl+x ctrl+s") // "Ctrl-X Ctrl-S"
+//	ParseKeys("g g")           // "g g"
+//	ParseKeys("alt-Enter")     // "Alt-Enter"
+func ParseKeys(keys string) (string, error) {
+	fields := strings.Fields(keys)
+	if len(fields) == 0 {
+		return "", fmt.Errorf("%w: empty key sequence", ErrInvalidKey)
+	}
+	chords := make([]string, len(fields))
+	for i, field := range fields {
+		chord, err := parseChord(field)
+		if err != nil {
+			return "", err
+		}
+		chords[i] = chord
+	}
+	return strings.Join(chords, " "), nil
+}
+
+// parseChord parses a single chord like "Ctrl-X" into canonical form.
+func parseChord(chord string) (string, error) {
+	var ctrl, alt, shift bool
+	rest := chord
+	for {
+		i := strings.IndexAny(rest, "-+")
+		// A trailing separator is the key itself ("Ctrl--", "Ctrl-+").
+		if i <= 0 || i == len(rest)-1 {
+			break
+		}
+		switch strings.ToLower(rest[:i]) {
+		case "ctrl", "c", "control":
+			ctrl = true
+		case "alt", "a", "m", "meta", "opt", "option":
+			alt = true
+		case "shift", "s":
+			shift = true
+		default:
+			return "", fmt.Errorf("%w: unknown modifier %q in %q", ErrInvalidKey, rest[:i], chord)
+		}
+		rest = rest[i+1:]
+	}
+
+	var name string
+	if n, ok := keyNames[strings.ToLower(rest)]; ok {
+		name = n
+	} else if f := strings.ToUpper(rest); len(f) >= 2 && f[0] == 'F' && isDigits(f[1:]) {
+		name = f
+	} else if utf8.RuneCountInString(rest) == 1 {
+		name = rest
+		if ctrl || shift {
+			name = strings.ToUpper(name)
+		}
+		shift = false
+	} else {
+		return "", fmt.Errorf("%w: unknown key %q in %q", ErrInvalidKey, rest, chord)
+	}
+
+	if name == "Shift-Tab" {
+		name, shift = "Tab", true
+	}
+	return chordName(ctrl, alt, shift, name), nil
+}
+
+// chordName assembles a canonical chord from modifiers and key name.
+func chordName(ctrl, alt, shift bool, name string) string {
+	var b strings.Builder
+	if ctrl {
+		b.WriteString("Ctrl-")
+	}
+	if alt {
+		b.WriteString("Alt-")
+	}
+	if shift {
+		b.WriteString("Shift-")
+	}
+	b.WriteString(name)
+	return b.String()
+}
+
+// isDigits reports whether s is a non-empty string of ASCII digits.
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, ch := range s {
+		if ch < '0' || ch > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// KeyName returns the canonical chord for a key event, in the same form
+// ParseKeys produces, so that it can be looked up in a Keymap. Keys that
+// have no name yield an empty string.
+func KeyName(event *tcell.EventKey) string {
+	mod := event.Modifiers()
+	ctrl := mod&tcell.ModCtrl != 0
+	alt := mod&tcell.ModAlt != 0
+	shift := mod&tcell.ModShift != 0
+
+	var name string
+	switch key := event.Key(); {
+	case key == tcell.KeyRune:
+		name = event.Str()
+		if name == " " {
+			name = "Space"
+		} else {
+			if ctrl {
+				name = strings.ToUpper(name)
+			}
+			shift = false
+		}
+	case key == tcell.KeyBacktab:
+		name, shift = "Tab", true
+	case key == tcell.KeyTab:
+		name = "Tab"
+	case key == tcell.KeyEnter:
+		name = "Enter"
+	case key == tcell.KeyEsc:
+		name = "Esc"
+	case key == tcell.KeyBackspace, key == tcell.KeyBackspace2:
+		name = "Backspace"
+	case key >= tcell.KeyCtrlA && key <= tcell.KeyCtrlZ:
+		name, ctrl = string(rune('A'+key-tcell.KeyCtrlA)), true
+	case key >= tcell.KeyNUL && key <= tcell.KeyUS:
+		name, ctrl = string(rune('@'+key-tcell.KeyNUL)), true
+	default:
+		name = tcell.KeyNames[key]
+		if name == "" {
+			return ""
+		}
+	}
+	return chordName(ctrl, alt, shift, name)
+}
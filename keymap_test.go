@@ -0,0 +1,245 @@
+package zeichenwerk
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/gdamore/tcell/v3"
+	. "github.com/tekugo/zeichenwerk/core"
+	. "github.com/tekugo/zeichenwerk/widgets"
+)
+
+// ── ParseKeys ─────────────────────────────────────────────────────────────────
+
+func TestParseKeys_Canonical(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"ctrl+x ctrl+s", "Ctrl-X Ctrl-S"},
+		{"Ctrl-X  Ctrl-S", "Ctrl-X Ctrl-S"},
+		{"g g", "g g"},
+		{"G", "G"},
+		{"shift-a", "A"},
+		{"alt-enter", "Alt-Enter"},
+		{"shift+alt+ctrl+f5", "Ctrl-Alt-Shift-F5"},
+		{"backtab", "Shift-Tab"},
+		{"Ctrl--", "Ctrl--"},
+		{"Ctrl+\\", "Ctrl-\\"},
+		{"pagedown", "PgDn"},
+		{"space", "Space"},
+	}
+	for _, tt := range tests {
+		got, err := ParseKeys(tt.in)
+		if err != nil {
+			t.Errorf("ParseKeys(%q) error: %v", tt.in, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseKeys(%q) = %q; want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestParseKeys_Invalid(t *testing.T) {
+	for _, in := range []string{"", "   ", "Hyper-X", "Ctrl-Foo", "abc"} {
+		if _, err := ParseKeys(in); !errors.Is(err, ErrInvalidKey) {
+			t.Errorf("ParseKeys(%q) error = %v; want ErrInvalidKey", in, err)
+		}
+	}
+}
+
+// ── KeyName ───────────────────────────────────────────────────────────────────
+
+func TestKeyName(t *testing.T) {
+	tests := []struct {
+		event *tcell.EventKey
+		want  string
+	}{
+		{tcell.NewEventKey(tcell.KeyRune, "g", tcell.ModNone), "g"},
+		{tcell.NewEventKey(tcell.KeyRune, "G", tcell.ModShift), "G"},
+		{tcell.NewEventKey(tcell.KeyRune, " ", tcell.ModNone), "Space"},
+		{tcell.NewEventKey(tcell.KeyRune, "x", tcell.ModAlt), "Alt-x"},
+		{tcell.NewEventKey(tcell.KeyRune, "s", tcell.ModCtrl), "Ctrl-S"},
+		{tcell.NewEventKey(tcell.KeyTab, "", tcell.ModNone), "Tab"},
+		{tcell.NewEventKey(tcell.KeyBacktab, "", tcell.ModNone), "Shift-Tab"},
+		{tcell.NewEventKey(tcell.KeyEnter, "", tcell.ModNone), "Enter"},
+		{tcell.NewEventKey(tcell.KeyEscape, "", tcell.ModNone), "Esc"},
+		{tcell.NewEventKey(tcell.KeyUp, "", tcell.ModNone), "Up"},
+		{tcell.NewEventKey(tcell.KeyF5, "", tcell.ModNone), "F5"},
+	}
+	for _, tt := range tests {
+		got := KeyName(tt.event)
+		if got != tt.want {
+			t.Errorf("KeyName(%v) = %q; want %q", tt.event.Name(), got, tt.want)
+			continue
+		}
+		if parsed, err := ParseKeys(got); err != nil || parsed != got {
+			t.Errorf("ParseKeys(%q) = %q, %v; want it to round-trip", got, parsed, err)
+		}
+	}
+}
+
+// ── Keymap ────────────────────────────────────────────────────────────────────
+
+func TestKeymap_BindAndKeys(t *testing.T) {
+	k := NewKeymap()
+	if err := k.Bind("ctrl+s", "file.save"); err != nil {
+		t.Fatal(err)
+	}
+	if err := k.Bind("ctrl+x ctrl+s", "file.save"); err != nil {
+		t.Fatal(err)
+	}
+	if err := k.Bind("Hyper-S", "file.save"); !errors.Is(err, ErrInvalidKey) {
+		t.Errorf("Bind with invalid key error = %v; want ErrInvalidKey", err)
+	}
+	want := []string{"Ctrl-S", "Ctrl-X Ctrl-S"}
+	if got := k.Keys("file.save"); !slices.Equal(got, want) {
+		t.Errorf("Keys() = %v; want %v", got, want)
+	}
+	if action, ok := k.Action("Ctrl-S"); !ok || action != "file.save" {
+		t.Errorf("Action(Ctrl-S) = %q, %v; want file.save, true", action, ok)
+	}
+}
+
+func TestKeymap_UnbindAndExtend(t *testing.T) {
+	k := DefaultKeymap()
+	if !k.Unbind("q") {
+		t.Error("Unbind(q) should report a removed binding")
+	}
+	if k.Unbind("q") {
+		t.Error("second Unbind(q) should report nothing removed")
+	}
+	if n := k.UnbindAction(ActionFocusNext); n != 3 {
+		t.Errorf("UnbindAction(focus.next) = %d; want 3", n)
+	}
+
+	other := NewKeymap()
+	other.Bind("Esc", ActionAppQuit)
+	k.Extend(other)
+	if action, _ := k.Action("Esc"); action != ActionAppQuit {
+		t.Errorf("Esc after Extend = %q; want %q", action, ActionAppQuit)
+	}
+}
+
+func TestKeymap_CloneIsIndependent(t *testing.T) {
+	k := DefaultKeymap()
+	c := k.Clone()
+	c.Unbind("Tab")
+	if _, ok := k.Action("Tab"); !ok {
+		t.Error("unbinding in a clone should not affect the original")
+	}
+}
+
+// ── UI integration ────────────────────────────────────────────────────────────
+
+func TestUI_Keymap_Rebind(t *testing.T) {
+	h := newHeadlessForm(t)
+	h.UI().Keymap().UnbindAction(ActionFocusNext)
+	h.UI().Keymap().Bind("F2", ActionFocusNext)
+
+	h.Key(tcell.KeyTab)
+	if ID(h.Focus()) != "name" {
+		t.Errorf("focus = %q after unbound Tab; want %q", ID(h.Focus()), "name")
+	}
+	h.Key(tcell.KeyF2)
+	if ID(h.Focus()) != "ok" {
+		t.Errorf("focus = %q after F2; want %q", ID(h.Focus()), "ok")
+	}
+}
+
+func TestUI_Keymap_Sequence(t *testing.T) {
+	h := newHeadlessForm(t)
+	h.Key(tcell.KeyTab) // focus the button, which ignores runes
+	count := 0
+	h.UI().SetAction("test.top", func() { count++ })
+	h.UI().Keymap().Bind("g g", "test.top")
+	h.UI().Keymap().Bind("Ctrl-X Ctrl-S", "test.top")
+
+	h.Type("g")
+	if count != 0 {
+		t.Fatal("action should not run after the first chord")
+	}
+	h.Type("g")
+	if count != 1 {
+		t.Errorf("count = %d after g g; want 1", count)
+	}
+
+	h.Key(tcell.KeyCtrlX).Key(tcell.KeyCtrlS)
+	if count != 2 {
+		t.Errorf("count = %d after Ctrl-X Ctrl-S; want 2", count)
+	}
+
+	// A chord that breaks the sequence is retried on its own.
+	h.Type("g").Key(tcell.KeyTab)
+	if ID(h.Focus()) != "name" {
+		t.Errorf("focus = %q after g Tab; want Tab to move focus to %q", ID(h.Focus()), "name")
+	}
+}
+
+func TestUI_Keymap_LayerScope(t *testing.T) {
+	h := newHeadlessForm(t)
+	h.UI().Confirm("Sure?", "Really?", nil, nil)
+	h.Settle()
+	top := h.UI().layers[len(h.UI().layers)-1]
+
+	scoped := NewKeymap()
+	scoped.Bind("Esc", ActionNone)
+	h.UI().SetLayerKeymap(top, scoped)
+
+	h.Key(tcell.KeyEscape)
+	if h.Layers() != 2 {
+		t.Fatalf("Layers() = %d after Escape with layer keymap; want 2", h.Layers())
+	}
+
+	// A prefix in the layer keymap wins over a global binding.
+	count := 0
+	h.UI().SetAction("test.layer", func() { count++ })
+	sequence := NewKeymap()
+	sequence.Bind("Esc Esc", "test.layer")
+	h.UI().SetLayerKeymap(top, sequence)
+	h.Key(tcell.KeyEscape)
+	if h.Layers() != 2 || count != 0 {
+		t.Fatalf("Layers() = %d, count = %d after the first Escape; want 2, 0", h.Layers(), count)
+	}
+	h.Key(tcell.KeyEscape)
+	if h.Layers() != 2 || count != 1 {
+		t.Fatalf("Layers() = %d, count = %d after Esc Esc; want 2, 1", h.Layers(), count)
+	}
+
+	h.UI().SetLayerKeymap(top, nil)
+	h.Key(tcell.KeyEscape)
+	if h.Layers() != 1 {
+		t.Errorf("Layers() = %d after Escape without layer keymap; want 1", h.Layers())
+	}
+}
+
+func TestCommands_ShortcutFromKeymap(t *testing.T) {
+	ui := NewUI(headlessTheme(), NewBox("root", "", ""))
+	ran := false
+	cmd := ui.Commands().Register("", "Save", "ctrl+s", func() { ran = true })
+
+	if cmd.Binding != "command.Save" {
+		t.Errorf("Binding = %q; want %q", cmd.Binding, "command.Save")
+	}
+	if got := ui.Commands().filterCommands("")[0].shortcut; got != "Ctrl-S" {
+		t.Errorf("shortcut = %q; want %q", got, "Ctrl-S")
+	}
+
+	ui.Keymap().Bind("Ctrl-X Ctrl-S", cmd.Binding)
+	if got := ui.Commands().filterCommands("")[0].shortcut; got != "Ctrl-S, Ctrl-X Ctrl-S" {
+		t.Errorf("shortcut after rebinding = %q; want %q", got, "Ctrl-S, Ctrl-X Ctrl-S")
+	}
+
+	if !ui.Perform(cmd.Binding) || !ran {
+		t.Error("Perform should run the command action")
+	}
+
+	ui.Commands().Unregister("Save")
+	if len(ui.Keymap().Keys("command.Save")) != 0 {
+		t.Error("Unregister should remove the command's key bindings")
+	}
+	if ui.Perform("command.Save") {
+		t.Error("Unregister should remove the command's action")
+	}
+}
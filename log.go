@@ -6,6 +6,7 @@ import (
 	"log/slog"
 	"os"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/tekugo/zeichenwerk/widgets"
@@ -27,7 +28,9 @@ func (le *TableLogItem) String() string {
 // TableLog is a circular buffer that stores log entries and implements the
 // TableProvider interface, allowing it to be used as a data source for a Table widget.
 // The buffer has a fixed capacity; when full, oldest entries are overwritten.
+// It is safe for concurrent use, so several UIs may share one.
 type TableLog struct {
+	mutex   sync.RWMutex
 	items   []TableLogItem
 	columns []widgets.TableColumn
 	size    int
@@ -55,6 +58,8 @@ func NewTableLog(size int) *TableLog {
 // The buffer grows until it reaches the configured size, after which the oldest
 // entry is overwritten.
 func (t *TableLog) Add(source, level, message string, params ...any) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
 	index := (t.start + t.count) % t.size
 	t.items[index] = TableLogItem{
 		Time:    time.Now(),
@@ -70,6 +75,13 @@ func (t *TableLog) Add(source, level, message string, params ...any) {
 	}
 }
 
+// Clear removes all entries.
+func (t *TableLog) Clear() {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.start, t.count = 0, 0
+}
+
 // Columns returns the column definitions for the TableProvider interface.
 func (t *TableLog) Columns() []widgets.TableColumn {
 	return t.columns
@@ -77,13 +89,21 @@ func (t *TableLog) Columns() []widgets.TableColumn {
 
 // Length returns the number of log entries currently stored (up to the buffer size).
 func (t *TableLog) Length() int {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
 	return t.count
 }
 
 // Str returns the string value for the cell at the given row and column.
 // Rows are indexed from 0 (most recent entry) to Length-1 (oldest entry).
-// Column indices: 0=Time, 1=Level, 2=Source, 3=Message.
+// Column indices: 0=Time, 1=Level, 2=Source, 3=Message. Rows that no
+// longer exist, e.g. after a concurrent Clear, are empty.
 func (t *TableLog) Str(row, column int) string {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	if row < 0 || row >= t.count {
+		return ""
+	}
 	entry := t.items[(t.start+t.count-row-1)%t.size]
 	switch column {
 	case 0:
@@ -98,14 +118,21 @@ func (t *TableLog) Str(row, column int) string {
 }
 
 // Iter returns a channel that streams all log entries from oldest to newest.
-// The channel is closed after all entries have been sent.
+// The channel is closed after all entries have been sent. The entries are
+// those in the buffer when Iter is called.
 func (t *TableLog) Iter() <-chan TableLogItem {
+	t.mutex.RLock()
+	items := make([]TableLogItem, t.count)
+	for i := range items {
+		items[i] = t.items[(t.start+i)%t.size]
+	}
+	t.mutex.RUnlock()
 	ch := make(chan TableLogItem)
 
 	go func() {
 		defer close(ch)
-		for i := range t.count {
-			ch <- t.items[(t.start+i)%t.size]
+		for _, item := range items {
+			ch <- item
 		}
 	}()
 
@@ -1,6 +1,7 @@
 package zeichenwerk
 
 import (
+	"sync"
 	"testing"
 	"time"
 )
@@ -45,6 +46,31 @@ func TestTableLog_RingBuffer(t *testing.T) {
 	}
 }
 
+func TestTableLog_Concurrent(t *testing.T) {
+	log := NewTableLog(50)
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Go(func() {
+			for range 100 {
+				log.Add("src", "INFO", "msg")
+				_ = log.Str(log.Length()-1, 3)
+			}
+		})
+	}
+	wg.Go(func() {
+		for range 10 {
+			log.Clear()
+		}
+	})
+	wg.Wait()
+	if log.Length() > 50 {
+		t.Fatalf("length %d exceeds the buffer size", log.Length())
+	}
+	if log.Str(50, 3) != "" {
+		t.Error("row beyond the buffer is not empty")
+	}
+}
+
 func TestTableLog_Columns(t *testing.T) {
 	log := NewTableLog(10)
 	cols := log.Columns()
@@ -0,0 +1,267 @@
+package zeichenwerk
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/tekugo/zeichenwerk/core"
+	. "github.com/tekugo/zeichenwerk/widgets"
+)
+
+// NotifyTimeout is the time a notification stays on screen when
+// NotifyOptions.Timeout is 0.
+const NotifyTimeout = 5 * time.Second
+
+// noticeReveal is the time per column of the animation that reveals a new
+// notification from left to right.
+const noticeReveal = 5 * time.Millisecond
+
+// notificationLog is the notification history. It is shared by all UIs,
+// whose event loops add to it concurrently; TableLog locks itself.
+var notificationLog = NewTableLog(500)
+
+// NotifyOptions holds the optional settings of UI.Notify.
+type NotifyOptions struct {
+	Timeout time.Duration        // time on screen; 0 = NotifyTimeout, negative = until dismissed
+	Actions []NotificationAction // buttons in the bottom row
+	Key     string               // groups repeated notifications; default level, title and text
+	Icon    string               // overrides the icon of the level
+	Source  string               // source column of the history; default "notify"
+}
+
+// notice is a notification shown by UI.Notify.
+type notice struct {
+	notification *Notification
+	grow         *Grow       // reveals the notification when it is shown
+	key          string      // dedup key, see NotifyOptions.Key
+	timer        *time.Timer // dismisses the notification; nil if sticky
+}
+
+// Notify shows a notification in the bottom-right corner of the screen and
+// returns it. A new notification is revealed from left to right, and
+// notifications are stacked above each other, newest on top, on an overlay
+// that is drawn above all layers. They never take the focus,
+// are not affected by Popup and Close and are operated with the mouse: the
+// close button and the action buttons dismiss them.
+//
+// A notification is dismissed after the timeout of the options. When a
+// notification with the same key is still on screen, no new one is shown;
+// the existing one takes level, title and text, counts the repeat (shown as
+// ×N after the title) and restarts its timeout. Every call is recorded in
+// the notification history, see NotificationLog.
+//
+//	ui.Notify(Success, "Saved", "main.go written to disk.")
+//	ui.Notify(Error, "Build failed", err.Error(), NotifyOptions{
+//		Timeout: -1,
+//		Actions: []NotificationAction{{Label: "Retry", Fn: build}},
+//	})
+//
+// Notify must be called on the event loop; use Post from other goroutines.
+func (ui *UI) Notify(level Level, title, text string, opts ...NotifyOptions) *Notification {
+	var opt NotifyOptions
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+	if level == "" {
+		level = Info
+	}
+	key := opt.Key
+	if key == "" {
+		key = string(level) + "\x00" + title + "\x00" + text
+	}
+	source := opt.Source
+	if source == "" {
+		source = "notify"
+	}
+
+	for _, active := range ui.notices {
+		if active.key == key {
+			n := active.notification
+			n.SetLevel(level).SetTitle(title).SetText(text).SetCount(n.Count() + 1)
+			LogNotification(source, n)
+			ui.schedule(active, opt.Timeout)
+			ui.stack()
+			ui.Refresh()
+			return n
+		}
+	}
+
+	ui.noticeSerial++
+	n := NewNotification(fmt.Sprintf("notification-%d", ui.noticeSerial), "")
+	n.SetLevel(level).SetIcon(opt.Icon).SetTitle(title).SetText(text)
+	for _, action := range opt.Actions {
+		n.AddAction(action.Label, action.Fn)
+	}
+	n.Apply(ui.theme)
+	n.On(EvtHide, func(_ Widget, _ Event, _ ...any) bool {
+		ui.removeNotice(n)
+		return false
+	})
+	LogNotification(source, n)
+
+	grow := NewGrow(n.ID()+"-grow", "", true)
+	grow.Apply(ui.theme)
+	grow.Add(n)
+	grow.SetParent(ui)
+
+	active := &notice{notification: n, grow: grow, key: key}
+	ui.notices = append(ui.notices, active)
+	ui.schedule(active, opt.Timeout)
+	ui.stack()
+	grow.Start(noticeReveal)
+	ui.Refresh()
+	return n
+}
+
+// LogNotification records a notification in the notification history with
+// its current level, title and text. Notify records its notifications
+// itself; use LogNotification for notifications placed in a layout.
+func LogNotification(source string, n *Notification) {
+	message := n.Title()
+	if n.Text() != "" {
+		message += ": " + n.Text()
+	}
+	notificationLog.Add(source, string(n.Level()), "%s", message)
+}
+
+// NotificationLog returns the history of the notifications shown with
+// Notify, including the repeated ones, and of those recorded with
+// LogNotification. It keeps the last 500 entries and is shared by all UIs;
+// it may be read and cleared from any goroutine.
+func NotificationLog() *TableLog {
+	return notificationLog
+}
+
+// ClearNotificationLog empties the notification history.
+func ClearNotificationLog() {
+	notificationLog.Clear()
+}
+
+// Notifications returns the notifications on screen, bottom to top.
+func (ui *UI) Notifications() []*Notification {
+	result := make([]*Notification, len(ui.notices))
+	for i, active := range ui.notices {
+		result[i] = active.notification
+	}
+	return result
+}
+
+// DismissNotification dismisses the newest notification on screen. It is
+// the default function of the notifications.dismiss action.
+func (ui *UI) DismissNotification() {
+	if len(ui.notices) > 0 {
+		ui.notices[len(ui.notices)-1].notification.Dismiss()
+	}
+}
+
+// NotificationHistory shows the notification history in a modal dialog,
+// newest first. It is the default function of the notifications.history
+// action.
+func (ui *UI) NotificationHistory() {
+	_, _, width, height := ui.Bounds()
+	dialog := ui.NewBuilder().
+		Dialog("notification-history", "Notifications").
+		Class("dialog").
+		VFlex("notification-history-body", Stretch, 1).
+		Table("notification-history-table", notificationLog, false).Hint(0, -1).
+		HFlex("notification-history-buttons", End, 2).Hint(0, 1).
+		Button("notification-history-close", "Close").
+		End().
+		End().
+		Class("").
+		Container()
+
+	Find(dialog, "notification-history-close").On(EvtActivate, func(_ Widget, _ Event, _ ...any) bool {
+		ui.Close()
+		return true
+	})
+
+	ui.Popup(-1, -1, min(100, width-4), min(24, height-2), dialog)
+}
+
+// schedule (re)starts the timer that dismisses a notification.
+func (ui *UI) schedule(active *notice, timeout time.Duration) {
+	if active.timer != nil {
+		active.timer.Stop()
+		active.timer = nil
+	}
+	if timeout < 0 {
+		return
+	}
+	if timeout == 0 {
+		timeout = NotifyTimeout
+	}
+	var timer *time.Timer
+	timer = time.AfterFunc(timeout, func() {
+		ui.Post(func() {
+			// A restarted timer replaces this one
+			if active.timer == timer {
+				active.notification.Dismiss()
+			}
+		})
+	})
+	active.timer = timer
+}
+
+// removeNotice takes a dismissed notification off the screen and moves the
+// notifications above it down.
+func (ui *UI) removeNotice(n *Notification) {
+	for i, active := range ui.notices {
+		if active.notification != n {
+			continue
+		}
+		if active.timer != nil {
+			active.timer.Stop()
+		}
+		active.grow.Stop()
+		ui.notices = append(ui.notices[:i], ui.notices[i+1:]...)
+		if ui.hover == n {
+			ui.hover = nil
+		}
+		ui.stack()
+		ui.Refresh()
+		return
+	}
+}
+
+// stack positions the notifications in the bottom-right corner, each above
+// the previous one. Notifications that do not fit on the screen are hidden
+// until the ones below them are dismissed.
+func (ui *UI) stack() {
+	_, _, width, height := ui.Bounds()
+	if ui.debug {
+		height--
+	}
+	w := min(48, width-2)
+	bottom := height - 1
+	for _, active := range ui.notices {
+		n := active.notification
+		style := n.Style()
+		n.SetHint(w-style.Horizontal(), 0)
+		_, h := n.Hint()
+		h += style.Vertical()
+		bottom -= h
+		active.grow.SetBounds(width-w-1, bottom, w, h)
+		active.grow.Layout()
+		n.SetFlag(FlagHidden, bottom < 0)
+	}
+}
+
+// noticeAt returns the notification at the screen position, or nil.
+func (ui *UI) noticeAt(x, y int) Widget {
+	for i := len(ui.notices) - 1; i >= 0; i-- {
+		n := ui.notices[i].notification
+		nx, ny, nw, nh := n.Bounds()
+		if !n.Flag(FlagHidden) && x >= nx && x < nx+nw && y >= ny && y < ny+nh {
+			return n
+		}
+	}
+	return nil
+}
+
+// drawNotices renders the notifications on top of all layers.
+func (ui *UI) drawNotices() {
+	for _, active := range ui.notices {
+		active.grow.Render(ui.renderer)
+	}
+}
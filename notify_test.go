@@ -0,0 +1,175 @@
+package zeichenwerk
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/tekugo/zeichenwerk/core"
+	. "github.com/tekugo/zeichenwerk/widgets"
+)
+
+// newNotifyHeadless returns a 60×20 headless UI with a focused input. The
+// headless theme has no notification styles, so notifications have no
+// border and are 48 columns wide at x = 11.
+func newNotifyHeadless(t *testing.T) *Headless {
+	t.Helper()
+	ui := NewBuilder(headlessTheme()).
+		VFlex("root", Stretch, 0).
+		Input("name").Hint(10, 1).
+		End().
+		Build()
+	return NewHeadless(ui, 60, 20)
+}
+
+// revealed waits until the notifications on screen are fully revealed.
+func revealed(t *testing.T, h *Headless) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for _, active := range h.UI().notices {
+		for active.grow.Running() && time.Now().Before(deadline) {
+			time.Sleep(5 * time.Millisecond)
+			h.Settle()
+		}
+	}
+	h.Settle()
+}
+
+func TestNotify_Stack(t *testing.T) {
+	h := newNotifyHeadless(t)
+	ui := h.UI()
+	first := ui.Notify(Info, "First", "", NotifyOptions{Timeout: -1})
+	second := ui.Notify(Warning, "Second", "Body", NotifyOptions{Timeout: -1})
+	revealed(t, h)
+
+	if h.Layers() != 1 || ID(h.Focus()) != "name" {
+		t.Errorf("layers = %d, focus = %s; notifications should not open layers or take the focus", h.Layers(), ID(h.Focus()))
+	}
+	if x, y, w, hh := first.Bounds(); x != 11 || y != 18 || w != 48 || hh != 1 {
+		t.Errorf("first at %d, %d, %d×%d; want 11, 18, 48×1", x, y, w, hh)
+	}
+	if _, y, _, hh := second.Bounds(); y != 16 || hh != 2 {
+		t.Errorf("second at y = %d, height %d; want 16, 2", y, hh)
+	}
+	if got := h.Screen().Line(16); !strings.Contains(got, "⚠  Second") {
+		t.Errorf("line 16 = %q; want the second title", got)
+	}
+
+	// Dismissing the lower notification moves the upper one down.
+	first.Dismiss()
+	h.Settle()
+	if _, y, _, _ := second.Bounds(); y != 17 || len(ui.Notifications()) != 1 {
+		t.Errorf("second at y = %d after dismiss; want 17", y)
+	}
+}
+
+func TestNotify_Reveal(t *testing.T) {
+	h := newNotifyHeadless(t)
+	h.UI().Notify(Info, "Revealed from the left", "", NotifyOptions{Timeout: -1})
+	h.Settle()
+	if got := h.Screen().Line(18); strings.Contains(got, "left") {
+		t.Errorf("line 18 = %q; want the title still hidden", got)
+	}
+	revealed(t, h)
+	if got := h.Screen().Line(18); !strings.Contains(got, "ℹ  Revealed from the left") {
+		t.Errorf("line 18 = %q; want the whole title", got)
+	}
+}
+
+func TestNotify_Dedup(t *testing.T) {
+	ClearNotificationLog()
+	h := newNotifyHeadless(t)
+	ui := h.UI()
+	n := ui.Notify(Error, "Disk full", "", NotifyOptions{Timeout: -1})
+	if again := ui.Notify(Error, "Disk full", "", NotifyOptions{Timeout: -1}); again != n {
+		t.Fatal("a repeated notification should reuse the one on screen")
+	}
+	grouped := ui.Notify(Error, "Disk full", "/var", NotifyOptions{Timeout: -1, Key: n.Title()})
+	if grouped == n {
+		t.Error("a different key should show a new notification")
+	}
+	h.Settle()
+
+	if n.Count() != 2 || len(ui.Notifications()) != 2 {
+		t.Errorf("count = %d with %d notifications; want 2, 2", n.Count(), len(ui.Notifications()))
+	}
+	if got := NotificationLog().Length(); got != 3 {
+		t.Errorf("history length = %d; want every call", got)
+	}
+	if got := NotificationLog().Str(0, 3); got != "Disk full: /var" {
+		t.Errorf("newest history message = %q", got)
+	}
+
+	LogNotification("layout", NewNotification("static", "").SetTitle("Static"))
+	if got := NotificationLog().Str(0, 3); got != "Static" || NotificationLog().Length() != 4 {
+		t.Errorf("newest history message = %q; want the logged notification", got)
+	}
+	ClearNotificationLog()
+	if got := NotificationLog().Length(); got != 0 {
+		t.Errorf("history length = %d after clear; want 0", got)
+	}
+}
+
+func TestNotify_Timeout(t *testing.T) {
+	h := newNotifyHeadless(t)
+	ui := h.UI()
+	n := ui.Notify(Info, "Short", "", NotifyOptions{Timeout: time.Millisecond})
+	sticky := ui.Notify(Info, "Sticky", "", NotifyOptions{Timeout: -1})
+
+	deadline := time.Now().Add(2 * time.Second)
+	for !n.Dismissed() && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+		h.Settle()
+	}
+	if !n.Dismissed() {
+		t.Fatal("notification was not dismissed after its timeout")
+	}
+	if got := ui.Notifications(); len(got) != 1 || got[0] != sticky {
+		t.Errorf("notifications = %d; want only the sticky one", len(got))
+	}
+}
+
+func TestNotify_Mouse(t *testing.T) {
+	h := newNotifyHeadless(t)
+	ui := h.UI()
+	undone := false
+	ui.Notify(Success, "Deleted", "", NotifyOptions{
+		Timeout: -1,
+		Actions: []NotificationAction{{Label: "Undo", Fn: func() { undone = true }}},
+	})
+	closed := ui.Notify(Info, "Other", "", NotifyOptions{Timeout: -1})
+	h.Settle()
+
+	// The close button is in the last three columns of the title row.
+	_, y, _, _ := closed.Bounds()
+	h.Click(57, y)
+	if !closed.Dismissed() || ID(h.Focus()) != "name" {
+		t.Errorf("dismissed = %t, focus = %s; want the close button to dismiss without focus change", closed.Dismissed(), ID(h.Focus()))
+	}
+
+	h.Click(14, 18)
+	if !undone || len(ui.Notifications()) != 0 {
+		t.Errorf("undone = %t with %d notifications; want the action run and dismissed", undone, len(ui.Notifications()))
+	}
+}
+
+func TestNotify_Actions(t *testing.T) {
+	h := newNotifyHeadless(t)
+	ui := h.UI()
+	ui.Notify(Info, "Old", "", NotifyOptions{Timeout: -1})
+	newest := ui.Notify(Info, "New", "", NotifyOptions{Timeout: -1})
+
+	ui.Perform(ActionNotificationsDismiss)
+	if !newest.Dismissed() || len(ui.Notifications()) != 1 {
+		t.Error("notifications.dismiss should dismiss the newest notification")
+	}
+
+	ui.Perform(ActionNotificationsHistory)
+	h.Settle()
+	if h.Layers() != 2 {
+		t.Fatalf("layers = %d; notifications.history should open a dialog", h.Layers())
+	}
+	if _, ok := Find(ui, "notification-history-table").(*Table); !ok {
+		t.Error("history dialog has no table")
+	}
+}
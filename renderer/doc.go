@@ -16,6 +16,9 @@
 //     receive a *Renderer.
 //   - NewMockScreen — a helper that wires a tcell screen to a vt.MockTerm
 //     so tests can exercise rendering without a real terminal.
+//   - MemoryScreen — a fixed-size in-memory Screen that keeps the full
+//     cell grid (glyph, colours, font) and the cursor; it backs the
+//     headless UI driver used for end-to-end tests.
 //
 // # Coordinate model
 //
@@ -0,0 +1,197 @@
+package renderer
+
+import "strings"
+
+// Cell is a single character cell of a MemoryScreen together with the
+// style that was active when it was last written. Colours are stored
+// exactly as they were passed to Set, i.e. already resolved from theme
+// variables by the layer above.
+type Cell struct {
+	Ch   string // glyph; a blank cell holds " "
+	Fg   string // foreground colour
+	Bg   string // background colour
+	Font string // space-separated font attributes
+}
+
+// MemoryScreen is an in-memory Screen with a fixed-size cell grid. Unlike
+// core.TestScreen, which only records individual Put calls, it models the
+// complete screen: clipping and translation follow the same rules as
+// TcellScreen, every cell carries its glyph and full style, and the
+// cursor position is tracked. It is the back-end used by the headless UI
+// driver to run whole applications without a terminal.
+//
+// Like every Screen, a MemoryScreen is owned by a single goroutine.
+type MemoryScreen struct {
+	width, height int    // screen size in cells
+	cells         []Cell // row-major cell grid
+	fg, bg, font  string // current style for Put
+	x, y          int    // clipping region top-left (absolute screen coordinates)
+	cw, ch        int    // clipping region size (0 means unlimited)
+	tx, ty        int    // coordinate translation offsets
+	cursorX       int    // cursor column, valid when cursor is visible
+	cursorY       int    // cursor row, valid when cursor is visible
+	cursor        string // cursor style; empty when the cursor is hidden
+	flushes       int    // number of Flush calls, useful for tests
+	clipboard     []byte // data posted with SetClipboard
+}
+
+// NewMemoryScreen creates a blank MemoryScreen of the given size. All
+// cells start out as spaces without any colour or font.
+//
+// Parameters:
+//   - width, height: Screen size in cells.
+func NewMemoryScreen(width, height int) *MemoryScreen {
+	m := &MemoryScreen{}
+	m.Resize(width, height)
+	return m
+}
+
+// Cell returns the cell at the absolute screen position (x, y). Positions
+// outside the screen yield the zero Cell.
+func (m *MemoryScreen) Cell(x, y int) Cell {
+	if x < 0 || y < 0 || x >= m.width || y >= m.height {
+		return Cell{}
+	}
+	return m.cells[y*m.width+x]
+}
+
+// Clipboard returns the data last posted with SetClipboard.
+func (m *MemoryScreen) Clipboard() []byte {
+	return m.clipboard
+}
+
+// Clear blanks every cell of the screen, ignoring the clipping region.
+func (m *MemoryScreen) Clear() {
+	for i := range m.cells {
+		m.cells[i] = Cell{Ch: " "}
+	}
+}
+
+// Clip replaces the clipping region and resets the coordinate origin. The
+// semantics are identical to TcellScreen.Clip.
+func (m *MemoryScreen) Clip(x, y, width, height int) {
+	m.x, m.y, m.cw, m.ch = x, y, width, height
+}
+
+// Cursor returns the cursor position in absolute screen coordinates and
+// its style. The style is empty when the cursor is hidden.
+func (m *MemoryScreen) Cursor() (int, int, string) {
+	return m.cursorX, m.cursorY, m.cursor
+}
+
+// Flush only counts the call; a MemoryScreen has no back buffer.
+func (m *MemoryScreen) Flush() {
+	m.flushes++
+}
+
+// Flushes returns how many times Flush has been called.
+func (m *MemoryScreen) Flushes() int {
+	return m.flushes
+}
+
+// Get returns the glyph at the given position relative to the clipping
+// origin, or an empty string if the position is off-screen.
+func (m *MemoryScreen) Get(x, y int) string {
+	return m.Cell(x+m.x+m.tx, y+m.y+m.ty).Ch
+}
+
+// HideCursor hides the cursor.
+func (m *MemoryScreen) HideCursor() {
+	m.cursor = ""
+}
+
+// Line returns the glyphs of row y as a string with trailing blanks
+// removed.
+func (m *MemoryScreen) Line(y int) string {
+	var b strings.Builder
+	for x := range m.width {
+		b.WriteString(m.Cell(x, y).Ch)
+	}
+	return strings.TrimRight(b.String(), " ")
+}
+
+// Put writes ch at the given position relative to the clipping origin
+// using the current style. Writes outside the clipping region or the
+// screen are discarded.
+func (m *MemoryScreen) Put(x, y int, ch string) {
+	if x+m.tx < 0 || (m.cw != 0 && x+m.tx >= m.cw) || y+m.ty < 0 || (m.ch != 0 && y+m.ty >= m.ch) {
+		return
+	}
+	ax, ay := x+m.x+m.tx, y+m.y+m.ty
+	if ax < 0 || ay < 0 || ax >= m.width || ay >= m.height {
+		return
+	}
+	m.cells[ay*m.width+ax] = Cell{Ch: ch, Fg: m.fg, Bg: m.bg, Font: m.font}
+}
+
+// Resize changes the screen size. The existing content is kept where it
+// still fits; newly exposed cells are blank.
+func (m *MemoryScreen) Resize(width, height int) {
+	width, height = max(width, 0), max(height, 0)
+	cells := make([]Cell, width*height)
+	for y := range height {
+		for x := range width {
+			if x < m.width && y < m.height {
+				cells[y*width+x] = m.cells[y*m.width+x]
+			} else {
+				cells[y*width+x] = Cell{Ch: " "}
+			}
+		}
+	}
+	m.width, m.height, m.cells = width, height, cells
+}
+
+// Set installs the style for subsequent Put calls. The font string is
+// normalised so that "normal" clears all attributes.
+func (m *MemoryScreen) Set(fg, bg, font string) {
+	m.fg, m.bg = fg, bg
+	if strings.TrimSpace(strings.ToLower(font)) == "normal" {
+		font = ""
+	}
+	m.font = strings.TrimSpace(font)
+}
+
+// SetUnderline adds an "underline" attribute to the current font when a
+// non-zero underline style is requested. The underline colour is not
+// modelled.
+func (m *MemoryScreen) SetUnderline(style int, color string) {
+	if style != 0 && !strings.Contains(m.font, "underline") {
+		m.font = strings.TrimSpace(m.font + " underline")
+	}
+}
+
+// SetClipboard stores the data instead of posting it to a system
+// clipboard, see Clipboard.
+func (m *MemoryScreen) SetClipboard(data []byte) {
+	m.clipboard = data
+}
+
+// ShowCursor places the cursor at the absolute screen position (x, y)
+// with the given style (see UI.ShowCursor for the recognised names).
+func (m *MemoryScreen) ShowCursor(x, y int, style string) {
+	if style == "" {
+		style = "default"
+	}
+	m.cursorX, m.cursorY, m.cursor = x, y, style
+}
+
+// Size returns the screen size in cells.
+func (m *MemoryScreen) Size() (int, int) {
+	return m.width, m.height
+}
+
+// String returns all rows of the screen joined by newlines, each with
+// trailing blanks removed.
+func (m *MemoryScreen) String() string {
+	lines := make([]string, m.height)
+	for y := range m.height {
+		lines[y] = m.Line(y)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Translate sets the coordinate translation offsets applied after the
+// clipping origin.
+func (m *MemoryScreen) Translate(x, y int) {
+	m.tx, m.ty = x, y
+}
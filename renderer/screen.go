@@ -74,7 +74,8 @@ type Screen interface {
 	// and reuse it across many Put calls.
 	//
 	// Colour strings are literal values understood by the back-end (named
-	// colours such as "red" or hex triplets such as "#ff0000"). The
+	// colours such as "red", palette colours such as "color111" or hex
+	// triplets such as "#ff0000"). The
 	// renderer package does not know about theme variables; those are
 	// resolved at a higher layer (core.Renderer) before reaching Screen.
 	//
@@ -83,7 +84,7 @@ type Screen interface {
 	//   - bg:   Background colour, or an empty string to keep the default.
 	//   - font: Space-separated font attributes (for example
 	//     "bold italic"). Recognised tokens include blink, bold, italic,
-	//     normal, strikethrough and underline.
+	//     normal, reverse, strikethrough and underline.
 	Set(fg, bg, font string)
 
 	// Translate shifts the coordinate system used by Put and Get by an
@@ -1,6 +1,7 @@
 package renderer
 
 import (
+	"strconv"
 	"strings"
 
 	"github.com/gdamore/tcell/v3"
@@ -91,18 +92,19 @@ func (t *TcellScreen) Put(x, y int, ch string) {
 // Set updates the current drawing style (foreground, background, font attributes).
 //
 // Parameters:
-//   - foreground: Color name or hex string (e.g., "red", "#ff0000").
-//   - background: Color name or hex string.
+//   - foreground: Color name, palette colour or hex string (e.g., "red",
+//     "color111", "#ff0000").
+//   - background: Color name, palette colour or hex string.
 //   - font: Space-separated list of attributes (e.g., "bold italic blink").
 func (t *TcellScreen) Set(foreground, background, font string) {
 	next := tcell.StyleDefault
 
 	if background != "" {
-		next = next.Background(color.GetColor(background))
+		next = next.Background(tcellColor(background))
 	}
 
 	if foreground != "" {
-		next = next.Foreground(color.GetColor(foreground))
+		next = next.Foreground(tcellColor(foreground))
 	}
 
 	for part := range strings.SplitSeq(font, " ") {
@@ -113,9 +115,11 @@ func (t *TcellScreen) Set(foreground, background, font string) {
 		case "bold":
 			next = next.Bold(true)
 		case "normal":
-			next = next.Blink(false).Bold(false).Italic(false).Underline(false).StrikeThrough(false)
+			next = next.Blink(false).Bold(false).Italic(false).Underline(false).StrikeThrough(false).Reverse(false)
 		case "italic":
 			next = next.Italic(true)
+		case "reverse":
+			next = next.Reverse(true)
 		case "strikethrough":
 			next = next.StrikeThrough(true)
 		case "underline":
@@ -126,6 +130,18 @@ func (t *TcellScreen) Set(foreground, background, font string) {
 	t.style = next
 }
 
+// tcellColor converts a colour string to a tcell colour. Besides the names
+// and hex values understood by tcell, "color0" to "color255" select a
+// colour of the terminal palette.
+func tcellColor(name string) color.Color {
+	if n, ok := strings.CutPrefix(name, "color"); ok {
+		if index, err := strconv.Atoi(n); err == nil && index >= 0 && index < 256 {
+			return color.PaletteColor(index)
+		}
+	}
+	return color.GetColor(name)
+}
+
 // Style retrieves the style at the specified position relative to the clipping origin.
 //
 // Parameters:
@@ -156,7 +172,7 @@ func (t *TcellScreen) SetUnderline(style int, ulColor string) {
 		us = tcell.UnderlineStyleDashed
 	}
 	if ulColor != "" {
-		t.style = t.style.Underline(us, color.GetColor(ulColor))
+		t.style = t.style.Underline(us, tcellColor(ulColor))
 	} else {
 		t.style = t.style.Underline(us)
 	}
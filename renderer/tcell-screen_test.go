@@ -140,6 +140,15 @@ func TestTcellScreen_Style(t *testing.T) {
 			expectedBg: -1,
 			expectedAt: tcell.AttrBold | tcell.AttrItalic,
 		},
+		{
+			name:       "Palette Colour, Reverse",
+			fg:         "color111",
+			bg:         "",
+			font:       "reverse",
+			expectedFg: 0x87afff,
+			expectedBg: -1,
+			expectedAt: tcell.AttrReverse,
+		},
 		{
 			name:       "Reset to Normal",
 			fg:         "",
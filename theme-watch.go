@@ -0,0 +1,74 @@
+package zeichenwerk
+
+import (
+	"os"
+	"sync"
+	"time"
+
+	. "github.com/tekugo/zeichenwerk/core"
+	"github.com/tekugo/zeichenwerk/themes"
+)
+
+// WatchTheme reloads a theme file whenever it changes and applies it to the
+// running UI. It is meant for tuning themes: edit the colours in an editor
+// and see the result as soon as the file is saved.
+//
+// The file is polled every interval (500ms if interval is zero or less) for
+// a new modification time or size; no file system notifications are used.
+// The file is not loaded initially, set the theme it describes when
+// creating the UI. On a change, it is loaded with [themes.LoadThemeFile]
+// and set with [UI.SetTheme] on the event loop. Files that fail to load are
+// reported to the UI log at error level, visible in debug mode, and the
+// current theme stays active until the file is fixed. Only the file itself
+// is watched, not the files it extends.
+//
+// Watching ends when the returned function is called or the UI quits.
+//
+//	stop := ui.WatchTheme("mytheme.json", 0)
+//	defer stop()
+func (ui *UI) WatchTheme(path string, interval time.Duration) (stop func()) {
+	if interval <= 0 {
+		interval = 500 * time.Millisecond
+	}
+	done := make(chan struct{})
+	var once sync.Once
+	stop = func() { once.Do(func() { close(done) }) }
+
+	last, _ := os.Stat(path)
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ui.quit:
+				return
+			case <-ticker.C:
+			}
+			info, err := os.Stat(path)
+			if err != nil || !changed(last, info) {
+				continue
+			}
+			last = info
+			theme, err := themes.LoadThemeFile(path)
+			if err != nil {
+				ui.Post(func() {
+					ui.Log(ui, Error, "Theme reload failed", "path", path, "error", err)
+				})
+				continue
+			}
+			ui.Post(func() {
+				ui.Log(ui, Info, "Theme reloaded", "path", path)
+				ui.SetTheme(theme)
+			})
+		}
+	}()
+	return stop
+}
+
+// changed reports whether a file was modified between two stats. A missing
+// previous stat counts as a change.
+func changed(last, info os.FileInfo) bool {
+	return last == nil || !info.ModTime().Equal(last.ModTime()) || info.Size() != last.Size()
+}
@@ -39,6 +39,19 @@ func AddDefaultStyles(theme *Theme) {
 		NewStyle("editor/current-line-number").WithColors("$cyan", "$bg1"),
 		NewStyle("editor/line-numbers").WithColors("$fg3", "$bg0"),
 		NewStyle("editor/selection").WithColors("$bg0", "$blue"),
+		NewStyle("editor/match").WithColors("$bg0", "$yellow"),
+		NewStyle("editor/code").WithForeground("$green"),
+		NewStyle("editor/comment").WithForeground("$gray").WithFont("italic"),
+		NewStyle("editor/constant").WithForeground("$orange"),
+		NewStyle("editor/emphasis").WithForeground("$yellow").WithFont("italic"),
+		NewStyle("editor/heading").WithForeground("$blue").WithFont("bold"),
+		NewStyle("editor/key").WithForeground("$blue"),
+		NewStyle("editor/keyword").WithForeground("$magenta"),
+		NewStyle("editor/link").WithForeground("$cyan").WithFont("underline"),
+		NewStyle("editor/number").WithForeground("$orange"),
+		NewStyle("editor/string").WithForeground("$green"),
+		NewStyle("editor/type").WithForeground("$cyan"),
+		NewStyle("editor/variable").WithForeground("$aqua"),
 		NewStyle("input").WithColors("$fg0", "$bg2").WithCursor("*bar"),
 		NewStyle("input:focused").WithColors("$bg0", "$blue"),
 		NewStyle("typeahead").WithColors("$fg0", "$bg2").WithCursor("*bar"),
@@ -70,6 +83,73 @@ func AddDefaultStyles(theme *Theme) {
 		NewStyle("bar-chart/selection").WithColors("$bg0", "$fg2"),
 		NewStyle("bar-chart/value").WithColors("$fg0", "$bg0"),
 		NewStyle("bar-chart/legend").WithColors("$fg2", "$bg0"),
+		NewStyle("line-chart").WithColors("$fg0", "$bg0"),
+		NewStyle("line-chart/s0").WithColors("$cyan", "$bg0"),
+		NewStyle("line-chart/s1").WithColors("$green", "$bg0"),
+		NewStyle("line-chart/s2").WithColors("$magenta", "$bg0"),
+		NewStyle("line-chart/s3").WithColors("$blue", "$bg0"),
+		NewStyle("line-chart/s4").WithColors("$orange", "$bg0"),
+		NewStyle("line-chart/s5").WithColors("$red", "$bg0"),
+		NewStyle("line-chart/s6").WithColors("$aqua", "$bg0"),
+		NewStyle("line-chart/s7").WithColors("$yellow", "$bg0"),
+		NewStyle("line-chart/axis").WithColors("$fg3", "$bg0"),
+		NewStyle("line-chart/grid").WithColors("$bg3", "$bg0"),
+		NewStyle("line-chart/fill").WithColors("$fg3", "$bg0"),
+		NewStyle("line-chart/label").WithColors("$fg2", "$bg0"),
+		NewStyle("line-chart/label:focused").WithColors("$cyan", "$bg0").WithFont("bold"),
+		NewStyle("line-chart/cursor").WithColors("$cyan", "$bg0").WithFont("bold"),
+		NewStyle("line-chart/legend").WithColors("$fg2", "$bg0"),
+		NewStyle("splitter").WithColors("$fg3", "$bg0"),
+		NewStyle("splitter:focused").WithColors("$cyan", "$bg0").WithFont("bold"),
+		NewStyle("splitter:hovered").WithColors("$fg2", "$bg0"),
+		NewStyle("board").WithColors("$fg0", "$bg0").WithBorder("thin"),
+		NewStyle("board/column").WithColors("$fg0", "$bg0"),
+		NewStyle("board/column:focused").WithColors("$fg0", "$bg1"),
+		NewStyle("board/header").WithColors("$fg1", "$bg1").WithFont("bold"),
+		NewStyle("board/header:focused").WithColors("$bg0", "$cyan").WithFont("bold"),
+		NewStyle("board/header:over").WithColors("$bg0", "$red").WithFont("bold"),
+		NewStyle("board/card").WithColors("$fg1", "$bg2"),
+		NewStyle("board/card:selected").WithColors("$fg0", "$bg3"),
+		NewStyle("board/card:focused").WithColors("$fg0", "$bg3"),
+		NewStyle("board/title").WithColors("$fg0", "").WithFont("bold"),
+		NewStyle("board/title:selected").WithColors("$fg0", "").WithFont("bold"),
+		NewStyle("board/title:focused").WithColors("$cyan", "").WithFont("bold"),
+		NewStyle("board/body").WithColors("$fg1", ""),
+		NewStyle("board/tag").WithColors("$aqua", ""),
+		NewStyle("board/drag").WithColors("$fg3", "$bg1"),
+		NewStyle("board/drop").WithColors("$bg3", "$bg1"),
+		NewStyle("notification").WithColors("$fg0", "$bg1").WithBorder("round").WithPadding(0, 1),
+		NewStyle("notification:debug").WithForeground("$gray"),
+		NewStyle("notification:info").WithForeground("$blue"),
+		NewStyle("notification:success").WithForeground("$green"),
+		NewStyle("notification:warning").WithForeground("$yellow"),
+		NewStyle("notification:error").WithForeground("$red"),
+		NewStyle("notification:fatal").WithForeground("$magenta"),
+		NewStyle("notification/icon").WithFont("bold"),
+		NewStyle("notification/title").WithColors("$fg0", "").WithFont("bold"),
+		NewStyle("notification/body").WithColors("$fg1", ""),
+		NewStyle("notification/close").WithColors("$fg2", "$bg1"),
+		NewStyle("notification/close:hovered").WithColors("$bg0", "$red"),
+		NewStyle("notification/action").WithColors("$fg0", "$bg2"),
+		NewStyle("notification/action:hovered").WithColors("$bg0", "$cyan").WithFont("bold"),
+		NewStyle("calendar").WithColors("$fg0", "$bg0"),
+		NewStyle("calendar/title").WithColors("$fg0", "").WithFont("bold"),
+		NewStyle("calendar/header").WithColors("$fg2", ""),
+		NewStyle("calendar/arrow").WithColors("$fg1", ""),
+		NewStyle("calendar/arrow:hovered").WithColors("$cyan", "").WithFont("bold"),
+		NewStyle("calendar/day").WithColors("$fg1", ""),
+		NewStyle("calendar/day:disabled").WithColors("$bg3", ""),
+		NewStyle("calendar/today").WithColors("$cyan", "").WithFont("bold"),
+		NewStyle("calendar/selected").WithColors("$bg0", "$fg2"),
+		NewStyle("calendar/selected:focused").WithColors("$bg0", "$cyan").WithFont("bold"),
+		NewStyle("calendar/range").WithColors("$fg0", "$bg2"),
+		NewStyle("calendar/overflow").WithColors("$fg3", ""),
+		NewStyle("date-picker").WithColors("$fg0", "$bg2").WithPadding(0, 1),
+		NewStyle("date-picker:focused").WithColors("$bg0", "$cyan"),
+		NewStyle("date-picker/placeholder").WithColors("$fg3", ""),
+		NewStyle("time-spinner").WithColors("$fg0", "$bg2"),
+		NewStyle("time-spinner/field").WithColors("$fg0", ""),
+		NewStyle("time-spinner/field:focused").WithColors("$bg0", "$cyan").WithFont("bold"),
 		NewStyle("heatmap").WithColors("$fg0", "$bg0"),
 		NewStyle("heatmap/header").WithColors("$fg2", "$bg0"),
 		NewStyle("heatmap/zero").WithColors("$fg2", "$bg2"),
@@ -121,6 +201,7 @@ func AddDefaultStyles(theme *Theme) {
 		NewStyle("table/highlight:focused").WithColors("$bg0", "$cyan"),
 		NewStyle("table/cell").WithColors("$fg0", "$bg3"),
 		NewStyle("table/cell:focused").WithColors("$bg0", "$aqua").WithFont("bold"),
+		NewStyle("table/error").WithColors("$bg0", "$red"),
 		NewStyle("tabs/highlight").WithColors("$bg0", "$orange"),
 		NewStyle("tabs/highlight-line").WithForeground("$orange"),
 		NewStyle("tabs/line:focused").WithForeground("$aqua"),
@@ -136,6 +217,8 @@ func AddDefaultStyles(theme *Theme) {
 		NewStyle("viewport"),
 		NewStyle("terminal").WithColors("$fg0", "$bg0"),
 		NewStyle("terminal:focused").WithColors("$fg0", "$bg0"),
+		NewStyle("terminal/match").WithColors("$bg0", "$yellow"),
+		NewStyle("terminal/selection").WithColors("$bg0", "$blue"),
 		NewStyle("shortcuts").WithColors("$fg2", "$bg0"),
 		NewStyle("shortcuts/key").WithForeground("$cyan").WithFont("bold"),
 		NewStyle("shortcuts/label").WithForeground("$fg1"),
@@ -0,0 +1,468 @@
+package themes
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/gdamore/tcell/v3/color"
+	. "github.com/tekugo/zeichenwerk/core"
+)
+
+// A theme file is a JSON document describing a complete Theme:
+//
+//	{
+//	  "extends": "tokyo-night",
+//	  "colors": {"$bg0": "#16161e", "$blue": "#7dcfff"},
+//	  "fallbacks": {"256": {"$blue": "color117"}, "16": {"$blue": "aqua"}},
+//	  "borders": {"thin": {"top": "-", "left": "|", ...}},
+//	  "strings": {"tree.expanded": "▾ "},
+//	  "flags": {"focus-indicator": true},
+//	  "styles": {
+//	    "button": {"foreground": "$bg0", "background": "$blue", "padding": [0, 2]},
+//	    "button:focused": {"background": "$cyan", "font": "bold"}
+//	  }
+//	}
+//
+// Every section is optional. With extends, the file starts from a built-in
+// or registered theme (see [Register]) and only lists what it changes:
+// colours, fallbacks, borders, strings and flags replace entries of the
+// same name, and styles are merged property by property, so a style in the
+// file only overrides the properties it sets. Fallbacks are the colours of
+// variables on terminals with 256 or 16 colours, see [Theme.Resolve].
+
+// themeFile is the JSON form of a theme.
+type themeFile struct {
+	Extends   string                       `json:"extends,omitempty"`
+	Colors    map[string]string            `json:"colors,omitempty"`
+	Fallbacks map[string]map[string]string `json:"fallbacks,omitempty"`
+	Borders   map[string]*Border           `json:"borders,omitempty"`
+	Strings   map[string]string            `json:"strings,omitempty"`
+	Flags     map[string]bool              `json:"flags,omitempty"`
+	Styles    map[string]*styleDef         `json:"styles,omitempty"`
+}
+
+// styleDef is the JSON form of a style. Only properties set on the style
+// itself are listed; the rest is inherited through the cascade. Margin and
+// padding take one to four values like [Style.WithMargin].
+type styleDef struct {
+	Foreground string `json:"foreground,omitempty"`
+	Background string `json:"background,omitempty"`
+	Font       string `json:"font,omitempty"`
+	Border     string `json:"border,omitempty"`
+	Shadow     string `json:"shadow,omitempty"`
+	Cursor     string `json:"cursor,omitempty"`
+	Margin     []int  `json:"margin,omitempty"`
+	Padding    []int  `json:"padding,omitempty"`
+}
+
+// fallbackProfiles are the profiles a theme file may give fallbacks for.
+var fallbackProfiles = []ColorProfile{Profile256, Profile16}
+
+// fonts lists the font attributes the renderer understands.
+var fonts = []string{"blink", "bold", "italic", "normal", "reverse", "strikethrough", "underline"}
+
+// ---- Named Themes ---------------------------------------------------------
+
+var (
+	namedMu sync.RWMutex
+	named   = map[string]func() *Theme{
+		"gruvbox-dark":  GruvboxDark,
+		"gruvbox-light": GruvboxLight,
+		"lipstick":      Lipstick,
+		"midnight-neon": MidnightNeon,
+		"nord":          Nord,
+		"tokyo-night":   TokyoNight,
+	}
+)
+
+// Register makes a theme available under a name, for [Named] and for the
+// extends entry of theme files. fn is called for every lookup, so each
+// caller gets a theme of its own. Registering an existing name replaces
+// it, including the built-in themes.
+func Register(name string, fn func() *Theme) {
+	namedMu.Lock()
+	defer namedMu.Unlock()
+	named[name] = fn
+}
+
+// Named returns a new instance of the theme registered under name. The
+// built-in themes are "gruvbox-dark", "gruvbox-light", "lipstick",
+// "midnight-neon", "nord" and "tokyo-night".
+func Named(name string) (*Theme, bool) {
+	namedMu.RLock()
+	fn, ok := named[name]
+	namedMu.RUnlock()
+	if !ok {
+		return nil, false
+	}
+	return fn(), true
+}
+
+// Names returns the names of all registered themes in lexicographic order.
+func Names() []string {
+	namedMu.RLock()
+	defer namedMu.RUnlock()
+	return slices.Sorted(maps.Keys(named))
+}
+
+// ---- Loading --------------------------------------------------------------
+
+// LoadTheme reads a theme file from r. The extends entry must name a
+// registered theme. Errors wrap [ErrInvalidTheme] and name the offending
+// selector or entry, e.g. `style "button:focused": foreground: undefined
+// colour variable "$bg9"`.
+func LoadTheme(r io.Reader) (*Theme, error) {
+	return loadTheme(r, "", nil)
+}
+
+// LoadThemeFile reads a theme file from disk. Besides a registered name,
+// extends may be the path of another theme file, relative to the directory
+// of this one, such as "base.json".
+func LoadThemeFile(path string) (*Theme, error) {
+	return loadThemeFile(path, nil)
+}
+
+// loadThemeFile reads a theme file, tracking the files already being
+// loaded to reject cyclic extends.
+func loadThemeFile(path string, seen []string) (*Theme, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+	if slices.Contains(seen, abs) {
+		return nil, fmt.Errorf("%w: %s: cyclic extends", ErrInvalidTheme, path)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	theme, err := loadTheme(f, filepath.Dir(abs), append(seen, abs))
+	if err != nil && errors.Is(err, ErrInvalidTheme) && len(seen) == 0 {
+		err = fmt.Errorf("%s: %w", path, err)
+	}
+	return theme, err
+}
+
+// loadTheme decodes and builds a theme. dir is the directory extends paths
+// are resolved against; it is empty when reading from a plain reader.
+func loadTheme(r io.Reader, dir string, seen []string) (*Theme, error) {
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	var file themeFile
+	if err := dec.Decode(&file); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidTheme, err)
+	}
+
+	base := NewTheme()
+	if file.Extends != "" {
+		var ok bool
+		base, ok = Named(file.Extends)
+		if !ok && dir != "" && (strings.ContainsRune(file.Extends, filepath.Separator) || strings.HasSuffix(file.Extends, ".json")) {
+			var err error
+			if base, err = loadThemeFile(filepath.Join(dir, file.Extends), seen); err != nil {
+				return nil, fmt.Errorf("extends %q: %w", file.Extends, err)
+			}
+			ok = true
+		}
+		if !ok {
+			return nil, fmt.Errorf("%w: extends: unknown theme %q", ErrInvalidTheme, file.Extends)
+		}
+	}
+
+	merged := describe(base)
+	merged.merge(&file)
+	if err := file.validate(merged); err != nil {
+		return nil, err
+	}
+	return merged.build(), nil
+}
+
+// describe converts a theme to its file form.
+func describe(theme *Theme) *themeFile {
+	file := &themeFile{
+		Colors:  maps.Clone(theme.Colors()),
+		Borders: make(map[string]*Border, len(theme.Borders())),
+		Strings: maps.Clone(theme.Strings()),
+		Flags:   maps.Clone(theme.Flags()),
+		Styles:  make(map[string]*styleDef),
+	}
+	for _, profile := range fallbackProfiles {
+		if colors := theme.Fallbacks(profile); len(colors) > 0 {
+			if file.Fallbacks == nil {
+				file.Fallbacks = make(map[string]map[string]string)
+			}
+			file.Fallbacks[profile.String()] = maps.Clone(colors)
+		}
+	}
+	for name, border := range theme.Borders() {
+		b := *border
+		file.Borders[name] = &b
+	}
+	for _, style := range theme.Styles() {
+		file.Styles[style.Selector()] = &styleDef{
+			Foreground: style.OwnForeground(),
+			Background: style.OwnBackground(),
+			Font:       style.OwnFont(),
+			Border:     style.OwnBorder(),
+			Shadow:     style.OwnShadow(),
+			Cursor:     style.OwnCursor(),
+			Margin:     insets(style.OwnMargin()),
+			Padding:    insets(style.OwnPadding()),
+		}
+	}
+	return file
+}
+
+// merge overlays another file onto this one.
+func (f *themeFile) merge(other *themeFile) {
+	f.Colors = overlay(f.Colors, other.Colors)
+	for profile, colors := range other.Fallbacks {
+		if f.Fallbacks == nil {
+			f.Fallbacks = make(map[string]map[string]string)
+		}
+		f.Fallbacks[profile] = overlay(f.Fallbacks[profile], colors)
+	}
+	f.Borders = overlay(f.Borders, other.Borders)
+	f.Strings = overlay(f.Strings, other.Strings)
+	f.Flags = overlay(f.Flags, other.Flags)
+	if f.Styles == nil {
+		f.Styles = make(map[string]*styleDef)
+	}
+	for selector, def := range other.Styles {
+		if def == nil {
+			continue
+		}
+		current, ok := f.Styles[selector]
+		if !ok {
+			f.Styles[selector] = def
+			continue
+		}
+		merged := *current
+		set := func(dst *string, src string) {
+			if src != "" {
+				*dst = src
+			}
+		}
+		set(&merged.Foreground, def.Foreground)
+		set(&merged.Background, def.Background)
+		set(&merged.Font, def.Font)
+		set(&merged.Border, def.Border)
+		set(&merged.Shadow, def.Shadow)
+		set(&merged.Cursor, def.Cursor)
+		if def.Margin != nil {
+			merged.Margin = def.Margin
+		}
+		if def.Padding != nil {
+			merged.Padding = def.Padding
+		}
+		f.Styles[selector] = &merged
+	}
+}
+
+// overlay copies the entries of src into dst, allocating dst if needed.
+func overlay[V any](dst, src map[string]V) map[string]V {
+	if dst == nil {
+		dst = make(map[string]V, len(src))
+	}
+	maps.Copy(dst, src)
+	return dst
+}
+
+// validate checks the entries of the file against the merged theme, which
+// provides the colour variables and borders the styles may refer to.
+// Entries inherited from the base theme are not checked.
+func (f *themeFile) validate(theme *themeFile) error {
+	for _, name := range slices.Sorted(maps.Keys(f.Colors)) {
+		if !strings.HasPrefix(name, "$") {
+			return fmt.Errorf("%w: colors %q: variable names must start with $", ErrInvalidTheme, name)
+		}
+		if !validColor(f.Colors[name]) {
+			return fmt.Errorf("%w: colors %q: invalid colour %q", ErrInvalidTheme, name, f.Colors[name])
+		}
+	}
+	for _, key := range slices.Sorted(maps.Keys(f.Fallbacks)) {
+		if profile, ok := ParseColorProfile(key); !ok || !slices.Contains(fallbackProfiles, profile) {
+			return fmt.Errorf("%w: fallbacks %q: expected 256 or 16", ErrInvalidTheme, key)
+		}
+		colors := f.Fallbacks[key]
+		for _, name := range slices.Sorted(maps.Keys(colors)) {
+			if _, ok := theme.Colors[name]; !ok {
+				return fmt.Errorf("%w: fallbacks %q: undefined colour variable %q", ErrInvalidTheme, key, name)
+			}
+			if !validColor(colors[name]) {
+				return fmt.Errorf("%w: fallbacks %q: %q: invalid colour %q", ErrInvalidTheme, key, name, colors[name])
+			}
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(f.Borders)) {
+		if f.Borders[name] == nil {
+			return fmt.Errorf("%w: borders %q: missing glyphs", ErrInvalidTheme, name)
+		}
+	}
+	for _, selector := range slices.Sorted(maps.Keys(f.Styles)) {
+		if err := f.Styles[selector].validate(selector, theme); err != nil {
+			return fmt.Errorf("%w: style %q: %v", ErrInvalidTheme, selector, err)
+		}
+	}
+	return nil
+}
+
+// validate checks one style definition.
+func (d *styleDef) validate(selector string, theme *themeFile) error {
+	if !ValidSelector(selector) {
+		return errors.New("invalid selector")
+	}
+	if d == nil {
+		return errors.New("missing properties")
+	}
+	if err := checkColor(theme, "foreground", d.Foreground); err != nil {
+		return err
+	}
+	if err := checkColor(theme, "background", d.Background); err != nil {
+		return err
+	}
+	for word := range strings.FieldsSeq(d.Font) {
+		if !slices.Contains(fonts, strings.ToLower(word)) {
+			return fmt.Errorf("font: unknown attribute %q", word)
+		}
+	}
+	if border := strings.Fields(d.Border); len(border) > 0 && border[0] != "none" {
+		if _, ok := theme.Borders[border[0]]; !ok {
+			return fmt.Errorf("border: unknown border %q", border[0])
+		}
+		// Optional border foreground and background colours
+		for _, value := range border[1:] {
+			if err := checkColor(theme, "border", value); err != nil {
+				return err
+			}
+		}
+	}
+	for _, i := range []struct {
+		name   string
+		values []int
+	}{{"margin", d.Margin}, {"padding", d.Padding}} {
+		if i.values == nil {
+			continue
+		}
+		if len(i.values) == 0 || len(i.values) > 4 {
+			return fmt.Errorf("%s: expected 1 to 4 values, got %d", i.name, len(i.values))
+		}
+		if slices.Min(i.values) < 0 {
+			return fmt.Errorf("%s: values must not be negative", i.name)
+		}
+	}
+	return nil
+}
+
+// checkColor checks a colour property: a defined variable or a literal
+// colour. Empty values are unset and always valid.
+func checkColor(theme *themeFile, property, value string) error {
+	switch {
+	case value == "":
+	case strings.HasPrefix(value, "$"):
+		if _, ok := theme.Colors[value]; !ok {
+			return fmt.Errorf("%s: undefined colour variable %q", property, value)
+		}
+	case !validColor(value):
+		return fmt.Errorf("%s: invalid colour %q", property, value)
+	}
+	return nil
+}
+
+// validColor reports whether a literal colour is understood by the
+// renderer: a colour name, a palette colour like "color111" or a #rrggbb
+// value.
+func validColor(value string) bool {
+	if _, ok := PaletteIndex(value); ok {
+		return true
+	}
+	return value == "default" || color.GetColor(value) != color.Default
+}
+
+// build creates the theme the file describes. Styles are added from the
+// least to the most specific selector, so each one is linked to its
+// closest parent regardless of the order in the file.
+func (f *themeFile) build() *Theme {
+	theme := NewTheme()
+	theme.SetColors(overlay(nil, f.Colors))
+	for key, colors := range f.Fallbacks {
+		profile, _ := ParseColorProfile(key)
+		theme.SetFallbacks(profile, overlay(nil, colors))
+	}
+	theme.SetBorders(overlay(nil, f.Borders))
+	theme.SetStrings(overlay(nil, f.Strings))
+	theme.SetFlags(overlay(nil, f.Flags))
+
+	selectors := slices.Collect(maps.Keys(f.Styles))
+	slices.SortFunc(selectors, func(a, b string) int {
+		if d := Specificity(b) - Specificity(a); d != 0 {
+			return d
+		}
+		return strings.Compare(a, b)
+	})
+	for _, selector := range selectors {
+		def := f.Styles[selector]
+		style := NewStyle(selector)
+		if def.Foreground != "" || def.Background != "" {
+			style.WithColors(def.Foreground, def.Background)
+		}
+		if def.Font != "" {
+			style.WithFont(def.Font)
+		}
+		if def.Border != "" {
+			style.WithBorder(def.Border)
+		}
+		if def.Shadow != "" {
+			style.WithShadow(def.Shadow)
+		}
+		if def.Cursor != "" {
+			style.WithCursor(def.Cursor)
+		}
+		if def.Margin != nil {
+			style.WithMargin(def.Margin...)
+		}
+		if def.Padding != nil {
+			style.WithPadding(def.Padding...)
+		}
+		theme.Add(style)
+	}
+	return theme
+}
+
+// ---- Saving ---------------------------------------------------------------
+
+// SaveTheme writes a complete theme file for theme to w: all colour
+// variables and their fallbacks, borders, strings, flags and styles,
+// without extends. Loading the file with [LoadTheme] yields an equivalent
+// theme.
+func SaveTheme(w io.Writer, theme *Theme) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	enc.SetEscapeHTML(false)
+	return enc.Encode(describe(theme))
+}
+
+// insets returns the shortest CSS-style shorthand for insets, or nil.
+func insets(i *Insets) []int {
+	switch {
+	case i == nil:
+		return nil
+	case i.Top == i.Bottom && i.Left == i.Right && i.Top == i.Left:
+		return []int{i.Top}
+	case i.Top == i.Bottom && i.Left == i.Right:
+		return []int{i.Top, i.Left}
+	case i.Left == i.Right:
+		return []int{i.Top, i.Left, i.Bottom}
+	default:
+		return []int{i.Top, i.Right, i.Bottom, i.Left}
+	}
+}
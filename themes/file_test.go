@@ -0,0 +1,177 @@
+package themes
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	. "github.com/tekugo/zeichenwerk/core"
+)
+
+// sameStyle compares the resolved properties of two styles.
+func sameStyle(a, b *Style) bool {
+	return a.Foreground() == b.Foreground() && a.Background() == b.Background() &&
+		a.Font() == b.Font() && a.Border() == b.Border() && a.Cursor() == b.Cursor() &&
+		*a.Margin() == *b.Margin() && *a.Padding() == *b.Padding()
+}
+
+func TestSaveTheme_RoundTrip(t *testing.T) {
+	for _, name := range Names() {
+		t.Run(name, func(t *testing.T) {
+			original, _ := Named(name)
+			var buf bytes.Buffer
+			if err := SaveTheme(&buf, original); err != nil {
+				t.Fatal(err)
+			}
+			loaded, err := LoadTheme(&buf)
+			if err != nil {
+				t.Fatalf("LoadTheme: %v", err)
+			}
+			for _, style := range original.Styles() {
+				for _, state := range []string{"", ":focused", ":hovered", ".dialog:disabled"} {
+					selector := style.Selector() + state
+					if !sameStyle(original.Get(selector), loaded.Get(selector)) {
+						t.Errorf("%q resolves differently:\n%s\n---\n%s", selector, original.Get(selector).Info(), loaded.Get(selector).Info())
+					}
+				}
+			}
+			if loaded.Color("$bg0") != original.Color("$bg0") || loaded.String("tree.expanded") != original.String("tree.expanded") {
+				t.Error("colours or strings differ")
+			}
+			if *loaded.Border("round") != *original.Border("round") {
+				t.Error("round border differs")
+			}
+		})
+	}
+}
+
+func TestLoadTheme_Extends(t *testing.T) {
+	doc := `{
+		"extends": "tokyo-night",
+		"colors": {"$blue": "#0000ff", "$accent": "#ff00ff"},
+		"flags": {"custom": true},
+		"styles": {
+			"button:focused": {"font": "bold"},
+			"button": {"background": "$accent"},
+			"input.search": {"padding": [0, 1]}
+		}
+	}`
+	theme, err := LoadTheme(strings.NewReader(doc))
+	if err != nil {
+		t.Fatal(err)
+	}
+	base := TokyoNight()
+	if got := theme.Color("$blue"); got != "#0000ff" {
+		t.Errorf("$blue = %q; want the override", got)
+	}
+	if got := theme.Color("$bg0"); got != base.Color("$bg0") {
+		t.Errorf("$bg0 = %q; want the inherited value", got)
+	}
+	if !theme.Flag("custom") {
+		t.Error("flag not set")
+	}
+	button := theme.Get("button")
+	if button.Background() != "$accent" || button.Foreground() != base.Get("button").Foreground() {
+		t.Errorf("button = %s / %s; want inherited foreground and new background", button.Foreground(), button.Background())
+	}
+	if got := button.Padding(); *got != *base.Get("button").Padding() {
+		t.Errorf("button padding = %s; want it kept from the base style", got.Info())
+	}
+	focused := theme.Get("button:focused")
+	if focused.Font() != "bold" || focused.Background() != base.Get("button:focused").Background() {
+		t.Errorf("button:focused = %s", focused.Info())
+	}
+	search := theme.Get("input.search")
+	if search.Padding().Left != 1 || search.Background() != base.Get("input").Background() {
+		t.Errorf("input.search = %s", search.Info())
+	}
+}
+
+func TestLoadTheme_Fallbacks(t *testing.T) {
+	doc := `{
+		"extends": "tokyo-night",
+		"fallbacks": {"256": {"$blue": "color111"}, "16": {"$blue": "blue", "$bg0": "black"}}
+	}`
+	theme, err := LoadTheme(strings.NewReader(doc))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := theme.Fallbacks(Profile16)["$blue"]; got != "blue" {
+		t.Errorf("16-colour $blue = %q; want blue", got)
+	}
+
+	var buf bytes.Buffer
+	if err := SaveTheme(&buf, theme); err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := LoadTheme(&buf)
+	if err != nil {
+		t.Fatalf("LoadTheme: %v", err)
+	}
+	if got := loaded.Fallbacks(Profile256)["$blue"]; got != "color111" {
+		t.Errorf("256-colour $blue after round trip = %q; want color111", got)
+	}
+	if got := len(loaded.Fallbacks(Profile16)); got != 2 {
+		t.Errorf("16-colour fallbacks after round trip = %d; want 2", got)
+	}
+}
+
+func TestLoadTheme_Errors(t *testing.T) {
+	cases := []struct {
+		name, doc, want string
+	}{
+		{"syntax", `{"colors": }`, "invalid character"},
+		{"unknown key", `{"colours": {}}`, "unknown field"},
+		{"extends", `{"extends": "nope"}`, `extends: unknown theme "nope"`},
+		{"variable name", `{"colors": {"bg": "#000000"}}`, `colors "bg"`},
+		{"colour", `{"colors": {"$bg": "#00"}}`, `invalid colour "#00"`},
+		{"undefined", `{"extends": "nord", "styles": {"button:focused": {"foreground": "$bg9"}}}`, `style "button:focused": foreground: undefined colour variable "$bg9"`},
+		{"border", `{"styles": {"box": {"border": "fancy"}}}`, `style "box": border: unknown border "fancy"`},
+		{"font", `{"styles": {"list/item": {"font": "bold wavy"}}}`, `style "list/item": font: unknown attribute "wavy"`},
+		{"insets", `{"styles": {"box": {"margin": [1, 2, 3, 4, 5]}}}`, `style "box": margin: expected 1 to 4 values`},
+		{"selector", `{"styles": {"box button": {}}}`, `style "box button": invalid selector`},
+		{"fallback profile", `{"colors": {"$bg": "#000000"}, "fallbacks": {"mono": {"$bg": "black"}}}`, `fallbacks "mono": expected 256 or 16`},
+		{"fallback variable", `{"fallbacks": {"256": {"$bg": "color16"}}}`, `fallbacks "256": undefined colour variable "$bg"`},
+		{"fallback colour", `{"colors": {"$bg": "#000000"}, "fallbacks": {"16": {"$bg": "color300"}}}`, `invalid colour "color300"`},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			_, err := LoadTheme(strings.NewReader(c.doc))
+			if !errors.Is(err, ErrInvalidTheme) {
+				t.Fatalf("err = %v; want ErrInvalidTheme", err)
+			}
+			if !strings.Contains(err.Error(), c.want) {
+				t.Errorf("err = %q; want it to contain %q", err, c.want)
+			}
+		})
+	}
+}
+
+func TestLoadThemeFile_ExtendsFile(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, doc string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(doc), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+	write("base.json", `{"extends": "nord", "colors": {"$brand": "#123456"}}`)
+	path := write("custom.json", `{"extends": "base.json", "styles": {"static.brand": {"foreground": "$brand"}}}`)
+	theme, err := LoadThemeFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := theme.Color(theme.Get("static.brand").Foreground()); got != "#123456" {
+		t.Errorf("static.brand foreground = %q; want #123456", got)
+	}
+
+	write("a.json", `{"extends": "b.json"}`)
+	write("b.json", `{"extends": "a.json"}`)
+	if _, err := LoadThemeFile(filepath.Join(dir, "a.json")); !errors.Is(err, ErrInvalidTheme) || !strings.Contains(err.Error(), "cyclic") {
+		t.Errorf("err = %v; want a cyclic extends error", err)
+	}
+}
@@ -47,6 +47,15 @@ func GruvboxLight() *Theme {
 		"$aqua_bright":   "#8ec07c", // Bright aqua
 		"$orange_bright": "#fe8019", // Bright orange
 
+		// Faded variants for chart series
+		"$red_dim":    "#9d0006", // Faded red
+		"$green_dim":  "#79740e", // Faded green
+		"$yellow_dim": "#b57614", // Faded yellow
+		"$blue_dim":   "#076678", // Faded blue
+		"$purple_dim": "#8f3f71", // Faded purple
+		"$aqua_dim":   "#427b58", // Faded aqua
+		"$orange_dim": "#af3a03", // Faded orange
+
 		// Aliases
 		"$cyan":    "#689d6a", // Alias for aqua
 		"$magenta": "#b16286", // Alias for purple
@@ -85,6 +94,19 @@ func GruvboxLight() *Theme {
 		NewStyle("editor/current-line-number").WithColors("$orange", "$bg1"),
 		NewStyle("editor/line-numbers").WithColors("$gray", "$bg0"),
 		NewStyle("editor/selection").WithColors("$bg0", "$blue"),
+		NewStyle("editor/match").WithColors("$bg0", "$yellow"),
+		NewStyle("editor/code").WithForeground("$green"),
+		NewStyle("editor/comment").WithForeground("$gray").WithFont("italic"),
+		NewStyle("editor/constant").WithForeground("$orange"),
+		NewStyle("editor/emphasis").WithForeground("$yellow").WithFont("italic"),
+		NewStyle("editor/heading").WithForeground("$blue").WithFont("bold"),
+		NewStyle("editor/key").WithForeground("$blue"),
+		NewStyle("editor/keyword").WithForeground("$magenta"),
+		NewStyle("editor/link").WithForeground("$cyan").WithFont("underline"),
+		NewStyle("editor/number").WithForeground("$orange"),
+		NewStyle("editor/string").WithForeground("$green"),
+		NewStyle("editor/type").WithForeground("$cyan"),
+		NewStyle("editor/variable").WithForeground("$aqua"),
 		NewStyle("input").WithColors("$fg0", "$bg1").WithCursor("*bar"),
 		NewStyle("input:focused").WithColors("$bg0", "$orange"),
 		NewStyle("typeahead").WithColors("$fg0", "$bg1").WithCursor("*bar"),
@@ -116,6 +138,73 @@ func GruvboxLight() *Theme {
 		NewStyle("bar-chart/selection").WithColors("$fg0", "$bg3"),
 		NewStyle("bar-chart/value").WithColors("$bg1", "$fg0"),
 		NewStyle("bar-chart/legend").WithColors("$bg1", "$fg0"),
+		NewStyle("line-chart").WithColors("$fg1", "$bg0"),
+		NewStyle("line-chart/s0").WithColors("$blue_dim", "$fg0"),
+		NewStyle("line-chart/s1").WithColors("$green_dim", "$fg0"),
+		NewStyle("line-chart/s2").WithColors("$orange_dim", "$fg0"),
+		NewStyle("line-chart/s3").WithColors("$purple_dim", "$fg0"),
+		NewStyle("line-chart/s4").WithColors("$aqua_dim", "$fg0"),
+		NewStyle("line-chart/s5").WithColors("$yellow_dim", "$fg0"),
+		NewStyle("line-chart/s6").WithColors("$red_dim", "$fg0"),
+		NewStyle("line-chart/s7").WithColors("$blue", "$fg0"),
+		NewStyle("line-chart/axis").WithColors("$bg4", "$fg0"),
+		NewStyle("line-chart/grid").WithColors("$bg3", "$fg0"),
+		NewStyle("line-chart/fill").WithColors("$bg4", "$fg0"),
+		NewStyle("line-chart/label").WithColors("$bg1", "$fg0"),
+		NewStyle("line-chart/label:focused").WithColors("$orange", "$fg0").WithFont("bold"),
+		NewStyle("line-chart/cursor").WithColors("$orange", "$fg0").WithFont("bold"),
+		NewStyle("line-chart/legend").WithColors("$bg1", "$fg0"),
+		NewStyle("splitter").WithColors("$bg4", "$fg0"),
+		NewStyle("splitter:focused").WithColors("$orange", "$fg0").WithFont("bold"),
+		NewStyle("splitter:hovered").WithColors("$bg1", "$fg0"),
+		NewStyle("board").WithColors("$fg0", "$bg0").WithBorder("thin"),
+		NewStyle("board/column").WithColors("$fg0", "$bg0"),
+		NewStyle("board/column:focused").WithColors("$fg0", "$bg1"),
+		NewStyle("board/header").WithColors("$fg1", "$bg1").WithFont("bold"),
+		NewStyle("board/header:focused").WithColors("$bg0", "$orange").WithFont("bold"),
+		NewStyle("board/header:over").WithColors("$bg0", "$red").WithFont("bold"),
+		NewStyle("board/card").WithColors("$fg1", "$bg2"),
+		NewStyle("board/card:selected").WithColors("$fg0", "$bg3"),
+		NewStyle("board/card:focused").WithColors("$fg0", "$bg3"),
+		NewStyle("board/title").WithColors("$fg0", "").WithFont("bold"),
+		NewStyle("board/title:selected").WithColors("$fg0", "").WithFont("bold"),
+		NewStyle("board/title:focused").WithColors("$orange", "").WithFont("bold"),
+		NewStyle("board/body").WithColors("$fg1", ""),
+		NewStyle("board/tag").WithColors("$aqua", ""),
+		NewStyle("board/drag").WithColors("$fg4", "$bg1"),
+		NewStyle("board/drop").WithColors("$bg3", "$bg1"),
+		NewStyle("notification").WithColors("$fg0", "$bg1").WithBorder("round").WithPadding(0, 1),
+		NewStyle("notification:debug").WithForeground("$gray"),
+		NewStyle("notification:info").WithForeground("$blue"),
+		NewStyle("notification:success").WithForeground("$green"),
+		NewStyle("notification:warning").WithForeground("$yellow"),
+		NewStyle("notification:error").WithForeground("$red"),
+		NewStyle("notification:fatal").WithForeground("$magenta"),
+		NewStyle("notification/icon").WithFont("bold"),
+		NewStyle("notification/title").WithColors("$fg0", "").WithFont("bold"),
+		NewStyle("notification/body").WithColors("$fg1", ""),
+		NewStyle("notification/close").WithColors("$fg2", "$bg1"),
+		NewStyle("notification/close:hovered").WithColors("$bg0", "$red"),
+		NewStyle("notification/action").WithColors("$fg0", "$bg2"),
+		NewStyle("notification/action:hovered").WithColors("$bg0", "$orange").WithFont("bold"),
+		NewStyle("calendar").WithColors("$fg0", "$bg0"),
+		NewStyle("calendar/title").WithColors("$fg0", "").WithFont("bold"),
+		NewStyle("calendar/header").WithColors("$fg2", ""),
+		NewStyle("calendar/arrow").WithColors("$fg1", ""),
+		NewStyle("calendar/arrow:hovered").WithColors("$orange", "").WithFont("bold"),
+		NewStyle("calendar/day").WithColors("$fg1", ""),
+		NewStyle("calendar/day:disabled").WithColors("$bg3", ""),
+		NewStyle("calendar/today").WithColors("$orange", "").WithFont("bold"),
+		NewStyle("calendar/selected").WithColors("$bg0", "$fg2"),
+		NewStyle("calendar/selected:focused").WithColors("$bg0", "$orange").WithFont("bold"),
+		NewStyle("calendar/range").WithColors("$fg0", "$bg2"),
+		NewStyle("calendar/overflow").WithColors("$fg3", ""),
+		NewStyle("date-picker").WithColors("$fg0", "$bg1").WithPadding(0, 1),
+		NewStyle("date-picker:focused").WithColors("$bg0", "$orange"),
+		NewStyle("date-picker/placeholder").WithColors("$fg3", ""),
+		NewStyle("time-spinner").WithColors("$fg0", "$bg1"),
+		NewStyle("time-spinner/field").WithColors("$fg0", ""),
+		NewStyle("time-spinner/field:focused").WithColors("$bg0", "$orange").WithFont("bold"),
 		NewStyle("heatmap").WithColors("$fg0", "$bg0"),
 		NewStyle("heatmap/header").WithColors("$fg2", "$bg0"),
 		NewStyle("heatmap/zero").WithColors("$fg4", "$bg2"),
@@ -157,6 +246,7 @@ func GruvboxLight() *Theme {
 		NewStyle("table/highlight:focused").WithColors("$bg0", "$orange"),
 		NewStyle("table/cell").WithColors("$fg0", "$bg3"),
 		NewStyle("table/cell:focused").WithColors("$bg0", "$yellow").WithFont("bold"),
+		NewStyle("table/error").WithColors("$bg0", "$red"),
 		NewStyle("tabs/highlight").WithColors("$bg0", "$fg3"),
 		NewStyle("tabs/highlight-line").WithForeground("$fg3"),
 		NewStyle("tabs/line:focused").WithForeground("$orange"),
@@ -172,6 +262,8 @@ func GruvboxLight() *Theme {
 		NewStyle("viewport"),
 		NewStyle("terminal").WithColors("$fg0", "$bg0"),
 		NewStyle("terminal:focused").WithColors("$fg0", "$bg0"),
+		NewStyle("terminal/match").WithColors("$bg0", "$yellow"),
+		NewStyle("terminal/selection").WithColors("$bg0", "$blue"),
 		NewStyle("shortcuts").WithColors("$fg2", "$bg0"),
 		NewStyle("shortcuts/key").WithForeground("$blue").WithFont("bold"),
 		NewStyle("shortcuts/label").WithForeground("$fg1"),
@@ -0,0 +1,117 @@
+package themes
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	. "github.com/tekugo/zeichenwerk/core"
+)
+
+// Minimum contrast ratios of normal text for the WCAG 2.1 conformance
+// levels, to pass to [Lint].
+const (
+	ContrastAA  = 4.5
+	ContrastAAA = 7.0
+)
+
+// Checks reported by [Lint].
+const (
+	CheckContrast = "contrast" // Text colour too close to its background
+	CheckFocus    = "focus"    // Focused state looks like the normal state
+	CheckVariable = "variable" // Colour variable that is not defined
+)
+
+// Issue is a problem found by [Lint].
+type Issue struct {
+	Selector string // Selector of the style, or the colour variable of a fallback
+	Check    string // CheckContrast, CheckFocus or CheckVariable
+	Message  string // Description of the problem
+}
+
+// String returns the issue as "selector: check: message".
+func (i Issue) String() string {
+	return fmt.Sprintf("%q: %s: %s", i.Selector, i.Check, i.Message)
+}
+
+// Lint checks the styles of a theme for accessibility problems and
+// mistakes and returns the issues found, sorted by selector:
+//
+//   - contrast: the foreground and background colour of a style, resolved
+//     through its parents, have a contrast ratio below minimum, usually
+//     ContrastAA or ContrastAAA. Pairs with a colour that is not a hex or
+//     ANSI colour, like "default" or an undefined variable, are skipped,
+//     and so are disabled states, which WCAG exempts.
+//   - focus: the focused state of a style cannot be told apart from its
+//     normal state, because colours, font and border are the same. Only
+//     styles of widgets the theme has a focused style for are checked.
+//   - variable: a style, border or fallback refers to a colour variable
+//     the theme does not define.
+//
+// Lint is meant for CI runs on custom themes; see the "zw theme lint"
+// command.
+func Lint(theme *Theme, minimum float64) []Issue {
+	var issues []Issue
+	report := func(selector, check, format string, args ...any) {
+		issues = append(issues, Issue{selector, check, fmt.Sprintf(format, args...)})
+	}
+
+	colors := theme.Colors()
+	for _, profile := range fallbackProfiles {
+		for _, name := range slices.Sorted(maps.Keys(theme.Fallbacks(profile))) {
+			if _, ok := colors[name]; !ok {
+				report(name, CheckVariable, "fallback for %s colours of undefined variable", profile)
+			}
+		}
+	}
+
+	bases := make(map[string]bool)
+	for _, style := range theme.Styles() {
+		selector := style.Selector()
+		base, state, _ := strings.Cut(selector, ":")
+		bases[base] = true
+
+		for _, value := range append([]string{style.OwnForeground(), style.OwnBackground()}, strings.Fields(style.OwnBorder())...) {
+			if _, ok := colors[value]; strings.HasPrefix(value, "$") && !ok {
+				report(selector, CheckVariable, "undefined colour variable %q", value)
+			}
+		}
+		if state == "disabled" {
+			continue // Exempt from contrast requirements
+		}
+
+		fg, bg := theme.Color(style.Foreground()), theme.Color(style.Background())
+		r1, g1, b1, ok1 := ColorRGB(fg)
+		r2, g2, b2, ok2 := ColorRGB(bg)
+		if !ok1 || !ok2 {
+			continue
+		}
+		if ratio := ContrastRatio(r1, g1, b1, r2, g2, b2); ratio < minimum {
+			report(selector, CheckContrast, "%s on %s has a contrast of %.2f:1, below %.1f:1", fg, bg, ratio, minimum)
+		}
+	}
+
+	for base := range bases {
+		focused := theme.Get(base + ":focused")
+		if !strings.HasSuffix(focused.Selector(), ":focused") {
+			continue // No focus style, so the widget is not focusable
+		}
+		if normal := theme.Get(base); looksSame(theme, normal, focused) {
+			report(base, CheckFocus, "focused state %q looks like the normal state", focused.Selector())
+		}
+	}
+
+	slices.SortFunc(issues, func(a, b Issue) int {
+		return cmp.Or(strings.Compare(a.Selector, b.Selector), strings.Compare(a.Check, b.Check), strings.Compare(a.Message, b.Message))
+	})
+	return issues
+}
+
+// looksSame reports whether two styles are rendered the same way.
+func looksSame(theme *Theme, a, b *Style) bool {
+	return theme.Color(a.Foreground()) == theme.Color(b.Foreground()) &&
+		theme.Color(a.Background()) == theme.Color(b.Background()) &&
+		a.Font() == b.Font() && a.Border() == b.Border()
+}
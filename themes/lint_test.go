@@ -0,0 +1,60 @@
+package themes
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/tekugo/zeichenwerk/core"
+)
+
+func TestLint(t *testing.T) {
+	theme := NewTheme()
+	theme.SetColors(map[string]string{"$bg": "#000000", "$fg": "#ffffff", "$dim": "#333333"})
+	theme.SetFallbacks(Profile16, map[string]string{"$gone": "navy"})
+	theme.AddStyles(
+		NewStyle("").WithColors("$fg", "$bg"),
+		NewStyle("static.hint").WithForeground("$dim"),
+		NewStyle("button").WithColors("$bg", "$fg"),
+		NewStyle("button:disabled").WithForeground("#eeeeee"),
+		NewStyle("input").WithBorder("thin $accent"),
+		NewStyle("input:focused").WithColors("$fg", "$bg"),
+		NewStyle("list").WithColors("#777777", "$bg"),
+		NewStyle("list:focused").WithFont("bold"),
+	)
+
+	var got []string
+	for _, issue := range Lint(theme, ContrastAA) {
+		got = append(got, issue.Selector+" "+issue.Check)
+	}
+	want := []string{
+		"$gone variable",
+		"input focus",
+		"input variable",
+		"static.hint contrast",
+	}
+	if strings.Join(got, ", ") != strings.Join(want, ", ") {
+		t.Errorf("issues = %v; want %v", got, want)
+	}
+
+	// #777777 on black passes AA but not AAA.
+	var aaa []string
+	for _, issue := range Lint(theme, ContrastAAA) {
+		if issue.Check == CheckContrast {
+			aaa = append(aaa, issue.Selector)
+		}
+	}
+	if strings.Join(aaa, ", ") != "list, list:focused, static.hint" {
+		t.Errorf("AAA contrast issues = %v", aaa)
+	}
+}
+
+func TestLint_BuiltinVariables(t *testing.T) {
+	for _, name := range Names() {
+		theme, _ := Named(name)
+		for _, issue := range Lint(theme, 1) {
+			if issue.Check == CheckVariable {
+				t.Errorf("%s: %s", name, issue)
+			}
+		}
+	}
+}
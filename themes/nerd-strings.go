@@ -48,11 +48,21 @@ func AddNerdStrings(theme *Theme) {
 		// ---- Select ----
 		"select.dropdown": " \u25BC",
 
+		// ---- Splitter ----
+		"splitter.vertical":        "│",
+		"splitter.horizontal":      "─",
+		"splitter.grip.vertical":   "╪",
+		"splitter.grip.horizontal": "╫",
+
 		// ---- Shortcuts ----
 		"shortcuts.prefix":    "",
 		"shortcuts.separator": "   ",
 		"shortcuts.suffix":    "",
 
+		// ---- Table ----
+		"table.sort.ascending":  "▲",
+		"table.sort.descending": "▼",
+
 		// ---- Tree ----
 		"tree.expanded":  " ▼",
 		"tree.collapsed": " ▶",
@@ -69,6 +79,37 @@ func AddNerdStrings(theme *Theme) {
 		"bar-chart.grid":   "┄",
 		"bar-chart.swatch": "█",
 
+		// ---- Line Chart ----
+		"line-chart.corner": "└",
+		"line-chart.hline":  "─",
+		"line-chart.vline":  "│",
+		"line-chart.tick-x": "┬",
+		"line-chart.tick-y": "┤",
+		"line-chart.grid":   "┄",
+		"line-chart.swatch": "█",
+		"line-chart.cursor": "│",
+
+		// ---- Board ----
+		"board.separator": "│",
+		"board.rule":      "─",
+		"board.cross":     "┼",
+		"board.label":     "▌",
+		"board.drop":      "░",
+		"board.wip-ok":    "",
+		"board.wip-over":  "!",
+
+		// ---- Notification ----
+		"notification.info":    "\uF05A",
+		"notification.success": "\uF058",
+		"notification.warning": "\uF071",
+		"notification.error":   "\uF057",
+		"notification.close":   "[×]",
+
+		// ---- Calendar ----
+		"calendar.prev":        "\u25C0",
+		"calendar.next":        "\u25B6",
+		"date-picker.dropdown": " \u25BC",
+
 		// ---- Breadcrumb ----
 		"breadcrumb.separator": " › ",
 		"breadcrumb.overflow":  "…",
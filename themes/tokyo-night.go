@@ -22,6 +22,7 @@ func TokyoNight() *Theme {
 		"$fg0":     "#c0caf5",
 		"$fg1":     "#a9b1d6", // secondary text
 		"$fg2":     "#565f89", // muted / comment text
+		"$fg3":     "#414868", // faint text, axes and rules
 		"$gray":    "#3b4261", // decorative / line numbers
 		"$blue":    "#7aa2f7",
 		"$cyan":    "#2ac3de",
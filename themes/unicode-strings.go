@@ -50,11 +50,21 @@ func AddUnicodeStrings(theme *Theme) {
 		// ---- Select ----
 		"select.dropdown": " ▼",
 
+		// ---- Splitter ----
+		"splitter.vertical":        "│",
+		"splitter.horizontal":      "─",
+		"splitter.grip.vertical":   "╪",
+		"splitter.grip.horizontal": "╫",
+
 		// ---- Shortcuts ----
 		"shortcuts.prefix":    "",
 		"shortcuts.separator": "   ",
 		"shortcuts.suffix":    "",
 
+		// ---- Table ----
+		"table.sort.ascending":  "▲",
+		"table.sort.descending": "▼",
+
 		// ---- Tree ----
 		"tree.expanded":  "▼ ",
 		"tree.collapsed": "▶ ",
@@ -71,6 +81,37 @@ func AddUnicodeStrings(theme *Theme) {
 		"bar-chart.grid":   "┄",
 		"bar-chart.swatch": "█",
 
+		// ---- Line Chart ----
+		"line-chart.corner": "└",
+		"line-chart.hline":  "─",
+		"line-chart.vline":  "│",
+		"line-chart.tick-x": "┬",
+		"line-chart.tick-y": "┤",
+		"line-chart.grid":   "┄",
+		"line-chart.swatch": "█",
+		"line-chart.cursor": "│",
+
+		// ---- Board ----
+		"board.separator": "│",
+		"board.rule":      "─",
+		"board.cross":     "┼",
+		"board.label":     "▌",
+		"board.drop":      "░",
+		"board.wip-ok":    "",
+		"board.wip-over":  "!",
+
+		// ---- Notification ----
+		"notification.info":    "ℹ",
+		"notification.success": "✓",
+		"notification.warning": "⚠",
+		"notification.error":   "✗",
+		"notification.close":   "[×]",
+
+		// ---- Calendar ----
+		"calendar.prev":        "◀",
+		"calendar.next":        "▶",
+		"date-picker.dropdown": " ▼",
+
 		// ---- Breadcrumb ----
 		"breadcrumb.separator": " › ",
 		"breadcrumb.overflow":  "…",
@@ -8,6 +8,7 @@ import (
 	"log/slog"
 	"os"
 	"runtime/debug"
+	"strings"
 	"sync"
 
 	"github.com/gdamore/tcell/v3"
@@ -38,7 +39,9 @@ import (
 // # Event System
 //
 //   - Hierarchical event propagation from focused widget up the parent chain
-//   - Global keyboard shortcuts (Tab navigation, Ctrl+C/Escape to quit)
+//   - Global key bindings via a replaceable Keymap (Tab navigation,
+//     Escape to close popups, Ctrl+C/q to quit) with per-layer scopes and
+//     multi-key sequences
 //   - Mouse hover detection and click handling
 //   - Automatic screen resize handling with layout recalculation
 //
@@ -81,14 +84,24 @@ type UI struct {
 	redraw  chan Widget   // Buffered channel for triggering individual widget redraws (performance optimization)
 	refresh chan struct{} // Buffered channel for triggering full screen redraws
 
+	// Update queue
+	postMu sync.Mutex    // Guards posted
+	posted []func()      // Closures queued by Post, run in order on the event loop
+	wake   chan struct{} // Signals the event loop that posted is not empty
+
 	// Widget state tracking
 	focus      Widget   // Currently focused widget that receives keyboard input and cursor positioning
 	focusStack []Widget // Focus saved before each popup layer was opened; restored on Close
 	hover      Widget   // Currently hovered widget for mouse interaction feedback and styling
+	capture    Widget   // Widget receiving all mouse events while set, e.g. during a drag
 
 	// Layer management
 	layers []Container // Stack of widget layers (base layer + popups/modals) for proper z-order rendering
 
+	// Notifications
+	notices      []*notice // Notifications shown by Notify, bottom to top, drawn above all layers
+	noticeSerial int       // Counter for notification IDs
+
 	// Logging infrastructure
 	tableLog   *TableLog
 	logger     *slog.Logger
@@ -99,9 +112,18 @@ type UI struct {
 	refreshs int // Counter for full screen refreshes (debugging and performance monitoring)
 
 	// Rendering system
-	theme    *Theme       // UI theme
-	renderer *Renderer    // Renderer instance responsible for drawing to the terminal
-	screen   tcell.Screen // The terminal screen interface for low-level cell manipulation and event polling
+	theme    *Theme                 // UI theme
+	profile  ColorProfile           // Colours the terminal displays
+	detect   bool                   // Whether Run detects the profile
+	renderer *Renderer              // Renderer instance responsible for drawing to the terminal
+	screen   tcell.Screen           // The terminal screen interface for low-level cell manipulation and event polling
+	memory   *renderer.MemoryScreen // In-memory screen used instead of screen when running headless
+
+	// Key bindings
+	keymap  *Keymap               // global key bindings, consulted after the widget chain
+	scoped  map[Container]*Keymap // per-layer key bindings, consulted before the global keymap
+	actions map[string]func()     // named actions that key bindings refer to
+	pending []string              // chords of a partially typed multi-key sequence
 
 	// Commands palette
 	commands *Commands // lazy singleton; allocated on first call to Commands()
@@ -151,6 +173,25 @@ func NewUI(theme *Theme, root Container) *UI {
 		events:    make(chan tcell.Event, 10),
 		redraw:    make(chan Widget, 10), // Initialize redraw channel with buffer
 		refresh:   make(chan struct{}, 1),
+		wake:      make(chan struct{}, 1),
+		keymap:    DefaultKeymap(),
+		scoped:    make(map[Container]*Keymap),
+		detect:    true,
+	}
+
+	ui.actions = map[string]func(){
+		ActionAppQuit:       ui.Quit,
+		ActionCommandsOpen:  func() { ui.Commands().Open() },
+		ActionFocusNext:     func() { ui.SetFocus("next") },
+		ActionFocusPrevious: func() { ui.SetFocus("previous") },
+		ActionLayerClose: func() {
+			if len(ui.layers) > 1 {
+				ui.Close()
+			}
+		},
+		ActionNone:                 func() {},
+		ActionNotificationsDismiss: ui.DismissNotification,
+		ActionNotificationsHistory: ui.NotificationHistory,
 	}
 
 	if root != nil {
@@ -207,35 +248,24 @@ func (ui *UI) Handle(event tcell.Event) bool {
 		// If the event is handled by the focused widget or one of its parents
 		// we do not process the event any further.
 		if ui.dispatch(ui.focus, EvtKey, event) {
+			ui.pending = nil
 			break
 		}
 		if ui.Dispatch(ui.focus, EvtKey, event) {
+			ui.pending = nil
 			break
 		}
 
-		// Handle global app events, if the keyboard event was propagated
-		switch event.Key() {
-		case tcell.KeyTab, tcell.KeyRight, tcell.KeyDown:
-			ui.SetFocus("next")
-		case tcell.KeyBacktab, tcell.KeyLeft, tcell.KeyUp:
-			ui.SetFocus("previous")
-		case tcell.KeyEscape:
-			if len(ui.layers) > 1 {
-				ui.Close()
-			}
-		case tcell.KeyCtrlC, tcell.KeyCtrlQ:
-			ui.Quit()
-		case tcell.KeyRune:
-			switch event.Str() {
-			case "q", "Q":
-				ui.Quit()
-			}
-		}
+		// Handle global key bindings, if the keyboard event was propagated
+		ui.handleBinding(event)
 
 	case *tcell.EventMouse:
-		// We only search the highest layer for hovering
+		// We only search the notifications and the highest layer for hovering
 		mx, my := event.Position()
-		at := FindAt(ui.layers[len(ui.layers)-1], mx, my)
+		at := ui.noticeAt(mx, my)
+		if at == nil {
+			at = FindAt(ui.layers[len(ui.layers)-1], mx, my)
+		}
 		if at != ui.hover {
 			if ui.hover != nil {
 				ui.hover.SetFlag(FlagHovered, false)
@@ -246,7 +276,7 @@ func (ui *UI) Handle(event tcell.Event) bool {
 				at.SetFlag(FlagHovered, true)
 				ui.Redraw(at)
 			}
-		} else {
+		} else if ui.capture == nil {
 			switch event.Buttons() {
 			case tcell.Button1:
 				if at.Flag(FlagFocusable) && at != ui.focus {
@@ -255,20 +285,27 @@ func (ui *UI) Handle(event tcell.Event) bool {
 			}
 		}
 		ui.dispatch(ui.hover, EvtHover, event)
+		// A capturing widget gets the mouse events wherever the mouse is;
+		// hover tracking above still follows the mouse.
+		if ui.capture != nil {
+			at = ui.capture
+		}
 		ui.dispatch(at, EvtMouse, event)
 
 	case *tcell.EventPaste:
 		ui.dispatch(ui.focus, EvtPaste, event)
 
 	case *tcell.EventResize:
-		sw, sh := ui.screen.Size()
+		sw, sh := ui.screenSize()
 		_, _, width, height := ui.Bounds()
 		if width != sw || height != sh {
 			ui.SetBounds(0, 0, sw, sh)
 			ui.Layout()
 			ui.Log(ui, Debug, "Screen size: %d:%d", width, height)
 			ui.Refresh()
-			ui.screen.Sync()
+			if ui.screen != nil {
+				ui.screen.Sync()
+			}
 		}
 	}
 
@@ -296,6 +333,115 @@ func (ui *UI) dispatch(target Widget, event Event, data ...any) bool {
 	return handled
 }
 
+// ---- Key Bindings ---------------------------------------------------------
+
+// Keymap returns the global keymap. It starts out as DefaultKeymap and can
+// be modified in place to add or remove bindings.
+func (ui *UI) Keymap() *Keymap {
+	return ui.keymap
+}
+
+// SetKeymap replaces the global keymap. Passing nil installs an empty
+// keymap, which disables all global key bindings.
+func (ui *UI) SetKeymap(keymap *Keymap) {
+	if keymap == nil {
+		keymap = NewKeymap()
+	}
+	ui.keymap = keymap
+	ui.pending = nil
+}
+
+// SetClipboard posts data to the system clipboard through the hosting
+// terminal (OSC 52). Terminals may ignore the request; a headless UI keeps
+// the data on its MemoryScreen.
+func (ui *UI) SetClipboard(data []byte) {
+	if ui.memory != nil {
+		ui.memory.SetClipboard(data)
+	} else if ui.screen != nil {
+		ui.screen.SetClipboard(data)
+	}
+}
+
+// SetLayerKeymap installs a keymap that is consulted before the global
+// keymap while layer is the topmost layer. Bindings to ActionNone hide
+// global bindings for the same keys. The keymap is dropped automatically
+// when the layer is closed; passing nil removes it earlier.
+func (ui *UI) SetLayerKeymap(layer Container, keymap *Keymap) {
+	if keymap == nil {
+		delete(ui.scoped, layer)
+	} else {
+		ui.scoped[layer] = keymap
+	}
+	ui.pending = nil
+}
+
+// SetAction registers the function that runs when a key bound to the named
+// action is pressed, replacing any previous function. Passing nil removes
+// the action. Built-in actions (see the Action... constants) can be
+// overridden the same way.
+func (ui *UI) SetAction(name string, fn func()) {
+	if fn == nil {
+		delete(ui.actions, name)
+	} else {
+		ui.actions[name] = fn
+	}
+}
+
+// Perform runs the named action and reports whether it exists.
+func (ui *UI) Perform(name string) bool {
+	fn, ok := ui.actions[name]
+	if ok {
+		fn()
+	}
+	return ok
+}
+
+// handleBinding feeds a key event that no widget consumed into the key
+// binding machinery. Chords accumulate in ui.pending while they form the
+// prefix of a bound multi-key sequence; a complete sequence runs its
+// action. The layer keymap is consulted before the global one, and the
+// first keymap that completes or extends the sequence decides, so a
+// prefix in the layer keymap hides a global binding of the same keys. A
+// chord that neither completes nor extends the pending sequence discards
+// it and is retried on its own.
+func (ui *UI) handleBinding(event *tcell.EventKey) bool {
+	chord := KeyName(event)
+	if chord == "" {
+		ui.pending = nil
+		return false
+	}
+
+	keymaps := []*Keymap{ui.keymap}
+	if len(ui.layers) > 0 {
+		if scoped, ok := ui.scoped[ui.layers[len(ui.layers)-1]]; ok {
+			keymaps = []*Keymap{scoped, ui.keymap}
+		}
+	}
+
+	for {
+		seq := strings.Join(append(ui.pending, chord), " ")
+		for _, keymap := range keymaps {
+			action, exact, more := keymap.match(seq)
+			if exact {
+				ui.pending = nil
+				ui.Log(ui, Debug, "Key binding", "keys", seq, "action", action)
+				if !ui.Perform(action) {
+					ui.Log(ui, Warning, "Unknown action", "keys", seq, "action", action)
+				}
+				return true
+			}
+			if more {
+				ui.pending = append(ui.pending, chord)
+				return true
+			}
+		}
+		if len(ui.pending) == 0 {
+			return false
+		}
+		ui.pending = nil
+	}
+}
+
 // ---- Container Methods ----------------------------------------------------
 
 // Add adds a new container layer to the UI.
@@ -395,6 +541,9 @@ func (ui *UI) Layout() error {
 			errs = append(errs, err)
 		}
 	}
+
+	// Notifications stay in the bottom-right corner of the screen
+	ui.stack()
 	return errors.Join(errs...)
 }
 
@@ -412,6 +561,7 @@ func (ui *UI) Draw() {
 	for i := range len(ui.layers) {
 		ui.layers[i].Render(ui.renderer)
 	}
+	ui.drawNotices()
 
 	ui.ShowCursor()
 	ui.ShowDebug()
@@ -447,6 +597,7 @@ func (ui *UI) DrawWidget(widget Widget) {
 
 	ui.redraws++
 	widget.Render(ui.renderer)
+	ui.drawNotices()
 	ui.ShowCursor()
 	ui.ShowDebug()
 	ui.renderer.Flush()
@@ -464,10 +615,10 @@ func (ui *UI) Redraw(widget Widget) {
 }
 
 // Refresh triggers a complete screen redraw for all visible
-// This method sets the dirty flag and signals the main event loop to perform
-// a full rendering pass on the next iteration.
+// This method signals the main event loop to perform a full rendering pass
+// on the next iteration, which sets the dirty flag. It is safe to call from
+// any goroutine.
 func (ui *UI) Refresh() {
-	ui.dirty = true
 	select {
 	case ui.refresh <- struct{}{}:
 	default: // Channel is full, redraw already pending
@@ -520,6 +671,21 @@ func (ui *UI) Focus(widget Widget) {
 	ui.Refresh()
 }
 
+// Capture routes all mouse events to widget, wherever the mouse is, until
+// Release is called. Widgets use it to follow a drag beyond their own
+// bounds. Hover tracking is not affected.
+func (ui *UI) Capture(widget Widget) {
+	ui.capture = widget
+}
+
+// Release ends a mouse capture started with Capture. It is a no-op when
+// widget does not hold the capture.
+func (ui *UI) Release(widget Widget) {
+	if ui.capture == widget {
+		ui.capture = nil
+	}
+}
+
 // SetFocus navigates focus between using directional or positional commands.
 // This method implements keyboard navigation patterns commonly used in terminal
 // applications, providing consistent focus traversal behavior.
@@ -593,6 +759,14 @@ func (ui *UI) ShowCursor() {
 	if ui.focus != nil {
 		x, y, _, _ := ui.focus.Content()
 		cx, cy, cursor := ui.focus.Cursor()
+		if ui.memory != nil {
+			if cursor != "" && cx >= 0 && cy >= 0 {
+				ui.memory.ShowCursor(x+cx, y+cy, cursor)
+			} else {
+				ui.memory.HideCursor()
+			}
+			return
+		}
 		if cursor != "" && cx >= 0 && cy >= 0 {
 			cs := tcell.CursorStyleDefault
 			switch cursor {
@@ -730,6 +904,8 @@ func (ui *UI) Close() {
 	if len(ui.layers) > 1 {
 		top := ui.layers[len(ui.layers)-1]
 		ui.layers = ui.layers[:len(ui.layers)-1]
+		delete(ui.scoped, top)
+		ui.pending = nil
 		top.Dispatch(top, EvtClose)
 		var prev Widget
 		if len(ui.focusStack) > 0 {
@@ -851,6 +1027,59 @@ func (ui *UI) Commands() *Commands {
 	return ui.commands
 }
 
+// ---- Update Queue ---------------------------------------------------------
+
+// Post queues fn to run on the event loop, the goroutine that handles
+// events and renders. Widgets are not safe for concurrent use, so code
+// running on other goroutines (tickers, network pollers, process readers)
+// must change widgets through Post:
+//
+//	go func() {
+//		for status := range updates {
+//			ui.Post(func() { label.Set(status) })
+//		}
+//	}()
+//
+// Post is safe to call from any goroutine, including the event loop
+// itself, and never blocks. Closures run in the order they were posted.
+// Closures posted before Run starts run once the loop is up; a headless UI
+// runs them on the next injection or Settle. Widget setters usually
+// request their own redraw; use QueueUpdate for changes that do not.
+func (ui *UI) Post(fn func()) {
+	if fn == nil {
+		return
+	}
+	ui.postMu.Lock()
+	ui.posted = append(ui.posted, fn)
+	ui.postMu.Unlock()
+	select {
+	case ui.wake <- struct{}{}:
+	default: // Loop already signalled
+	}
+}
+
+// QueueUpdate queues fn like Post and refreshes the whole screen after it
+// ran. Use it for changes to widget state that do not redraw by themselves,
+// such as fields set directly.
+func (ui *UI) QueueUpdate(fn func()) {
+	ui.Post(func() {
+		fn()
+		ui.Refresh()
+	})
+}
+
+// runPosted runs all closures queued by Post. Closures posted while they
+// run are picked up on the next wake-up.
+func (ui *UI) runPosted() {
+	ui.postMu.Lock()
+	posted := ui.posted
+	ui.posted = nil
+	ui.postMu.Unlock()
+	for _, fn := range posted {
+		fn()
+	}
+}
+
 // Quit signals the application to exit cleanly. Safe to call multiple times.
 // This is the programmatic equivalent of pressing Ctrl+C or Ctrl+Q.
 func (ui *UI) Quit() {
@@ -888,8 +1117,10 @@ func (ui *UI) Run() error {
 		}
 	}()
 
-	// Enable mouse events
+	// Enable mouse events and bracketed paste, so that widgets can tell
+	// pasted text from typed keys (EvtPaste)
 	ui.screen.EnableMouse()
+	ui.screen.EnablePaste()
 
 	style := tcell.StyleDefault
 	ui.screen.SetStyle(style)
@@ -900,7 +1131,17 @@ func (ui *UI) Run() error {
 	// Take screen size for the root
 	width, height := ui.screen.Size()
 	ui.SetBounds(0, 0, width, height)
-	ui.Layout()
+
+	// Detect the colours of the terminal once and style the widgets for them
+	if ui.detect {
+		ui.profile = DetectColorProfile(ui.screen.Colors())
+		ui.Log(ui, Debug, "Color profile detected", "profile", ui.profile, "colors", ui.screen.Colors())
+	}
+	if ui.theme.Profile() != ui.profile {
+		ui.SetTheme(ui.theme)
+	} else {
+		ui.Layout()
+	}
 
 	// Set initial focus
 	ui.SetFocus("first")
@@ -915,13 +1156,53 @@ func (ui *UI) Run() error {
 		case widget := <-ui.redraw:
 			ui.DrawWidget(widget)
 		case <-ui.refresh:
+			ui.dirty = true
 			ui.Draw()
+		case <-ui.wake:
+			ui.runPosted()
 		case event := <-ui.events:
 			ui.Handle(event)
 		}
 	}
 }
 
+// screenSize returns the size of the active screen, which is the in-memory
+// screen when running headless and the terminal otherwise.
+func (ui *UI) screenSize() (int, int) {
+	if ui.memory != nil {
+		return ui.memory.Size()
+	}
+	return ui.screen.Size()
+}
+
+// drain processes everything that is currently queued on the event,
+// redraw and refresh channels and the Post queue without blocking and
+// returns once all of them are empty or the UI has been asked to quit. It
+// is the synchronous counterpart of the Run loop and drives the headless
+// mode.
+func (ui *UI) drain() {
+	if ui.dirty {
+		ui.Draw()
+	}
+	for {
+		select {
+		case <-ui.quit:
+			return
+		case widget := <-ui.redraw:
+			ui.DrawWidget(widget)
+		case <-ui.refresh:
+			ui.dirty = true
+			ui.Draw()
+		case <-ui.wake:
+			ui.runPosted()
+		case event := <-ui.events:
+			ui.Handle(event)
+		default:
+			return
+		}
+	}
+}
+
 // EventLoop continuously polls for tcell events and forwards them to the main event loop.
 // This method runs in a separate goroutine to prevent blocking the main event loop
 // during event polling operations.
@@ -936,21 +1217,51 @@ func (ui *UI) EventLoop() {
 
 // ---- Theme Management -----------------------------------------------------
 
+// ColorProfile returns the colours the UI renders for, see SetColorProfile.
+func (ui *UI) ColorProfile() ColorProfile {
+	return ui.profile
+}
+
+// SetColorProfile sets the colours the UI renders for instead of detecting
+// them when Run starts. Use it to honour a command line option or to test a
+// theme with fewer colours:
+//
+//	ui.SetColorProfile(ProfileMonochrome)
+//
+// Theme colours are mapped to the profile by [Theme.Resolve]; with
+// ProfileMonochrome, widget states are marked by font attributes.
+func (ui *UI) SetColorProfile(profile ColorProfile) {
+	ui.profile = profile
+	ui.detect = false
+	ui.SetTheme(ui.theme)
+}
+
 // SetTheme changes the active theme and re-applies styling to all
 // This method enables runtime theme switching by updating the renderer's theme
 // and triggering a complete re-styling of the entire widget hierarchy.
+// The layout is recalculated, as margins, padding and borders may differ
+// between themes. The theme is set to the UI's colour profile.
 //
 // Parameters:
 //   - theme: The new theme to apply to the application
 func (ui *UI) SetTheme(theme *Theme) {
-	ui.renderer.Theme = theme
+	ui.theme = theme
+	theme.SetProfile(ui.profile)
+	if ui.renderer != nil {
+		ui.renderer.Theme = theme
+	}
 
 	// Re-apply theme styles to all widgets
 	Traverse(ui, func(widget Widget) bool {
 		widget.Apply(theme)
 		return true
 	})
+	for _, active := range ui.notices {
+		active.grow.Apply(theme)
+		active.notification.Apply(theme)
+	}
 
+	ui.Layout()
 	ui.Refresh()
 }
 
@@ -12,11 +12,38 @@ type Value[T any] struct {
 	mu          sync.RWMutex
 	value       T
 	subscribers []func(T)
+	post        func(func()) // delivers notifications; nil calls subscribers directly
+}
+
+// Option configures a Value created with NewValue.
+type Option func(*options)
+
+// options collects the settings of Option functions.
+type options struct {
+	post func(func())
+}
+
+// WithPost makes a Value deliver its notifications through post instead of
+// calling subscribers on the goroutine that calls Set. Pass UI.Post to set
+// values from background goroutines while bound widgets are only updated on
+// the event loop:
+//
+//	status := values.NewValue("", values.WithPost(ui.Post))
+//	status.Bind(label)
+//	go func() { status.Set(poll()) }()
+func WithPost(post func(func())) Option {
+	return func(o *options) {
+		o.post = post
+	}
 }
 
 // NewValue creates a reactive value with an initial value.
-func NewValue[T any](initial T) *Value[T] {
-	return &Value[T]{value: initial}
+func NewValue[T any](initial T, opts ...Option) *Value[T] {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return &Value[T]{value: initial, post: o.post}
 }
 
 // Get returns the current value.
@@ -26,7 +53,8 @@ func (v *Value[T]) Get() T {
 	return v.value
 }
 
-// Set sets the new value and notifies all subscribers.
+// Set sets the new value and notifies all subscribers. Subscribers are
+// called on the caller's goroutine unless the value was created WithPost.
 func (v *Value[T]) Set(val T) {
 	v.mu.Lock()
 	v.value = val
@@ -34,8 +62,19 @@ func (v *Value[T]) Set(val T) {
 	copy(subs, v.subscribers)
 	v.mu.Unlock()
 
-	for _, fn := range subs {
-		fn(val)
+	v.notify(func() {
+		for _, fn := range subs {
+			fn(val)
+		}
+	})
+}
+
+// notify runs fn through the post function of the value, if any.
+func (v *Value[T]) notify(fn func()) {
+	if v.post != nil {
+		v.post(fn)
+	} else {
+		fn()
 	}
 }
 
@@ -45,7 +84,7 @@ func (v *Value[T]) Bind(s Setter[T]) *Value[T] {
 	v.subscribers = append(v.subscribers, s.Set)
 	current := v.value
 	v.mu.Unlock()
-	s.Set(current)
+	v.notify(func() { s.Set(current) })
 	return v
 }
 
@@ -75,7 +114,7 @@ func (v *Value[T]) Subscribe(fn func(T)) *Value[T] {
 	v.subscribers = append(v.subscribers, fn)
 	current := v.value
 	v.mu.Unlock()
-	fn(current)
+	v.notify(func() { fn(current) })
 	return v
 }
 
@@ -298,3 +298,37 @@ func TestValueDerivedFromInput(t *testing.T) {
 		t.Errorf("got %d, want 42", got)
 	}
 }
+
+// TestValueWithPost verifies that notifications are delivered through the
+// post function instead of on the caller's goroutine.
+func TestValueWithPost(t *testing.T) {
+	var queue []func()
+	post := func(fn func()) { queue = append(queue, fn) }
+	run := func() {
+		for len(queue) > 0 {
+			fn := queue[0]
+			queue = queue[1:]
+			fn()
+		}
+	}
+
+	v := NewValue(1, WithPost(post))
+	var got []int
+	v.Subscribe(func(n int) { got = append(got, n) })
+	s := &captureSetter[int]{}
+	v.Bind(s)
+	v.Set(2)
+	if len(got) != 0 || len(s.values) != 0 {
+		t.Fatalf("notified before the queue ran: %v %v", got, s.values)
+	}
+	run()
+	if len(got) != 2 || got[0] != 1 || got[1] != 2 {
+		t.Errorf("subscriber got %v; want [1 2]", got)
+	}
+	if last, _ := s.last(); last != 2 {
+		t.Errorf("setter got %d; want 2", last)
+	}
+	if v.Get() != 2 {
+		t.Errorf("Get() = %d; want 2 right after Set", v.Get())
+	}
+}
@@ -2,6 +2,7 @@ package widgets
 
 import (
 	"sync"
+	"sync/atomic"
 	"time"
 
 	. "github.com/tekugo/zeichenwerk/core"
@@ -11,12 +12,19 @@ import (
 // It manages the ticker and stop channel, and calls the tick callback
 // on each animation frame. Components that embed Animation should
 // implement the Tick() method to define their specific animation behavior.
+//
+// Once the animation is part of a UI, frames are posted to the event loop
+// with [Root.Post], so the tick callback never runs concurrently with
+// event handling or rendering. A frame is skipped while the previous one
+// is still queued, so a busy loop slows the animation down instead of
+// piling up frames.
 type Animation struct {
 	Component
-	mu     sync.Mutex
-	ticker *time.Ticker
-	fn     func()
-	stop   chan struct{}
+	mu      sync.Mutex
+	ticker  *time.Ticker
+	fn      func()
+	stop    chan struct{}
+	pending atomic.Bool // a frame is posted and has not run yet
 }
 
 // ---- Widget Methods -------------------------------------------------------
@@ -46,14 +54,15 @@ func (a *Animation) Start(interval time.Duration) {
 		a.Log(a, Error, "Animation already running")
 		return
 	}
-	a.ticker = time.NewTicker(interval)
+	ticker := time.NewTicker(interval)
+	a.ticker = ticker
 	a.mu.Unlock()
 
 	go func() {
 		defer func() {
+			ticker.Stop()
 			a.mu.Lock()
-			if a.ticker != nil {
-				a.ticker.Stop()
+			if a.ticker == ticker {
 				a.ticker = nil
 			}
 			a.mu.Unlock()
@@ -63,13 +72,30 @@ func (a *Animation) Start(interval time.Duration) {
 			select {
 			case <-a.stop:
 				return
-			case <-a.ticker.C:
-				a.Tick()
+			case <-ticker.C:
+				a.frame()
 			}
 		}
 	}()
 }
 
+// frame runs one animation frame: on the event loop if the animation is
+// part of a UI, directly otherwise.
+func (a *Animation) frame() {
+	root := FindRoot(a)
+	if root == nil {
+		a.Tick()
+		return
+	}
+	if !a.pending.CompareAndSwap(false, true) {
+		return
+	}
+	root.Post(func() {
+		a.pending.Store(false)
+		a.Tick()
+	})
+}
+
 // Stop gracefully halts the animation.
 func (a *Animation) Stop() {
 	select {
@@ -3,6 +3,7 @@ package widgets
 import (
 	"fmt"
 	"math"
+	"strconv"
 	"unicode/utf8"
 
 	"github.com/gdamore/tcell/v3"
@@ -688,11 +689,26 @@ func niceCeil(v float64) float64 {
 
 // yAxisLayout computes tick values, their string labels, and the y-axis width.
 func (c *BarChart) yAxisLayout() (tickVals []float64, tickLabels []string, yAxisW int) {
-	eMax := c.effectiveMax()
-	step := niceCeil(eMax / float64(c.ticks))
-	for v := 0.0; v <= eMax+step*0.5; v += step {
+	return axisTicks(0, c.effectiveMax(), c.ticks)
+}
+
+// axisTicks computes the y-axis ticks for the range [lo, hi] split into
+// about ticks steps. The step is rounded with niceCeil and the ticks sit
+// on its multiples, from the first one at or above lo up to the first
+// one past hi (within half a step). yAxisW is the width of the widest
+// label plus two columns for the space and the │ rule. Shared by
+// BarChart and LineChart so both draw the same axis for the same range.
+func axisTicks(lo, hi float64, ticks int) (tickVals []float64, tickLabels []string, yAxisW int) {
+	step := niceCeil((hi - lo) / float64(ticks))
+	// Multiply instead of accumulating so fractional steps don't drift.
+	first := math.Ceil(lo/step - 1e-9)
+	for i := 0.0; ; i++ {
+		v := (first + i) * step
+		if v > hi+step*0.5 {
+			break
+		}
 		tickVals = append(tickVals, v)
-		tickLabels = append(tickLabels, fmt.Sprintf("%g", v))
+		tickLabels = append(tickLabels, tickLabel(v, step))
 	}
 	maxLW := 0
 	for _, l := range tickLabels {
@@ -704,6 +720,16 @@ func (c *BarChart) yAxisLayout() (tickVals []float64, tickLabels []string, yAxis
 	return
 }
 
+// tickLabel formats an axis value. Whole steps use %g; fractional steps
+// print as many decimals as the step needs, so 0.1 + 0.2 reads "0.3".
+func tickLabel(v, step float64) string {
+	if step >= 1 {
+		return fmt.Sprintf("%g", v)
+	}
+	decimals := int(math.Ceil(-math.Log10(step) - 1e-9))
+	return strconv.FormatFloat(v, 'f', decimals, 64)
+}
+
 func (c *BarChart) hasLegendLabels() bool {
 	for _, s := range c.series {
 		if s.Label != "" {
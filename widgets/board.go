@@ -0,0 +1,913 @@
+package widgets
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/gdamore/tcell/v3"
+	. "github.com/tekugo/zeichenwerk/core"
+)
+
+// BoardCard is a single card on a [Board]. The application owns the cards
+// and may change their fields at any time; call Redraw on the board
+// afterwards.
+type BoardCard struct {
+	ID       string   // unique within the board; generated if empty
+	Title    string   // first row of the card
+	Body     string   // detail text, word-wrapped; may contain \n
+	Label    string   // colour of the label strip, e.g. "$red"; empty = none
+	Tags     []string // short labels shown as [tag] chips on the bottom row
+	Metadata any      // opaque application data; not rendered
+}
+
+// BoardColumn is a named, ordered list of cards on a [Board]. Columns are
+// created with Board.AddColumn; the cards are changed through the column
+// methods so that the board can keep its cursor consistent and repaint.
+type BoardColumn struct {
+	ID     string // unique identifier
+	Title  string // header label
+	board  *Board
+	cards  []*BoardCard // ordered, index 0 at the top
+	limit  int          // WIP limit, 0 = unlimited
+	width  int          // column width, -1 = the board's column width
+	offset int          // index of the first visible card
+}
+
+// SetTitle changes the header label.
+func (c *BoardColumn) SetTitle(s string) {
+	c.Title = s
+	c.redraw()
+}
+
+// SetLimit sets the work-in-progress limit; 0 removes it. A column with
+// more cards than its limit shows the "board/header:over" style.
+func (c *BoardColumn) SetLimit(n int) {
+	c.limit = max(0, n)
+	c.redraw()
+}
+
+// SetWidth sets the width of the column in cells (minimum 10); -1 uses
+// the board's column width.
+func (c *BoardColumn) SetWidth(n int) {
+	if n >= 0 {
+		n = max(10, n)
+	}
+	c.width = n
+	if c.board != nil {
+		c.board.Refresh()
+	}
+}
+
+// AddCard appends a card to the bottom of the column and returns it.
+func (c *BoardColumn) AddCard(card *BoardCard) *BoardCard {
+	return c.InsertCard(len(c.cards), card)
+}
+
+// InsertCard inserts a card at index, clamped to the column, and returns
+// it. A card without an ID gets a generated one.
+func (c *BoardColumn) InsertCard(index int, card *BoardCard) *BoardCard {
+	index = max(0, min(index, len(c.cards)))
+	if card.ID == "" && c.board != nil {
+		c.board.serial++
+		card.ID = "card-" + strconv.Itoa(c.board.serial)
+	}
+	c.cards = slices.Insert(c.cards, index, card)
+	if b := c.board; b != nil && b.FocusedColumn() == c {
+		if b.cardIdx < 0 || index <= b.cardIdx {
+			b.cardIdx++
+		}
+	}
+	c.redraw()
+	return card
+}
+
+// RemoveCard removes the card with the given ID and returns it, or nil if
+// the column has no such card.
+func (c *BoardColumn) RemoveCard(id string) *BoardCard {
+	i := c.index(id)
+	if i < 0 {
+		return nil
+	}
+	card := c.cards[i]
+	c.cards = slices.Delete(c.cards, i, i+1)
+	if b := c.board; b != nil && b.FocusedColumn() == c {
+		if i < b.cardIdx || b.cardIdx >= len(c.cards) {
+			b.cardIdx--
+		}
+	}
+	c.offset = max(0, min(c.offset, len(c.cards)-1))
+	c.redraw()
+	return card
+}
+
+// MoveCard moves the card with the given ID to toIndex within the column.
+// It reports whether the card was found. Unlike moves made by the user,
+// it dispatches no events.
+func (c *BoardColumn) MoveCard(id string, toIndex int) bool {
+	i := c.index(id)
+	if i < 0 {
+		return false
+	}
+	card := c.cards[i]
+	c.cards = slices.Delete(c.cards, i, i+1)
+	toIndex = max(0, min(toIndex, len(c.cards)))
+	c.cards = slices.Insert(c.cards, toIndex, card)
+	if b := c.board; b != nil && b.FocusedColumn() == c && b.cardIdx == i {
+		b.cardIdx = toIndex
+	}
+	c.redraw()
+	return true
+}
+
+// Card returns the card with the given ID, or nil.
+func (c *BoardColumn) Card(id string) *BoardCard {
+	if i := c.index(id); i >= 0 {
+		return c.cards[i]
+	}
+	return nil
+}
+
+// Cards returns a copy of the cards in the column, top first.
+func (c *BoardColumn) Cards() []*BoardCard {
+	return slices.Clone(c.cards)
+}
+
+// Len returns the number of cards in the column.
+func (c *BoardColumn) Len() int {
+	return len(c.cards)
+}
+
+// Over reports whether the column holds more cards than its WIP limit.
+func (c *BoardColumn) Over() bool {
+	return c.limit > 0 && len(c.cards) > c.limit
+}
+
+// index returns the position of the card with the given ID, or -1.
+func (c *BoardColumn) index(id string) int {
+	return slices.IndexFunc(c.cards, func(card *BoardCard) bool { return card.ID == id })
+}
+
+// redraw repaints the board the column belongs to.
+func (c *BoardColumn) redraw() {
+	if c.board != nil {
+		Redraw(c.board)
+	}
+}
+
+// BoardChange describes a card move on a [Board]. It is the data of the
+// EvtMove event dispatched before the move and of the EvtChange event
+// dispatched after it. ToIndex is the index the card has in ToColumn once
+// it is moved.
+type BoardChange struct {
+	Card       *BoardCard
+	FromColumn *BoardColumn
+	FromIndex  int
+	ToColumn   *BoardColumn
+	ToIndex    int
+}
+
+// Board is a Kanban-style board of named columns holding cards. The
+// cursor moves across columns with ←/→ and through the cards of a column
+// with ↑/↓; each column scrolls on its own. Ctrl with an arrow key moves
+// the card under the cursor up or down within its column or to the bottom
+// of the neighbouring column, and cards can be dragged to any position
+// with the mouse. A click on the card under the cursor activates it.
+//
+// The application owns the data: it adds columns and cards through the
+// Board and BoardColumn methods and keeps the *BoardCard values, which the
+// board only displays. Every move the user makes is announced with EvtMove
+// first; a handler returning true vetoes it, e.g. to enforce a WIP limit
+// or a workflow, and the card stays where it is.
+//
+// Events:
+//   - [EvtSelect]   – int, int: column and card index of the cursor
+//   - [EvtActivate] – *BoardCard: Enter pressed or the card under the
+//     cursor clicked
+//   - [EvtMove]     – BoardChange: a card is about to be moved; return true
+//     to veto
+//   - [EvtChange]   – BoardChange: a card was moved
+type Board struct {
+	Component
+	columns    []*BoardColumn
+	colIdx     int // column under the cursor
+	cardIdx    int // card under the cursor, -1 if the column is empty
+	first      int // first visible column
+	colWidth   int // default column width
+	cardHeight int // rows per card including the gap row
+	serial     int // counter for generated card IDs
+
+	// Mouse and drag state
+	pressed    bool         // button 1 went down on a card
+	activate   bool         // the press was on the card under the cursor
+	dragging   bool         // the mouse moved while pressed
+	dragCard   *BoardCard   // card pressed on
+	dragFrom   *BoardColumn // column of the dragged card
+	dragX      int          // mouse position of the press
+	dragY      int
+	targetCol  *BoardColumn // column the card would be dropped into
+	targetCard int          // index the card would get there
+
+	// Characters read from theme strings in Apply; defaults set in constructor.
+	chSeparator string
+	chRule      string
+	chCross     string
+	chLabel     string
+	chDrop      string
+	chWipOK     string
+	chWipOver   string
+}
+
+// NewBoard creates a new focusable board without columns, with 22-cell
+// columns and 4-row card slots.
+func NewBoard(id, class string) *Board {
+	b := &Board{
+		Component:  Component{id: id, class: class},
+		cardIdx:    -1,
+		colWidth:   22,
+		cardHeight: 4,
+
+		chSeparator: "│",
+		chRule:      "─",
+		chCross:     "┼",
+		chLabel:     "▌",
+		chDrop:      "░",
+		chWipOK:     "",
+		chWipOver:   "!",
+	}
+	b.SetFlag(FlagFocusable, true)
+	OnKey(b, b.handleKey)
+	OnMouse(b, b.handleMouse)
+	return b
+}
+
+// Apply registers the board styles and reads the theme strings.
+func (b *Board) Apply(theme *Theme) {
+	theme.Apply(b, b.Selector("board"), "focused", "disabled")
+	theme.Apply(b, b.Selector("board/column"), "focused")
+	theme.Apply(b, b.Selector("board/header"), "focused", "over")
+	theme.Apply(b, b.Selector("board/card"), "selected", "focused")
+	theme.Apply(b, b.Selector("board/title"), "selected", "focused")
+	theme.Apply(b, b.Selector("board/body"))
+	theme.Apply(b, b.Selector("board/tag"))
+	theme.Apply(b, b.Selector("board/drag"))
+	theme.Apply(b, b.Selector("board/drop"))
+	str := func(key, def string) string {
+		if v := theme.String(key); v != "" {
+			return v
+		}
+		return def
+	}
+	b.chSeparator = str("board.separator", "│")
+	b.chRule = str("board.rule", "─")
+	b.chCross = str("board.cross", "┼")
+	b.chLabel = str("board.label", "▌")
+	b.chDrop = str("board.drop", "░")
+	b.chWipOK = theme.String("board.wip-ok")
+	b.chWipOver = str("board.wip-over", "!")
+}
+
+// ---- Columns ---------------------------------------------------------------
+
+// AddColumn appends a new, empty column and returns it.
+func (b *Board) AddColumn(id, title string) *BoardColumn {
+	col := &BoardColumn{ID: id, Title: title, board: b, width: -1}
+	b.columns = append(b.columns, col)
+	b.Refresh()
+	return col
+}
+
+// RemoveColumn removes the column with the given ID and reports whether
+// it was found. The cursor stays on the same column if that one remains.
+func (b *Board) RemoveColumn(id string) bool {
+	i := b.column(id)
+	if i < 0 {
+		return false
+	}
+	b.columns[i].board = nil
+	b.columns = slices.Delete(b.columns, i, i+1)
+	if i < b.colIdx || b.colIdx >= len(b.columns) {
+		b.colIdx = max(0, b.colIdx-1)
+	}
+	b.first = max(0, min(b.first, len(b.columns)-1))
+	b.clamp()
+	b.Refresh()
+	return true
+}
+
+// MoveColumn moves the column with the given ID to toIndex and reports
+// whether it was found. The cursor follows the column it is on.
+func (b *Board) MoveColumn(id string, toIndex int) bool {
+	i := b.column(id)
+	if i < 0 {
+		return false
+	}
+	focused := b.FocusedColumn()
+	col := b.columns[i]
+	b.columns = slices.Delete(b.columns, i, i+1)
+	toIndex = max(0, min(toIndex, len(b.columns)))
+	b.columns = slices.Insert(b.columns, toIndex, col)
+	b.colIdx = slices.Index(b.columns, focused)
+	Redraw(b)
+	return true
+}
+
+// GetColumn returns the column with the given ID, or nil.
+func (b *Board) GetColumn(id string) *BoardColumn {
+	if i := b.column(id); i >= 0 {
+		return b.columns[i]
+	}
+	return nil
+}
+
+// Columns returns a copy of the column list, left to right.
+func (b *Board) Columns() []*BoardColumn {
+	return slices.Clone(b.columns)
+}
+
+// ---- Display Options -------------------------------------------------------
+
+// SetCardHeight sets the rows of a card slot including the blank row
+// below each card (minimum 3). The bottom row of a card shows its tags
+// when the slot has at least 4 rows.
+func (b *Board) SetCardHeight(rows int) {
+	b.cardHeight = max(3, rows)
+	for i := range b.columns {
+		b.adjust(i)
+	}
+	Redraw(b)
+}
+
+// SetColumnWidth sets the width of columns without their own width
+// (minimum 10).
+func (b *Board) SetColumnWidth(cols int) {
+	b.colWidth = max(10, cols)
+	b.Refresh()
+}
+
+// Hint returns the preferred size. The natural width fits all columns
+// with their separators; the height is left to the parent.
+func (b *Board) Hint() (int, int) {
+	if b.hwidth != 0 || b.hheight != 0 {
+		return b.hwidth, b.hheight
+	}
+	w := 0
+	for i := range b.columns {
+		w += b.width(i) + 1
+	}
+	return max(0, w-1) + b.Style().Horizontal(), 0
+}
+
+// ---- Navigation ------------------------------------------------------------
+
+// Select moves the cursor to the card at cardIdx in the column at colIdx,
+// both clamped to valid ranges, and scrolls it into view. EvtSelect is
+// dispatched when the position changes.
+func (b *Board) Select(colIdx, cardIdx int) {
+	if len(b.columns) == 0 {
+		b.colIdx, b.cardIdx = 0, -1
+		return
+	}
+	colIdx = max(0, min(colIdx, len(b.columns)-1))
+	cardIdx = max(0, min(cardIdx, len(b.columns[colIdx].cards)-1))
+	if len(b.columns[colIdx].cards) == 0 {
+		cardIdx = -1
+	}
+	changed := colIdx != b.colIdx || cardIdx != b.cardIdx
+	b.colIdx, b.cardIdx = colIdx, cardIdx
+	b.adjust(colIdx)
+	b.reveal()
+	Redraw(b)
+	if changed {
+		b.Dispatch(b, EvtSelect, colIdx, cardIdx)
+	}
+}
+
+// SelectedColumn returns the index of the column under the cursor, or -1
+// if the board has no columns.
+func (b *Board) SelectedColumn() int {
+	if len(b.columns) == 0 {
+		return -1
+	}
+	return b.colIdx
+}
+
+// SelectedCard returns the index of the card under the cursor within its
+// column, or -1 if the column is empty.
+func (b *Board) SelectedCard() int {
+	if len(b.columns) == 0 {
+		return -1
+	}
+	return b.cardIdx
+}
+
+// FocusedColumn returns the column under the cursor, or nil.
+func (b *Board) FocusedColumn() *BoardColumn {
+	if b.colIdx < 0 || b.colIdx >= len(b.columns) {
+		return nil
+	}
+	return b.columns[b.colIdx]
+}
+
+// FocusedCard returns the card under the cursor, or nil.
+func (b *Board) FocusedCard() *BoardCard {
+	col := b.FocusedColumn()
+	if col == nil || b.cardIdx < 0 || b.cardIdx >= len(col.cards) {
+		return nil
+	}
+	return col.cards[b.cardIdx]
+}
+
+// ---- Rendering -------------------------------------------------------------
+
+// Render draws the column headers, the rule below them, the column
+// separators and the visible cards of every column, with a scrollbar in
+// columns whose cards do not fit.
+func (b *Board) Render(r *Renderer) {
+	if b.Flag(FlagHidden) {
+		return
+	}
+	b.Component.Render(r)
+	cx, cy, cw, ch := b.Content()
+	if cw < 1 || ch < 3 {
+		return
+	}
+	focused := b.Flag(FlagFocused)
+
+	base := b.Style()
+	r.Set(base.Foreground(), base.Background(), base.Font())
+	r.Repeat(cx, cy+1, 1, 0, cw, b.chRule)
+
+	top, height := cy+2, ch-2
+	for _, v := range b.visible() {
+		i, x, w := v.index, v.x, v.width
+		col := b.columns[i]
+		current := focused && i == b.colIdx
+
+		// Separator between the column and the next one
+		if sx := x + w; i < len(b.columns)-1 && sx < cx+cw {
+			r.Set(base.Foreground(), base.Background(), base.Font())
+			r.Put(sx, cy, b.chSeparator)
+			r.Put(sx, cy+1, b.chCross)
+			r.Repeat(sx, top, 0, 1, height, b.chSeparator)
+		}
+
+		// Header
+		style := b.Style("header")
+		if col.Over() {
+			style = b.Style("header:over")
+		} else if current {
+			style = b.Style("header:focused")
+		}
+		r.Set(style.Foreground(), style.Background(), style.Font())
+		r.Fill(x, cy, w, 1, " ")
+		title := b.header(col)
+		pad := max(0, (w-utf8.RuneCountInString(title))/2)
+		r.Text(x+pad, cy, title, w-pad)
+
+		// Column background
+		style = b.Style("column")
+		if current {
+			style = b.Style("column:focused")
+		}
+		r.Set(style.Foreground(), style.Background(), style.Font())
+		r.Fill(x, top, w, height, " ")
+
+		// Cards
+		total := len(col.cards)
+		cols := w
+		if total*b.cardHeight > height {
+			cols--
+		}
+		for s := 0; s*b.cardHeight < height; s++ {
+			index := col.offset + s
+			y := top + s*b.cardHeight
+			rows := min(b.cardHeight-1, top+height-y)
+			switch {
+			case b.dragging && index < total && col.cards[index] == b.dragCard:
+				b.renderCard(r, col.cards[index], x, y, cols, rows, "drag")
+			case b.dragging && col == b.targetCol && index == b.targetCard:
+				b.renderDrop(r, x, y, cols, rows)
+			case index >= total:
+			case i == b.colIdx && index == b.cardIdx && focused:
+				b.renderCard(r, col.cards[index], x, y, cols, rows, "focused")
+			case i == b.colIdx && index == b.cardIdx:
+				b.renderCard(r, col.cards[index], x, y, cols, rows, "selected")
+			default:
+				b.renderCard(r, col.cards[index], x, y, cols, rows, "")
+			}
+		}
+		if cols < w {
+			r.Set(base.Foreground(), base.Background(), base.Font())
+			r.ScrollbarV(x+cols, top, height, col.offset*b.cardHeight, total*b.cardHeight)
+		}
+	}
+}
+
+// renderCard draws a card in w×h cells at x, y: the label strip on the
+// left, the title in the first row, the wrapped body below and the tags in
+// the bottom row of a full-height card, all on the background of the card
+// style. The state selects the styles: "" for plain cards, "selected" or
+// "focused" for the card under the cursor and "drag" for the card being
+// dragged.
+func (b *Board) renderCard(r *Renderer, card *BoardCard, x, y, w, h int, state string) {
+	if w < 1 || h < 1 {
+		return
+	}
+	style, title, body, tag := b.Style("card"), b.Style("title"), b.Style("body"), b.Style("tag")
+	switch state {
+	case "selected", "focused":
+		style, title = b.Style("card:"+state), b.Style("title:"+state)
+	case "drag":
+		style = b.Style("drag")
+		title, body, tag = style, style, style
+	}
+	r.Set(style.Foreground(), style.Background(), style.Font())
+	r.Fill(x, y, w, h, " ")
+	if card.Label != "" && state != "drag" {
+		r.Set(card.Label, style.Background(), "")
+		r.Repeat(x, y, 0, 1, h, b.chLabel)
+	}
+
+	tx, tw := x+2, w-3
+	if tw < 1 {
+		return
+	}
+	bg := style.Background()
+	r.Set(title.Foreground(), bg, title.Font())
+	r.Text(tx, y, card.Title, tw)
+
+	rows := h - 1
+	if len(card.Tags) > 0 && b.cardHeight >= 4 && h == b.cardHeight-1 {
+		rows--
+		r.Set(tag.Foreground(), bg, tag.Font())
+		cx := tx
+		for _, t := range card.Tags {
+			chip := "[" + t + "]"
+			n := utf8.RuneCountInString(chip)
+			if cx+n > tx+tw {
+				break
+			}
+			r.Text(cx, y+h-1, chip, n)
+			cx += n + 1
+		}
+	}
+	r.Set(body.Foreground(), bg, body.Font())
+	for i, line := range wrapWords(card.Body, tw) {
+		if i >= rows {
+			break
+		}
+		r.Text(tx, y+1+i, line, tw)
+	}
+}
+
+// renderDrop fills the slot a dragged card would be dropped into.
+func (b *Board) renderDrop(r *Renderer, x, y, w, h int) {
+	style := b.Style("drop")
+	r.Set(style.Foreground(), style.Background(), style.Font())
+	r.Fill(x, y, w, h, b.chDrop)
+}
+
+// header returns the header text of a column: its title and the number of
+// cards, marked when a WIP limit is set.
+func (b *Board) header(col *BoardColumn) string {
+	count := strconv.Itoa(len(col.cards))
+	if col.Over() {
+		count += b.chWipOver
+	} else if col.limit > 0 {
+		count += b.chWipOK
+	}
+	return fmt.Sprintf("%s (%s)", col.Title, count)
+}
+
+// ---- Internal Helpers ------------------------------------------------------
+
+// column returns the index of the column with the given ID, or -1.
+func (b *Board) column(id string) int {
+	return slices.IndexFunc(b.columns, func(c *BoardColumn) bool { return c.ID == id })
+}
+
+// width returns the width of the column at index i.
+func (b *Board) width(i int) int {
+	if w := b.columns[i].width; w > 0 {
+		return w
+	}
+	return b.colWidth
+}
+
+// boardSpan is the position of a visible column: its index, x position and
+// width.
+type boardSpan struct {
+	index, x, width int
+}
+
+// visible returns the columns shown, starting at the first visible one.
+// The last column is cut at the right edge of the content area.
+func (b *Board) visible() []boardSpan {
+	cx, _, cw, _ := b.Content()
+	var spans []boardSpan
+	x := cx
+	for i := b.first; i < len(b.columns) && x < cx+cw; i++ {
+		w := min(b.width(i), cx+cw-x)
+		spans = append(spans, boardSpan{i, x, w})
+		x += w + 1
+	}
+	return spans
+}
+
+// slots returns the number of card slots that fit a column completely,
+// at least 1.
+func (b *Board) slots() int {
+	_, _, _, ch := b.Content()
+	return max(1, (ch-2)/b.cardHeight)
+}
+
+// adjust scrolls the column at index i so that the card under the cursor
+// is visible if it is in that column, and keeps the offset in range.
+func (b *Board) adjust(i int) {
+	col := b.columns[i]
+	slots := b.slots()
+	if i == b.colIdx && b.cardIdx >= 0 {
+		if b.cardIdx < col.offset {
+			col.offset = b.cardIdx
+		} else if b.cardIdx >= col.offset+slots {
+			col.offset = b.cardIdx - slots + 1
+		}
+	}
+	col.offset = max(0, min(col.offset, len(col.cards)-slots))
+}
+
+// reveal scrolls the board horizontally so that the column under the
+// cursor is fully visible, as far as the width allows.
+func (b *Board) reveal() {
+	if b.colIdx < b.first {
+		b.first = b.colIdx
+		return
+	}
+	_, _, cw, _ := b.Content()
+	for b.first < b.colIdx {
+		w := 0
+		for i := b.first; i <= b.colIdx; i++ {
+			w += b.width(i) + 1
+		}
+		if w-1 <= cw {
+			break
+		}
+		b.first++
+	}
+}
+
+// clamp keeps the cursor within the columns and cards after a removal.
+func (b *Board) clamp() {
+	col := b.FocusedColumn()
+	if col == nil {
+		b.colIdx, b.cardIdx = 0, -1
+		return
+	}
+	b.cardIdx = min(max(b.cardIdx, 0), len(col.cards)-1)
+}
+
+// hitTest returns the column and card index at the screen position mx,
+// my, or -1, -1 if there is no card. The blank row below a card belongs
+// to no card.
+func (b *Board) hitTest(mx, my int) (int, int) {
+	_, cy, _, ch := b.Content()
+	if my < cy+2 || my >= cy+ch {
+		return -1, -1
+	}
+	for _, v := range b.visible() {
+		i := v.index
+		if mx < v.x || mx >= v.x+v.width {
+			continue
+		}
+		row := my - cy - 2
+		if row%b.cardHeight == b.cardHeight-1 {
+			return -1, -1
+		}
+		index := b.columns[i].offset + row/b.cardHeight
+		if index >= len(b.columns[i].cards) {
+			return -1, -1
+		}
+		return i, index
+	}
+	return -1, -1
+}
+
+// target returns the column and index a dragged card would be dropped at
+// for the screen position mx, my. Positions beside the visible columns
+// map to the nearest one, positions above or below the cards to the first
+// or last index.
+func (b *Board) target(mx, my int) (*BoardColumn, int) {
+	_, cy, _, _ := b.Content()
+	col := -1
+	for _, v := range b.visible() {
+		if col < 0 || mx >= v.x {
+			col = v.index
+		}
+		if mx < v.x+v.width+1 {
+			break
+		}
+	}
+	if col < 0 {
+		return b.dragFrom, slices.Index(b.dragFrom.cards, b.dragCard)
+	}
+	dst := b.columns[col]
+	last := len(dst.cards)
+	if slices.Contains(dst.cards, b.dragCard) {
+		last--
+	}
+	index := dst.offset
+	if my >= cy+2 {
+		index += (my - cy - 2) / b.cardHeight
+	}
+	return dst, max(0, min(index, last))
+}
+
+// moveCard moves the card at fromIdx in column fromCol to index toIdx in
+// column toCol, the index it has there after the move. EvtMove is
+// dispatched first and may veto the move; otherwise the cursor follows
+// the card and EvtChange is dispatched. It reports whether the card was
+// moved.
+func (b *Board) moveCard(fromCol, fromIdx, toCol, toIdx int) bool {
+	src, dst := b.columns[fromCol], b.columns[toCol]
+	change := BoardChange{
+		Card:       src.cards[fromIdx],
+		FromColumn: src,
+		FromIndex:  fromIdx,
+		ToColumn:   dst,
+		ToIndex:    toIdx,
+	}
+	if b.Dispatch(b, EvtMove, change) {
+		return false
+	}
+	src.cards = slices.Delete(src.cards, fromIdx, fromIdx+1)
+	dst.cards = slices.Insert(dst.cards, toIdx, change.Card)
+	b.adjust(fromCol)
+	b.Select(toCol, toIdx)
+	b.Dispatch(b, EvtChange, change)
+	return true
+}
+
+// drop moves the dragged card to index toIdx of the column dst. The
+// indices are looked up again, since cards and columns may have been
+// added, moved or removed during the drag; if the card or one of the
+// columns is gone, nothing is moved.
+func (b *Board) drop(card *BoardCard, src, dst *BoardColumn, toIdx int) {
+	from, to := slices.Index(b.columns, src), slices.Index(b.columns, dst)
+	if from < 0 || to < 0 {
+		return
+	}
+	fromIdx := slices.Index(src.cards, card)
+	if fromIdx < 0 {
+		return
+	}
+	last := len(dst.cards)
+	if from == to {
+		last--
+	}
+	toIdx = max(0, min(toIdx, last))
+	if from != to || fromIdx != toIdx {
+		b.moveCard(from, fromIdx, to, toIdx)
+	}
+}
+
+// cancel ends a press or drag without moving anything.
+func (b *Board) cancel() {
+	b.pressed, b.activate = false, false
+	b.dragCard, b.dragFrom, b.targetCol = nil, nil, nil
+	capture(b, false)
+	if b.dragging {
+		b.dragging = false
+		Redraw(b)
+	}
+}
+
+// ---- Event Handlers --------------------------------------------------------
+
+func (b *Board) handleKey(evt *tcell.EventKey) bool {
+	if evt.Key() == tcell.KeyEscape && b.pressed {
+		b.cancel()
+		return true
+	}
+	col := b.FocusedColumn()
+	if col == nil {
+		return false
+	}
+	ctrl := evt.Modifiers()&tcell.ModCtrl != 0
+	card := b.cardIdx
+	switch evt.Key() {
+	case tcell.KeyUp:
+		if ctrl && card > 0 {
+			b.moveCard(b.colIdx, card, b.colIdx, card-1)
+		} else if !ctrl && card > 0 {
+			b.Select(b.colIdx, card-1)
+		}
+	case tcell.KeyDown:
+		if ctrl && card >= 0 && card < len(col.cards)-1 {
+			b.moveCard(b.colIdx, card, b.colIdx, card+1)
+		} else if !ctrl && card < len(col.cards)-1 {
+			b.Select(b.colIdx, card+1)
+		}
+	case tcell.KeyLeft:
+		if b.colIdx == 0 {
+			break
+		}
+		if ctrl && card >= 0 {
+			b.moveCard(b.colIdx, card, b.colIdx-1, len(b.columns[b.colIdx-1].cards))
+		} else if !ctrl {
+			b.Select(b.colIdx-1, card)
+		}
+	case tcell.KeyRight:
+		if b.colIdx == len(b.columns)-1 {
+			break
+		}
+		if ctrl && card >= 0 {
+			b.moveCard(b.colIdx, card, b.colIdx+1, len(b.columns[b.colIdx+1].cards))
+		} else if !ctrl {
+			b.Select(b.colIdx+1, card)
+		}
+	case tcell.KeyHome:
+		b.Select(b.colIdx, 0)
+	case tcell.KeyEnd:
+		b.Select(b.colIdx, len(col.cards)-1)
+	case tcell.KeyPgUp:
+		b.Select(b.colIdx, card-b.slots())
+	case tcell.KeyPgDn:
+		b.Select(b.colIdx, card+b.slots())
+	case tcell.KeyEnter:
+		if c := b.FocusedCard(); c != nil {
+			b.Dispatch(b, EvtActivate, c)
+		}
+	default:
+		return false
+	}
+	return true
+}
+
+// handleMouse selects the card pressed on, scrolls a column with the
+// wheel and drags cards: a press on a card followed by a movement with
+// the button held starts a drag, the release drops the card. A press and
+// release on the card under the cursor without movement activates it.
+func (b *Board) handleMouse(evt *tcell.EventMouse) bool {
+	mx, my := evt.Position()
+	switch evt.Buttons() {
+	case tcell.Button1:
+		if b.pressed {
+			if !b.dragging && (mx != b.dragX || my != b.dragY) {
+				b.dragging = true
+			}
+			if b.dragging {
+				b.targetCol, b.targetCard = b.target(mx, my)
+				Redraw(b)
+			}
+			return true
+		}
+		col, card := b.hitTest(mx, my)
+		if col < 0 {
+			return false
+		}
+		b.activate = col == b.colIdx && card == b.cardIdx
+		if !b.activate {
+			b.Select(col, card)
+		}
+		b.pressed = true
+		b.dragFrom = b.columns[col]
+		b.dragCard = b.dragFrom.cards[card]
+		b.targetCol, b.targetCard = b.dragFrom, card
+		b.dragX, b.dragY = mx, my
+		capture(b, true)
+		return true
+	case tcell.ButtonNone:
+		if !b.pressed {
+			return false
+		}
+		dragging, activate := b.dragging, b.activate
+		card, src, dst, toIdx := b.dragCard, b.dragFrom, b.targetCol, b.targetCard
+		b.cancel()
+		if dragging {
+			b.drop(card, src, dst, toIdx)
+		} else if activate {
+			if c := b.FocusedCard(); c != nil {
+				b.Dispatch(b, EvtActivate, c)
+			}
+		}
+		return true
+	case tcell.WheelUp, tcell.WheelDown:
+		for _, v := range b.visible() {
+			if mx < v.x || mx >= v.x+v.width {
+				continue
+			}
+			col := b.columns[v.index]
+			if evt.Buttons() == tcell.WheelUp {
+				col.offset--
+			} else {
+				col.offset++
+			}
+			col.offset = max(0, min(col.offset, len(col.cards)-b.slots()))
+			Redraw(b)
+			return true
+		}
+	}
+	return false
+}
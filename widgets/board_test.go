@@ -0,0 +1,325 @@
+package widgets
+
+import (
+	"testing"
+
+	"github.com/gdamore/tcell/v3"
+	. "github.com/tekugo/zeichenwerk/core"
+)
+
+// newTestBoard returns a 33×14 board with two 16-wide columns: "todo"
+// holding cards a, b and c and "done" holding d. Each column shows three
+// 4-row card slots below the header and the rule.
+func newTestBoard() *Board {
+	b := NewBoard("b", "")
+	b.SetColumnWidth(16)
+	b.SetBounds(0, 0, 33, 14)
+	todo := b.AddColumn("todo", "To Do")
+	for _, title := range []string{"a", "b", "c"} {
+		todo.AddCard(&BoardCard{ID: title, Title: title})
+	}
+	b.AddColumn("done", "Done").AddCard(&BoardCard{ID: "d", Title: "d"})
+	return b
+}
+
+// boardChanges records the moves the board reports with EvtChange.
+func boardChanges(b *Board) *[]BoardChange {
+	var got []BoardChange
+	b.On(EvtChange, func(_ Widget, _ Event, data ...any) bool {
+		got = append(got, data[0].(BoardChange))
+		return true
+	})
+	return &got
+}
+
+func ctrlKey(key tcell.Key) *tcell.EventKey {
+	return tcell.NewEventKey(key, "", tcell.ModCtrl)
+}
+
+func titles(c *BoardColumn) string {
+	s := ""
+	for _, card := range c.Cards() {
+		s += card.Title
+	}
+	return s
+}
+
+func TestBoard_Columns(t *testing.T) {
+	b := newTestBoard()
+	if b.SelectedColumn() != 0 || b.SelectedCard() != 0 {
+		t.Errorf("cursor = %d, %d; want 0, 0", b.SelectedColumn(), b.SelectedCard())
+	}
+	if c := b.GetColumn("done"); c == nil || c.Len() != 1 {
+		t.Fatal("GetColumn(done) should return the column with one card")
+	}
+	if b.GetColumn("none") != nil {
+		t.Error("GetColumn of an unknown ID should return nil")
+	}
+
+	b.Select(1, 0)
+	if !b.MoveColumn("done", 0) || b.SelectedColumn() != 0 || b.FocusedColumn().ID != "done" {
+		t.Errorf("cursor after MoveColumn = %d; want it on done at 0", b.SelectedColumn())
+	}
+	if !b.RemoveColumn("done") || b.FocusedColumn().ID != "todo" || b.SelectedCard() != 0 {
+		t.Errorf("cursor after RemoveColumn = %d, %d; want todo, 0", b.SelectedColumn(), b.SelectedCard())
+	}
+	b.RemoveColumn("todo")
+	if b.SelectedColumn() != -1 || b.FocusedCard() != nil {
+		t.Error("a board without columns should have no cursor")
+	}
+}
+
+func TestBoard_ColumnCards(t *testing.T) {
+	b := newTestBoard()
+	todo := b.GetColumn("todo")
+	card := todo.AddCard(&BoardCard{Title: "x"})
+	if card.ID == "" {
+		t.Error("AddCard should generate a missing ID")
+	}
+
+	// The cursor stays on the same card when cards are inserted above it.
+	b.Select(0, 1)
+	todo.InsertCard(0, &BoardCard{ID: "y", Title: "y"})
+	if b.FocusedCard().ID != "b" {
+		t.Errorf("focused card after insert = %s; want b", b.FocusedCard().ID)
+	}
+	todo.RemoveCard("y")
+	if b.FocusedCard().ID != "b" {
+		t.Errorf("focused card after remove = %s; want b", b.FocusedCard().ID)
+	}
+	if !todo.MoveCard("a", 9) || titles(todo) != "bcxa" {
+		t.Errorf("cards after MoveCard = %s; want bcxa", titles(todo))
+	}
+
+	todo.SetLimit(3)
+	if !todo.Over() {
+		t.Error("4 cards over a limit of 3 should be over")
+	}
+	todo.SetLimit(0)
+	if todo.Over() {
+		t.Error("a column without limit is never over")
+	}
+}
+
+func TestBoard_Select(t *testing.T) {
+	b := newTestBoard()
+	var got [][2]int
+	b.On(EvtSelect, func(_ Widget, _ Event, data ...any) bool {
+		got = append(got, [2]int{data[0].(int), data[1].(int)})
+		return true
+	})
+
+	b.Select(5, 9)
+	if b.SelectedColumn() != 1 || b.SelectedCard() != 0 {
+		t.Errorf("Select(5, 9) = %d, %d; want 1, 0", b.SelectedColumn(), b.SelectedCard())
+	}
+	// → keeps the card index, clamped to the column.
+	b.Select(0, 2)
+	b.handleKey(tcell.NewEventKey(tcell.KeyRight, "", tcell.ModNone))
+	if b.SelectedColumn() != 1 || b.SelectedCard() != 0 {
+		t.Errorf("→ from 0, 2 = %d, %d; want 1, 0", b.SelectedColumn(), b.SelectedCard())
+	}
+	if len(got) != 3 {
+		t.Errorf("EvtSelect = %v; want three changes", got)
+	}
+
+	// Selecting a card below the visible slots scrolls the column.
+	todo := b.GetColumn("todo")
+	todo.AddCard(&BoardCard{Title: "e"})
+	b.Select(0, 3)
+	if todo.offset != 1 {
+		t.Errorf("offset = %d; want 1", todo.offset)
+	}
+	b.handleKey(tcell.NewEventKey(tcell.KeyHome, "", tcell.ModNone))
+	if b.SelectedCard() != 0 || todo.offset != 0 {
+		t.Errorf("Home = card %d, offset %d; want 0, 0", b.SelectedCard(), todo.offset)
+	}
+}
+
+func TestBoard_HitTest(t *testing.T) {
+	b := newTestBoard()
+	tests := []struct {
+		x, y      int
+		col, card int
+	}{
+		{0, 0, -1, -1},  // header
+		{0, 2, 0, 0},    // first card
+		{15, 5, -1, -1}, // gap row below a card
+		{15, 6, 0, 1},   // second card
+		{16, 6, -1, -1}, // separator
+		{17, 2, 1, 0},   // first card of done
+		{17, 6, -1, -1}, // below the last card of done
+	}
+	for _, tt := range tests {
+		if col, card := b.hitTest(tt.x, tt.y); col != tt.col || card != tt.card {
+			t.Errorf("hitTest(%d, %d) = %d, %d; want %d, %d", tt.x, tt.y, col, card, tt.col, tt.card)
+		}
+	}
+}
+
+func TestBoard_KeyboardMove(t *testing.T) {
+	b := newTestBoard()
+	todo, done := b.GetColumn("todo"), b.GetColumn("done")
+	got := boardChanges(b)
+
+	b.handleKey(ctrlKey(tcell.KeyDown))
+	if titles(todo) != "bac" || b.SelectedCard() != 1 {
+		t.Errorf("Ctrl+↓ = %s, cursor %d; want bac, 1", titles(todo), b.SelectedCard())
+	}
+	b.handleKey(ctrlKey(tcell.KeyRight))
+	if titles(todo) != "bc" || titles(done) != "da" {
+		t.Errorf("Ctrl+→ = %s | %s; want bc | da", titles(todo), titles(done))
+	}
+	if b.FocusedCard().ID != "a" {
+		t.Errorf("cursor after Ctrl+→ on %s; want a", b.FocusedCard().ID)
+	}
+	if len(*got) != 2 {
+		t.Fatalf("EvtChange = %d moves; want 2", len(*got))
+	}
+	if c := (*got)[1]; c.FromColumn != todo || c.FromIndex != 1 || c.ToColumn != done || c.ToIndex != 1 {
+		t.Errorf("change = %s[%d] → %s[%d]; want todo[1] → done[1]", c.FromColumn.ID, c.FromIndex, c.ToColumn.ID, c.ToIndex)
+	}
+	if b.handleKey(ctrlKey(tcell.KeyRight)); titles(done) != "da" {
+		t.Error("Ctrl+→ in the last column should not move the card")
+	}
+}
+
+func TestBoard_Veto(t *testing.T) {
+	b := newTestBoard()
+	got := boardChanges(b)
+	b.On(EvtMove, func(_ Widget, _ Event, data ...any) bool {
+		return data[0].(BoardChange).ToColumn.ID == "done"
+	})
+
+	b.handleKey(ctrlKey(tcell.KeyRight))
+	if titles(b.GetColumn("done")) != "d" || b.FocusedCard().ID != "a" {
+		t.Error("a vetoed move should leave the card and the cursor in place")
+	}
+	b.handleKey(ctrlKey(tcell.KeyDown))
+	if titles(b.GetColumn("todo")) != "bac" {
+		t.Errorf("todo = %s; want bac", titles(b.GetColumn("todo")))
+	}
+	if len(*got) != 1 {
+		t.Errorf("EvtChange = %d moves; want only the allowed one", len(*got))
+	}
+}
+
+func TestBoard_Drag(t *testing.T) {
+	b := newTestBoard()
+	got := boardChanges(b)
+
+	// Drag b into done, below d.
+	b.handleMouse(mouse(2, 6, tcell.Button1))
+	if b.dragging {
+		t.Error("a press alone should not start a drag")
+	}
+	b.handleMouse(mouse(3, 6, tcell.Button1))
+	b.handleMouse(mouse(20, 13, tcell.Button1))
+	if !b.dragging || b.targetCol != b.GetColumn("done") || b.targetCard != 1 {
+		t.Errorf("target = %v, %d (dragging %t); want done, 1", b.targetCol, b.targetCard, b.dragging)
+	}
+	b.handleMouse(mouse(20, 13, tcell.ButtonNone))
+	if b.dragging || titles(b.GetColumn("done")) != "db" {
+		t.Errorf("done after drop = %s; want db", titles(b.GetColumn("done")))
+	}
+	if len(*got) != 1 || (*got)[0].FromIndex != 1 || (*got)[0].ToIndex != 1 {
+		t.Errorf("EvtChange = %+v; want one move from index 1 to 1", *got)
+	}
+
+	// Escape aborts a drag.
+	b.handleMouse(mouse(2, 2, tcell.Button1))
+	b.handleMouse(mouse(2, 10, tcell.Button1))
+	b.handleKey(tcell.NewEventKey(tcell.KeyEscape, "", tcell.ModNone))
+	b.handleMouse(mouse(2, 10, tcell.ButtonNone))
+	if b.dragging || titles(b.GetColumn("todo")) != "ac" || len(*got) != 1 {
+		t.Errorf("todo after aborted drag = %s; want ac", titles(b.GetColumn("todo")))
+	}
+}
+
+func TestBoard_DragChanged(t *testing.T) {
+	// drag presses on b, moves it to done below d and runs change before
+	// the release.
+	drag := func(change func(b *Board)) (*Board, *[]BoardChange) {
+		b := newTestBoard()
+		got := boardChanges(b)
+		b.handleMouse(mouse(2, 6, tcell.Button1))
+		b.handleMouse(mouse(20, 13, tcell.Button1))
+		change(b)
+		b.handleMouse(mouse(20, 13, tcell.ButtonNone))
+		return b, got
+	}
+
+	b, got := drag(func(b *Board) { b.GetColumn("todo").RemoveCard("b") })
+	if len(*got) != 0 || titles(b.GetColumn("done")) != "d" {
+		t.Errorf("removed card: done = %s, %d moves; want d and none", titles(b.GetColumn("done")), len(*got))
+	}
+
+	b, got = drag(func(b *Board) { b.RemoveColumn("done") })
+	if len(*got) != 0 || titles(b.GetColumn("todo")) != "abc" {
+		t.Errorf("removed column: todo = %s, %d moves; want abc and none", titles(b.GetColumn("todo")), len(*got))
+	}
+
+	// The dragged card is moved, wherever it is now.
+	b, got = drag(func(b *Board) {
+		b.GetColumn("todo").InsertCard(0, &BoardCard{ID: "x", Title: "x"})
+		b.MoveColumn("done", 0)
+	})
+	if len(*got) != 1 || titles(b.GetColumn("todo")) != "xac" || titles(b.GetColumn("done")) != "db" {
+		t.Errorf("shifted: todo = %s, done = %s; want xac, db", titles(b.GetColumn("todo")), titles(b.GetColumn("done")))
+	}
+}
+
+func TestBoard_ClickActivate(t *testing.T) {
+	b := newTestBoard()
+	var got []string
+	b.On(EvtActivate, func(_ Widget, _ Event, data ...any) bool {
+		got = append(got, data[0].(*BoardCard).ID)
+		return true
+	})
+	click := func(x, y int) {
+		b.handleMouse(mouse(x, y, tcell.Button1))
+		b.handleMouse(mouse(x, y, tcell.ButtonNone))
+	}
+	click(2, 6) // selects b
+	click(2, 7) // activates b
+	if b.FocusedCard().ID != "b" || len(got) != 1 || got[0] != "b" {
+		t.Errorf("activated %v with the cursor on %s; want [b] on b", got, b.FocusedCard().ID)
+	}
+}
+
+func TestBoard_Render(t *testing.T) {
+	cs := NewTestScreen()
+	NewBoard("empty", "").Render(NewRenderer(cs, NewTheme()))
+
+	b := newTestBoard()
+	todo := b.GetColumn("todo")
+	todo.SetLimit(2)
+	todo.AddCard(&BoardCard{Title: "labelled", Label: "$red"})
+	b.Render(NewRenderer(cs, NewTheme()))
+
+	if got := cs.Get(16, 1); got != "┼" {
+		t.Errorf("rule at the separator = %q; want ┼", got)
+	}
+	if got := cs.Get(16, 3); got != "│" {
+		t.Errorf("separator = %q; want │", got)
+	}
+	if got := cs.Get(0, 2); got != " " {
+		t.Errorf("strip without label = %q; want a space", got)
+	}
+	if got := cs.Get(2, 2); got != "a" {
+		t.Errorf("first title = %q; want a", got)
+	}
+	header := ""
+	for x := range 16 {
+		header += cs.Get(x, 0)
+	}
+	if header != "   To Do (4!)   " {
+		t.Errorf("header = %q; want the count with the WIP marker", header)
+	}
+
+	b.Select(0, 3)
+	b.Render(NewRenderer(cs, NewTheme()))
+	if got := cs.Get(0, 10); got != "▌" {
+		t.Errorf("strip with label = %q; want ▌", got)
+	}
+}
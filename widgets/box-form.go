@@ -45,7 +45,6 @@ func (f *BoxForm) Validate(field string) error { return nil }
 
 func (f *BoxForm) Emit(w io.Writer, mode string) error {
 	return f.EmitFrame(w, mode, func() error {
-		_, err := fmt.Fprintf(w, "Box(%q, %q).\n", f.ID, f.Title)
-		return err
+		return f.EmitConstructor(w, mode, "Box", fmt.Sprintf("%q", f.Title))
 	})
 }
@@ -60,8 +60,7 @@ func (f *BreadcrumbForm) Validate(field string) error { return nil }
 // overflow; those land as TODO comments after the standard frame.
 func (f *BreadcrumbForm) Emit(w io.Writer, mode string) error {
 	if err := f.EmitFrame(w, mode, func() error {
-		_, err := fmt.Fprintf(w, "Breadcrumb(%q).\n", f.ID)
-		return err
+		return f.EmitConstructor(w, mode, "Breadcrumb")
 	}); err != nil {
 		return err
 	}
@@ -70,13 +69,19 @@ func (f *BreadcrumbForm) Emit(w io.Writer, mode string) error {
 		for i, it := range items {
 			quoted[i] = fmt.Sprintf("%q", it)
 		}
-		fmt.Fprintf(w, "// TODO: Set([]string{%s}) — no Builder setter\n", strings.Join(quoted, ", "))
+		if err := f.EmitTODO(w, mode, "Set([]string{%s})", strings.Join(quoted, ", ")); err != nil {
+			return err
+		}
 	}
 	if f.Separator != "" {
-		fmt.Fprintf(w, "// TODO: SetSeparator(%q) — no Builder setter\n", f.Separator)
+		if err := f.EmitTODO(w, mode, "SetSeparator(%q)", f.Separator); err != nil {
+			return err
+		}
 	}
 	if f.Overflow != "" {
-		fmt.Fprintf(w, "// TODO: SetOverflow(%q) — no Builder setter\n", f.Overflow)
+		if err := f.EmitTODO(w, mode, "SetOverflow(%q)", f.Overflow); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -46,7 +46,6 @@ func (f *ButtonForm) Validate(field string) error { return nil }
 
 func (f *ButtonForm) Emit(w io.Writer, mode string) error {
 	return f.EmitFrame(w, mode, func() error {
-		_, err := fmt.Fprintf(w, "Button(%q, %q).\n", f.ID, f.Text)
-		return err
+		return f.EmitConstructor(w, mode, "Button", fmt.Sprintf("%q", f.Text))
 	})
 }
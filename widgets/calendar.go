@@ -0,0 +1,634 @@
+package widgets
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/gdamore/tcell/v3"
+	. "github.com/tekugo/zeichenwerk/core"
+)
+
+// CalendarNames holds the month and weekday names a Calendar shows.
+type CalendarNames struct {
+	Months   [12]string // month names, January first
+	Weekdays [7]string  // two-letter weekday abbreviations, Sunday first like time.Weekday
+}
+
+// CalendarLocales holds the names of the built-in locales for
+// Calendar.SetLocale, keyed by language tag. Applications can add their
+// own.
+var CalendarLocales = map[string]CalendarNames{
+	"en": {
+		Months:   [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+		Weekdays: [7]string{"Su", "Mo", "Tu", "We", "Th", "Fr", "Sa"},
+	},
+	"de": {
+		Months:   [12]string{"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+		Weekdays: [7]string{"So", "Mo", "Di", "Mi", "Do", "Fr", "Sa"},
+	},
+	"es": {
+		Months:   [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+		Weekdays: [7]string{"do", "lu", "ma", "mi", "ju", "vi", "sá"},
+	},
+	"fr": {
+		Months:   [12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+		Weekdays: [7]string{"di", "lu", "ma", "me", "je", "ve", "sa"},
+	},
+}
+
+// Calendar shows one month as a grid of days and lets the user pick a
+// date, or a range of dates, with the keyboard or the mouse:
+//
+//	◀    March 2026    ▶
+//	Mo Tu We Th Fr Sa Su
+//	                   1
+//	 2  3  4  5  6  7  8
+//	 9 10 11 12 13 14 15
+//	16 17 18 19 20 21 22
+//	23 24 25 26 27 28 29
+//	30 31
+//
+// The cursor (the selected date) moves by day, week, month and year; the
+// month shown follows it. Dates before the minimum, after the maximum or
+// rejected by the disabled function cannot be selected; the arrow keys
+// skip disabled dates.
+//
+// In range mode the first confirmed date starts a range and the second
+// one ends it; the days in between are highlighted while the cursor moves.
+// Escape drops a range being selected; otherwise it dispatches EvtCancel
+// and is left to the parent if no handler consumes it.
+type Calendar struct {
+	Component
+	selected  time.Time            // date under the cursor, at midnight
+	viewing   time.Time            // first day of the month shown
+	weekStart time.Weekday         // first column of the grid
+	minDate   time.Time            // first selectable date; zero = no bound
+	maxDate   time.Time            // last selectable date; zero = no bound
+	disabled  func(time.Time) bool // rejects single dates; may be nil
+	names     CalendarNames
+	ranged    bool      // range selection mode
+	anchor    time.Time // first date of the range being selected; zero if none
+	start     time.Time // first date of the confirmed range
+	end       time.Time // last date of the confirmed range
+	hot       int       // arrow under the mouse: -1 = previous, 1 = next, 0 = none
+	down      bool      // mouse button is down
+	lastClick time.Time // time of the last click on a day, for double clicks
+	lastDate  time.Time // day of the last click
+
+	chPrev string // previous month arrow, resolved from "calendar.prev"
+	chNext string // next month arrow, resolved from "calendar.next"
+}
+
+// NewCalendar creates a calendar showing the current month with today
+// selected. Weeks start on Monday and the names are English.
+func NewCalendar(id, class string) *Calendar {
+	today := calendarDay(time.Now())
+	c := &Calendar{
+		Component: Component{id: id, class: class},
+		selected:  today,
+		viewing:   calendarMonth(today),
+		weekStart: time.Monday,
+		names:     CalendarLocales["en"],
+		chPrev:    "◀",
+		chNext:    "▶",
+	}
+	c.SetFlag(FlagFocusable, true)
+	OnKey(c, c.handleKey)
+	OnMouse(c, c.handleMouse)
+	return c
+}
+
+// ---- Widget Methods -------------------------------------------------------
+
+// Apply applies the calendar styles and resolves the arrows from the
+// theme strings.
+func (c *Calendar) Apply(theme *Theme) {
+	theme.Apply(c, c.Selector("calendar"), "disabled", "focused")
+	theme.Apply(c, c.Selector("calendar/title"))
+	theme.Apply(c, c.Selector("calendar/header"))
+	theme.Apply(c, c.Selector("calendar/arrow"), "hovered")
+	theme.Apply(c, c.Selector("calendar/day"), "disabled")
+	theme.Apply(c, c.Selector("calendar/today"))
+	theme.Apply(c, c.Selector("calendar/selected"), "focused")
+	theme.Apply(c, c.Selector("calendar/range"))
+	theme.Apply(c, c.Selector("calendar/overflow"))
+	if s := theme.String("calendar.prev"); s != "" {
+		c.chPrev = s
+	}
+	if s := theme.String("calendar.next"); s != "" {
+		c.chNext = s
+	}
+}
+
+// Hint returns the fixed size of the grid: 7 two-column days with a space
+// between them and 8 rows for the title, the weekdays and 6 weeks.
+func (c *Calendar) Hint() (int, int) {
+	if c.hwidth != 0 || c.hheight != 0 {
+		return c.hwidth, c.hheight
+	}
+	return 20, 8
+}
+
+// Render draws the title with the month and the arrows, the weekday row
+// and the 6×7 grid of days, centred horizontally in the content area.
+func (c *Calendar) Render(r *Renderer) {
+	if c.Flag(FlagHidden) {
+		return
+	}
+	c.Component.Render(r)
+
+	cx, cy, cw, ch := c.Content()
+	if cw < 20 || ch < 1 {
+		return
+	}
+	ox := cx + (cw-20)/2
+	bg := c.Style()
+
+	// Title with the arrows
+	arrow := c.Style("arrow")
+	if c.hot < 0 && c.Flag(FlagHovered) {
+		arrow = c.Style("arrow:hovered")
+	}
+	r.Set(arrow.Foreground(), arrow.Background(), arrow.Font())
+	r.Text(ox, cy, c.chPrev, 1)
+	arrow = c.Style("arrow")
+	if c.hot > 0 && c.Flag(FlagHovered) {
+		arrow = c.Style("arrow:hovered")
+	}
+	r.Set(arrow.Foreground(), arrow.Background(), arrow.Font())
+	r.Text(ox+19, cy, c.chNext, 1)
+
+	title := fmt.Sprintf("%s %d", c.names.Months[c.viewing.Month()-1], c.viewing.Year())
+	pad := max(0, (18-utf8.RuneCountInString(title))/2)
+	style := c.Style("title")
+	r.Set(style.Foreground(), style.Background(), style.Font())
+	r.Text(ox+1, cy, strings.Repeat(" ", pad)+title, 18)
+
+	// Weekday names
+	if ch < 2 {
+		return
+	}
+	days := make([]string, 7)
+	for i := range days {
+		days[i] = c.names.Weekdays[(int(c.weekStart)+i)%7]
+	}
+	style = c.Style("header")
+	r.Set(style.Foreground(), style.Background(), style.Font())
+	r.Text(ox, cy+1, strings.Join(days, " "), 20)
+
+	// Days
+	first := c.firstCell()
+	for i := range 42 {
+		row, col := i/7, i%7
+		if row+2 >= ch {
+			break
+		}
+		x, y := ox+col*3, cy+2+row
+		day := first.AddDate(0, 0, i)
+		style = c.dayStyle(day)
+		text := "  "
+		if day.Month() == c.viewing.Month() {
+			text = fmt.Sprintf("%2d", day.Day())
+		}
+		r.Set(style.Foreground(), style.Background(), style.Font())
+		r.Text(x, y, text, 2)
+
+		// The gap between two days of a range is part of the range.
+		if col < 6 {
+			next := day.AddDate(0, 0, 1)
+			if c.inRange(day) && c.inRange(next) && day.Month() == c.viewing.Month() && next.Month() == c.viewing.Month() {
+				style = c.Style("range")
+			} else {
+				style = bg
+			}
+			r.Set(style.Foreground(), style.Background(), style.Font())
+			r.Text(x+2, y, " ", 1)
+		}
+	}
+}
+
+// Summary returns the selected date and the range for Dump output.
+func (c *Calendar) Summary() string {
+	s := "date=" + c.selected.Format(time.DateOnly)
+	if c.ranged && !c.start.IsZero() {
+		s += " range=" + c.start.Format(time.DateOnly) + ".." + c.end.Format(time.DateOnly)
+	}
+	return s
+}
+
+// ---- Getters and Setters --------------------------------------------------
+
+// Date returns the selected date at midnight.
+func (c *Calendar) Date() time.Time {
+	return c.selected
+}
+
+// SetDate selects the date, shows its month and clears a range being
+// selected. No events are dispatched.
+func (c *Calendar) SetDate(t time.Time) {
+	c.selected = calendarDay(t)
+	c.viewing = calendarMonth(c.selected)
+	c.anchor = time.Time{}
+	c.Refresh()
+}
+
+// Viewing returns the first day of the month shown.
+func (c *Calendar) Viewing() time.Time {
+	return c.viewing
+}
+
+// SetWeekStart sets the weekday of the first column, usually time.Monday
+// or time.Sunday.
+func (c *Calendar) SetWeekStart(day time.Weekday) {
+	c.weekStart = day % 7
+	c.Refresh()
+}
+
+// SetMinDate sets the first selectable date; the zero time removes the
+// bound.
+func (c *Calendar) SetMinDate(t time.Time) {
+	c.minDate = time.Time{}
+	if !t.IsZero() {
+		c.minDate = calendarDay(t)
+	}
+	c.Refresh()
+}
+
+// SetMaxDate sets the last selectable date; the zero time removes the
+// bound.
+func (c *Calendar) SetMaxDate(t time.Time) {
+	c.maxDate = time.Time{}
+	if !t.IsZero() {
+		c.maxDate = calendarDay(t)
+	}
+	c.Refresh()
+}
+
+// SetDisabled sets a function that disables single dates, for example
+// weekends or holidays. Passing nil enables all dates within the bounds.
+func (c *Calendar) SetDisabled(fn func(time.Time) bool) {
+	c.disabled = fn
+	c.Refresh()
+}
+
+// Enabled returns whether the date can be selected.
+func (c *Calendar) Enabled(t time.Time) bool {
+	t = calendarDay(t)
+	if !c.minDate.IsZero() && t.Before(c.minDate) {
+		return false
+	}
+	if !c.maxDate.IsZero() && t.After(c.maxDate) {
+		return false
+	}
+	return c.disabled == nil || !c.disabled(t)
+}
+
+// SetNames sets the month and weekday names.
+func (c *Calendar) SetNames(names CalendarNames) {
+	c.names = names
+	c.Refresh()
+}
+
+// SetLocale sets the names of a locale from CalendarLocales and reports
+// whether it exists. Only the language part of tags like "de-AT" or
+// "de_AT.UTF-8" is used if the full tag is not found.
+func (c *Calendar) SetLocale(tag string) bool {
+	names, ok := CalendarLocales[tag]
+	if !ok {
+		language, _, _ := strings.Cut(strings.NewReplacer("_", "-", ".", "-").Replace(tag), "-")
+		names, ok = CalendarLocales[strings.ToLower(language)]
+	}
+	if ok {
+		c.SetNames(names)
+	}
+	return ok
+}
+
+// SetRangeMode switches range selection on or off. Switching it clears
+// the range.
+func (c *Calendar) SetRangeMode(on bool) {
+	c.ranged = on
+	c.anchor = time.Time{}
+	c.start, c.end = time.Time{}, time.Time{}
+	c.Refresh()
+}
+
+// Range returns the first and the last date of the confirmed range, or
+// two zero times if there is none.
+func (c *Calendar) Range() (time.Time, time.Time) {
+	return c.start, c.end
+}
+
+// SetRange sets the confirmed range and shows the month of its last date.
+// The dates are swapped if end is before start. No events are dispatched.
+func (c *Calendar) SetRange(start, end time.Time) {
+	start, end = calendarDay(start), calendarDay(end)
+	if end.Before(start) {
+		start, end = end, start
+	}
+	c.start, c.end = start, end
+	c.anchor = time.Time{}
+	c.selected = end
+	c.viewing = calendarMonth(end)
+	c.Refresh()
+}
+
+// ---- Navigation -----------------------------------------------------------
+
+// Select moves the cursor to the date if it can be selected, shows its
+// month and dispatches EvtSelect. A change of the month shown is reported
+// with EvtChange.
+func (c *Calendar) Select(t time.Time) bool {
+	t = calendarDay(t)
+	if !c.Enabled(t) {
+		return false
+	}
+	if t.Equal(c.selected) {
+		return true
+	}
+	c.selected = t
+	c.show(calendarMonth(t))
+	c.Dispatch(c, EvtSelect, t)
+	c.Refresh()
+	return true
+}
+
+// PrevMonth shows the previous month without moving the cursor.
+func (c *Calendar) PrevMonth() {
+	c.show(c.viewing.AddDate(0, -1, 0))
+}
+
+// NextMonth shows the next month without moving the cursor.
+func (c *Calendar) NextMonth() {
+	c.show(c.viewing.AddDate(0, 1, 0))
+}
+
+// PrevYear shows the month one year back without moving the cursor.
+func (c *Calendar) PrevYear() {
+	c.show(c.viewing.AddDate(-1, 0, 0))
+}
+
+// NextYear shows the month one year ahead without moving the cursor.
+func (c *Calendar) NextYear() {
+	c.show(c.viewing.AddDate(1, 0, 0))
+}
+
+// Activate confirms the selected date: EvtActivate is dispatched with the
+// date. In range mode the first call starts a range and the second one
+// ends it and dispatches EvtActivate with the first and the last date.
+func (c *Calendar) Activate() {
+	if !c.ranged {
+		c.Dispatch(c, EvtActivate, c.selected)
+		return
+	}
+	if c.anchor.IsZero() {
+		c.anchor = c.selected
+		c.Refresh()
+		return
+	}
+	start, end := c.anchor, c.selected
+	if end.Before(start) {
+		start, end = end, start
+	}
+	c.start, c.end = start, end
+	c.anchor = time.Time{}
+	c.Refresh()
+	c.Dispatch(c, EvtActivate, start, end)
+}
+
+// ---- Internal Helpers -----------------------------------------------------
+
+// show changes the month shown and dispatches EvtChange with its first day.
+func (c *Calendar) show(month time.Time) {
+	month = calendarMonth(month)
+	if month.Equal(c.viewing) {
+		return
+	}
+	c.viewing = month
+	c.Dispatch(c, EvtChange, month)
+	c.Refresh()
+}
+
+// calendarSteps limits the dates step tries, so a disabler without bounds
+// that disables everything ahead cannot stall the event loop.
+const calendarSteps = 400
+
+// step moves the cursor by days, skipping disabled dates in the same
+// direction. It stops at the minimum or maximum date.
+func (c *Calendar) step(days int) {
+	t := c.selected
+	for range calendarSteps {
+		t = t.AddDate(0, 0, days)
+		if (!c.minDate.IsZero() && t.Before(c.minDate)) || (!c.maxDate.IsZero() && t.After(c.maxDate)) {
+			return
+		}
+		if c.Select(t) {
+			return
+		}
+	}
+}
+
+// moveMonths moves the cursor by n months, keeping the day of the month
+// where the month is long enough. If the date cannot be selected, only
+// the month shown changes.
+func (c *Calendar) moveMonths(n int) {
+	t := calendarAddMonths(c.selected, n)
+	if !c.Select(t) {
+		c.show(t)
+	}
+}
+
+// firstCell returns the date of the top-left cell: the first weekday of
+// the week holding the first day of the month shown.
+func (c *Calendar) firstCell() time.Time {
+	offset := (int(c.viewing.Weekday()) - int(c.weekStart) + 7) % 7
+	return c.viewing.AddDate(0, 0, -offset)
+}
+
+// inRange returns whether the date lies in the confirmed range or, while
+// a range is being selected, between its first date and the cursor.
+func (c *Calendar) inRange(t time.Time) bool {
+	if !c.ranged {
+		return false
+	}
+	start, end := c.start, c.end
+	if !c.anchor.IsZero() {
+		start, end = c.anchor, c.selected
+		if end.Before(start) {
+			start, end = end, start
+		}
+	}
+	return !start.IsZero() && !t.Before(start) && !t.After(end)
+}
+
+// dayStyle returns the style of a day cell. The priority is selected,
+// range, today, disabled, overflow, normal.
+func (c *Calendar) dayStyle(t time.Time) *Style {
+	switch {
+	case t.Month() != c.viewing.Month():
+		return c.Style("overflow")
+	case t.Equal(c.selected) && c.Flag(FlagFocused):
+		return c.Style("selected:focused")
+	case t.Equal(c.selected):
+		return c.Style("selected")
+	case c.inRange(t):
+		return c.Style("range")
+	case t.Equal(calendarDay(time.Now().In(t.Location()))):
+		return c.Style("today")
+	case !c.Enabled(t):
+		return c.Style("day:disabled")
+	}
+	return c.Style("day")
+}
+
+// hitTest returns the day at the screen position, or the zero time, and
+// the arrow at it: -1 for the previous, 1 for the next month.
+func (c *Calendar) hitTest(x, y int) (time.Time, int) {
+	cx, cy, cw, _ := c.Content()
+	x -= cx + (cw-20)/2
+	y -= cy
+	switch {
+	case y == 0 && x == 0:
+		return time.Time{}, -1
+	case y == 0 && x == 19:
+		return time.Time{}, 1
+	case y < 2 || y > 7 || x < 0 || x >= 20 || x%3 == 2:
+		return time.Time{}, 0
+	}
+	day := c.firstCell().AddDate(0, 0, (y-2)*7+x/3)
+	if day.Month() != c.viewing.Month() {
+		return time.Time{}, 0
+	}
+	return day, 0
+}
+
+// handleKey moves the cursor and confirms dates.
+func (c *Calendar) handleKey(evt *tcell.EventKey) bool {
+	ctrl := evt.Modifiers()&tcell.ModCtrl != 0
+	switch evt.Key() {
+	case tcell.KeyLeft:
+		c.step(-1)
+	case tcell.KeyRight:
+		c.step(1)
+	case tcell.KeyUp:
+		c.step(-7)
+	case tcell.KeyDown:
+		c.step(7)
+	case tcell.KeyPgUp:
+		if ctrl {
+			c.moveMonths(-12)
+		} else {
+			c.moveMonths(-1)
+		}
+	case tcell.KeyPgDn:
+		if ctrl {
+			c.moveMonths(12)
+		} else {
+			c.moveMonths(1)
+		}
+	case tcell.KeyHome:
+		c.Select(c.viewing)
+	case tcell.KeyEnd:
+		c.Select(c.viewing.AddDate(0, 1, -1))
+	case tcell.KeyEnter:
+		c.Activate()
+	case tcell.KeyEscape:
+		// Escape first drops a range being selected, then signals
+		// cancellation to the application.
+		if !c.anchor.IsZero() {
+			c.anchor = time.Time{}
+			c.Refresh()
+			return true
+		}
+		return c.Dispatch(c, EvtCancel)
+	case tcell.KeyRune:
+		switch evt.Str() {
+		case " ":
+			c.Activate()
+		case "t":
+			c.Select(time.Now())
+		default:
+			return false
+		}
+	default:
+		return false
+	}
+	return true
+}
+
+// handleMouse selects the day clicked, confirms it on a double click,
+// changes the month on the arrows and with the wheel.
+func (c *Calendar) handleMouse(event *tcell.EventMouse) bool {
+	x, y := event.Position()
+	day, arrow := c.hitTest(x, y)
+	if arrow != c.hot {
+		c.hot = arrow
+		c.Refresh()
+	}
+
+	switch event.Buttons() {
+	case tcell.WheelUp:
+		c.PrevMonth()
+		return true
+	case tcell.WheelDown:
+		c.NextMonth()
+		return true
+	case tcell.ButtonNone:
+		c.down = false
+		return false
+	case tcell.Button1:
+		if c.down {
+			return true
+		}
+		c.down = true
+	default:
+		return false
+	}
+
+	switch {
+	case arrow < 0:
+		c.PrevMonth()
+	case arrow > 0:
+		c.NextMonth()
+	case !day.IsZero() && c.Select(day):
+		now := event.When()
+		double := day.Equal(c.lastDate) && now.Sub(c.lastClick) <= DoubleClickThreshold
+		c.lastDate, c.lastClick = day, now
+		if double {
+			c.lastDate = time.Time{}
+			c.Activate()
+		}
+	default:
+		return false
+	}
+	return true
+}
+
+// calendarDay returns midnight of the day of t.
+func calendarDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
+
+// calendarMonth returns the first day of the month of t.
+func calendarMonth(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+}
+
+// calendarAddMonths adds n months to t, clamping the day to the length of
+// the month: January 31 plus one month is February 28 or 29.
+func calendarAddMonths(t time.Time, n int) time.Time {
+	first := calendarMonth(t).AddDate(0, n, 0)
+	last := first.AddDate(0, 1, -1).Day()
+	return time.Date(first.Year(), first.Month(), min(t.Day(), last), 0, 0, 0, 0, t.Location())
+}
+
+// calendarFormat returns the date in the layout, or "" for the zero time.
+func calendarFormat(t time.Time, layout string) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(layout)
+}
@@ -0,0 +1,267 @@
+package widgets
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gdamore/tcell/v3"
+	. "github.com/tekugo/zeichenwerk/core"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.Local)
+}
+
+// newTestCalendar returns a 20×8 calendar without border on the date.
+func newTestCalendar(t time.Time) *Calendar {
+	c := NewCalendar("c", "")
+	c.SetDate(t)
+	c.SetBounds(0, 0, 20, 8)
+	return c
+}
+
+func TestCalendar_FirstCell(t *testing.T) {
+	c := NewCalendar("c", "")
+	// March 2026 starts on a Sunday, June 2026 on a Monday, January 2026
+	// on a Thursday.
+	tests := []struct {
+		month time.Time
+		start time.Weekday
+		want  time.Time
+	}{
+		{date(2026, time.March, 1), time.Monday, date(2026, time.February, 23)},
+		{date(2026, time.March, 1), time.Sunday, date(2026, time.March, 1)},
+		{date(2026, time.June, 1), time.Monday, date(2026, time.June, 1)},
+		{date(2026, time.June, 1), time.Sunday, date(2026, time.May, 31)},
+		{date(2026, time.January, 1), time.Monday, date(2025, time.December, 29)},
+		{date(2026, time.January, 1), time.Sunday, date(2025, time.December, 28)},
+	}
+	for _, tt := range tests {
+		c.SetDate(tt.month)
+		c.SetWeekStart(tt.start)
+		if got := c.firstCell(); !got.Equal(tt.want) {
+			t.Errorf("firstCell(%s, %s) = %s; want %s", tt.month.Format("Jan"), tt.start, got.Format(time.DateOnly), tt.want.Format(time.DateOnly))
+		}
+	}
+}
+
+func TestCalendar_Keys(t *testing.T) {
+	c := newTestCalendar(date(2026, time.March, 31))
+	var months []time.Time
+	c.On(EvtChange, func(_ Widget, _ Event, data ...any) bool {
+		months = append(months, data[0].(time.Time))
+		return true
+	})
+
+	c.handleKey(newKey(tcell.KeyRight))
+	if !c.Date().Equal(date(2026, time.April, 1)) || !c.Viewing().Equal(date(2026, time.April, 1)) {
+		t.Errorf("→ on March 31 = %s viewing %s; want April 1", c.Date().Format(time.DateOnly), c.Viewing().Format("Jan"))
+	}
+	if len(months) != 1 || months[0].Month() != time.April {
+		t.Errorf("EvtChange months = %v; want April", months)
+	}
+	c.handleKey(newKey(tcell.KeyUp))
+	if !c.Date().Equal(date(2026, time.March, 25)) {
+		t.Errorf("↑ = %s; want March 25", c.Date().Format(time.DateOnly))
+	}
+	c.handleKey(newKey(tcell.KeyEnd))
+	if !c.Date().Equal(date(2026, time.March, 31)) {
+		t.Errorf("End = %s; want March 31", c.Date().Format(time.DateOnly))
+	}
+	c.handleKey(newKey(tcell.KeyHome))
+	if !c.Date().Equal(date(2026, time.March, 1)) {
+		t.Errorf("Home = %s; want March 1", c.Date().Format(time.DateOnly))
+	}
+	c.handleKey(ctrlKey(tcell.KeyPgDn))
+	if !c.Date().Equal(date(2027, time.March, 1)) {
+		t.Errorf("Ctrl+PgDn = %s; want March 1 2027", c.Date().Format(time.DateOnly))
+	}
+}
+
+func TestCalendar_MonthClamp(t *testing.T) {
+	c := newTestCalendar(date(2026, time.January, 31))
+	c.handleKey(newKey(tcell.KeyPgDn))
+	if !c.Date().Equal(date(2026, time.February, 28)) {
+		t.Errorf("PgDn on January 31 = %s; want February 28", c.Date().Format(time.DateOnly))
+	}
+
+	c.SetDate(date(2028, time.January, 31))
+	c.handleKey(newKey(tcell.KeyPgDn))
+	if !c.Date().Equal(date(2028, time.February, 29)) {
+		t.Errorf("PgDn on January 31 2028 = %s; want February 29", c.Date().Format(time.DateOnly))
+	}
+
+	c.SetDate(date(2028, time.March, 30))
+	c.handleKey(newKey(tcell.KeyPgDn))
+	if !c.Date().Equal(date(2028, time.April, 30)) {
+		t.Errorf("PgDn on March 30 2028 = %s; want April 30", c.Date().Format(time.DateOnly))
+	}
+}
+
+func TestCalendar_Bounds(t *testing.T) {
+	c := newTestCalendar(date(2026, time.March, 10))
+	c.SetMinDate(date(2026, time.March, 9))
+	c.SetMaxDate(date(2026, time.March, 20))
+	c.SetDisabled(func(t time.Time) bool { return t.Weekday() == time.Saturday })
+
+	c.handleKey(newKey(tcell.KeyUp))
+	if !c.Date().Equal(date(2026, time.March, 10)) {
+		t.Error("moving before the minimum date should be ignored")
+	}
+	c.handleKey(newKey(tcell.KeyLeft))
+	c.handleKey(newKey(tcell.KeyLeft))
+	if !c.Date().Equal(date(2026, time.March, 9)) {
+		t.Errorf("date = %s; want the minimum March 9", c.Date().Format(time.DateOnly))
+	}
+	c.SetDate(date(2026, time.March, 13))
+	c.handleKey(newKey(tcell.KeyRight))
+	if !c.Date().Equal(date(2026, time.March, 15)) {
+		t.Errorf("date = %s; want the disabled Saturday skipped", c.Date().Format(time.DateOnly))
+	}
+	if c.Select(date(2026, time.March, 21)) || c.Enabled(date(2026, time.March, 21)) {
+		t.Error("dates after the maximum should not be selectable")
+	}
+}
+
+func TestCalendar_SkipWeekend(t *testing.T) {
+	c := newTestCalendar(date(2026, time.March, 13)) // a Friday
+	c.SetDisabled(func(t time.Time) bool {
+		return t.Weekday() == time.Saturday || t.Weekday() == time.Sunday
+	})
+
+	c.handleKey(newKey(tcell.KeyRight))
+	if !c.Date().Equal(date(2026, time.March, 16)) {
+		t.Errorf("right from Friday = %s; want Monday March 16", c.Date().Format(time.DateOnly))
+	}
+	c.handleKey(newKey(tcell.KeyLeft))
+	if !c.Date().Equal(date(2026, time.March, 13)) {
+		t.Errorf("left from Monday = %s; want Friday March 13", c.Date().Format(time.DateOnly))
+	}
+
+	c.SetMaxDate(date(2026, time.March, 14))
+	c.handleKey(newKey(tcell.KeyRight))
+	if !c.Date().Equal(date(2026, time.March, 13)) {
+		t.Errorf("right before the maximum = %s; want Friday March 13 kept", c.Date().Format(time.DateOnly))
+	}
+
+	c.SetDate(date(2026, time.March, 16))
+	c.SetMinDate(date(2026, time.March, 14))
+	c.handleKey(newKey(tcell.KeyLeft))
+	if !c.Date().Equal(date(2026, time.March, 16)) {
+		t.Errorf("left after the minimum = %s; want Monday March 16 kept", c.Date().Format(time.DateOnly))
+	}
+}
+
+func TestCalendar_Activate(t *testing.T) {
+	c := newTestCalendar(date(2026, time.March, 10))
+	var got []any
+	c.On(EvtActivate, func(_ Widget, _ Event, data ...any) bool {
+		got = data
+		return true
+	})
+	c.handleKey(newKey(tcell.KeyRight))
+	c.handleKey(newKey(tcell.KeyEnter))
+	if len(got) != 1 || !got[0].(time.Time).Equal(date(2026, time.March, 11)) {
+		t.Errorf("EvtActivate data = %v; want March 11", got)
+	}
+
+	// Escape is left to the parent unless an EvtCancel handler takes it.
+	if c.handleKey(newKey(tcell.KeyEscape)) {
+		t.Error("Esc without an EvtCancel handler should not be consumed")
+	}
+	var cancelled, changed bool
+	c.On(EvtCancel, func(_ Widget, _ Event, _ ...any) bool {
+		cancelled = true
+		return true
+	})
+	c.On(EvtChange, func(_ Widget, _ Event, _ ...any) bool {
+		changed = true
+		return true
+	})
+	if !c.handleKey(newKey(tcell.KeyEscape)) || !cancelled || changed {
+		t.Errorf("Esc: cancelled = %t, changed = %t; want only EvtCancel", cancelled, changed)
+	}
+}
+
+func TestCalendar_Range(t *testing.T) {
+	c := newTestCalendar(date(2026, time.March, 20))
+	c.SetRangeMode(true)
+	var got []any
+	c.On(EvtActivate, func(_ Widget, _ Event, data ...any) bool {
+		got = data
+		return true
+	})
+
+	c.handleKey(newKey(tcell.KeyEnter))
+	c.handleKey(newKey(tcell.KeyUp))
+	if got != nil {
+		t.Fatal("the first date of a range should not activate")
+	}
+	if !c.inRange(date(2026, time.March, 15)) || c.inRange(date(2026, time.March, 21)) {
+		t.Error("the days between the anchor and the cursor should be in range")
+	}
+	c.handleKey(newKey(tcell.KeyEnter))
+	if len(got) != 2 || !got[0].(time.Time).Equal(date(2026, time.March, 13)) || !got[1].(time.Time).Equal(date(2026, time.March, 20)) {
+		t.Errorf("EvtActivate data = %v; want March 13 and March 20", got)
+	}
+	if start, end := c.Range(); !start.Equal(date(2026, time.March, 13)) || !end.Equal(date(2026, time.March, 20)) {
+		t.Errorf("Range() = %s, %s", start.Format(time.DateOnly), end.Format(time.DateOnly))
+	}
+}
+
+func TestCalendar_Render(t *testing.T) {
+	cs := NewTestScreen()
+	c := newTestCalendar(date(2026, time.March, 10))
+	c.Render(NewRenderer(cs, NewTheme()))
+
+	if got := screenRow(cs, 0, 0, 20); got != "◀    March 2026    ▶" {
+		t.Errorf("title = %q", got)
+	}
+	if got := screenRow(cs, 1, 0, 20); got != "Mo Tu We Th Fr Sa Su" {
+		t.Errorf("weekdays = %q", got)
+	}
+	if got := screenRow(cs, 2, 0, 20); got != "                   1" {
+		t.Errorf("first week = %q; want March 1 in the Sunday column", got)
+	}
+	if got := screenRow(cs, 7, 0, 20); got != "30 31               " {
+		t.Errorf("last week = %q; want the overflow cells blank", got)
+	}
+
+	// Locale names and Sunday-first weeks
+	cs = NewTestScreen()
+	c.SetLocale("de_DE.UTF-8")
+	c.SetWeekStart(time.Sunday)
+	c.Render(NewRenderer(cs, NewTheme()))
+	if got := screenRow(cs, 0, 0, 20); !strings.Contains(got, "März 2026") {
+		t.Errorf("title = %q; want the German month", got)
+	}
+	if got := screenRow(cs, 1, 0, 20); got != "So Mo Di Mi Do Fr Sa" {
+		t.Errorf("weekdays = %q", got)
+	}
+}
+
+func TestCalendar_Mouse(t *testing.T) {
+	c := newTestCalendar(date(2026, time.March, 10))
+	activated := false
+	c.On(EvtActivate, func(_ Widget, _ Event, _ ...any) bool {
+		activated = true
+		return true
+	})
+
+	// March 18 is a Wednesday in the fourth week.
+	c.handleMouse(mouse(7, 5, tcell.Button1))
+	c.handleMouse(mouse(7, 5, tcell.ButtonNone))
+	if !c.Date().Equal(date(2026, time.March, 18)) {
+		t.Errorf("click = %s; want March 18", c.Date().Format(time.DateOnly))
+	}
+	c.handleMouse(mouse(7, 5, tcell.Button1))
+	if !activated {
+		t.Error("double click should activate")
+	}
+	c.handleMouse(mouse(19, 0, tcell.ButtonNone))
+	c.handleMouse(mouse(19, 0, tcell.Button1))
+	if c.Viewing().Month() != time.April || c.Date().Month() != time.March {
+		t.Error("click on the next arrow should show April without moving the cursor")
+	}
+}
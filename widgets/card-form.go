@@ -46,7 +46,6 @@ func (f *CardForm) Validate(field string) error { return nil }
 
 func (f *CardForm) Emit(w io.Writer, mode string) error {
 	return f.EmitFrame(w, mode, func() error {
-		_, err := fmt.Fprintf(w, "Card(%q, %q).\n", f.ID, f.Title)
-		return err
+		return f.EmitConstructor(w, mode, "Card", fmt.Sprintf("%q", f.Title))
 	})
 }
@@ -328,3 +328,17 @@ func (b *CellBuffer) copyRow(dy, sy int) {
 	copy(b.bg[dy*b.w:dy*b.w+b.w], b.bg[sy*b.w:sy*b.w+b.w])
 	copy(b.ul[dy*b.w:dy*b.w+b.w], b.ul[sy*b.w:sy*b.w+b.w])
 }
+
+// saveRow copies row y into line, reusing its slices if they are large
+// enough, and returns it. Used to move rows into the terminal scrollback.
+func (b *CellBuffer) saveRow(y int, line termLine) termLine {
+	if y < 0 || y >= b.h {
+		return line
+	}
+	from, to := y*b.w, y*b.w+b.w
+	line.char = append(line.char[:0], b.char[from:to]...)
+	line.fg = append(line.fg[:0], b.fg[from:to]...)
+	line.bg = append(line.bg[:0], b.bg[from:to]...)
+	line.ul = append(line.ul[:0], b.ul[from:to]...)
+	return line
+}
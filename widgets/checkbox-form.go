@@ -56,11 +56,11 @@ func (f *CheckboxForm) Validate(field string) error { return nil }
 // chained Builder setter for it.
 func (f *CheckboxForm) Emit(w io.Writer, mode string) error {
 	return f.EmitFrame(w, mode, func() error {
-		if _, err := fmt.Fprintf(w, "Checkbox(%q, %q, %t).\n", f.ID, f.Text, f.Checked); err != nil {
+		if err := f.EmitConstructor(w, mode, "Checkbox", fmt.Sprintf("%q, %t", f.Text, f.Checked)); err != nil {
 			return err
 		}
 		if f.Readonly {
-			if _, err := fmt.Fprintf(w, "Flag(FlagReadonly, true).\n"); err != nil {
+			if err := f.EmitFlag(w, mode, "FlagReadonly"); err != nil {
 				return err
 			}
 		}
@@ -62,6 +62,11 @@ func (c *Checkbox) Refresh() {
 
 // ---- Setter ---------------------------------------------------------------
 
+// Get returns whether the checkbox is checked.
+func (c *Checkbox) Get() bool {
+	return c.Flag(FlagChecked)
+}
+
 // Set set's the checkbox value in a generic way.
 func (c *Checkbox) Set(value bool) {
 	c.SetFlag(FlagChecked, value)
@@ -12,4 +12,5 @@ var (
 	_ core.Widget = (*BarChart)(nil)
 	_ core.Widget = (*Button)(nil)
 	_ core.Widget = (*Component)(nil)
+	_ core.Widget = (*LineChart)(nil)
 )
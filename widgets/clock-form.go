@@ -78,15 +78,15 @@ func (f *ClockForm) Emit(w io.Writer, mode string) error {
 		if err != nil || d <= 0 {
 			d = time.Second
 		}
-		args := fmt.Sprintf("%q, %s", f.ID, durationLiteral(d))
-		if f.Format != "" || f.Prefix != "" {
-			args += fmt.Sprintf(", %q", f.Format)
+		args := []string{durationLiteral(d)}
+		// Compose takes format and prefix as fixed parameters.
+		if f.Format != "" || f.Prefix != "" || mode == "compose" {
+			args = append(args, fmt.Sprintf("%q", f.Format))
 		}
-		if f.Prefix != "" {
-			args += fmt.Sprintf(", %q", f.Prefix)
+		if f.Prefix != "" || mode == "compose" {
+			args = append(args, fmt.Sprintf("%q", f.Prefix))
 		}
-		_, err = fmt.Fprintf(w, "Clock(%s).\n", args)
-		return err
+		return f.EmitConstructor(w, mode, "Clock", args...)
 	})
 }
 
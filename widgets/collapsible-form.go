@@ -45,7 +45,6 @@ func (f *CollapsibleForm) Validate(field string) error { return nil }
 
 func (f *CollapsibleForm) Emit(w io.Writer, mode string) error {
 	return f.EmitFrame(w, mode, func() error {
-		_, err := fmt.Fprintf(w, "Collapsible(%q, %q, %t).\n", f.ID, f.Title, f.Expanded)
-		return err
+		return f.EmitConstructor(w, mode, "Collapsible", fmt.Sprintf("%q, %t", f.Title, f.Expanded))
 	})
 }
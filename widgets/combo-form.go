@@ -1,7 +1,6 @@
 package widgets
 
 import (
-	"fmt"
 	"io"
 	"strings"
 
@@ -49,21 +48,14 @@ func (f *ComboForm) Validate(field string) error { return nil }
 func (f *ComboForm) Emit(w io.Writer, mode string) error {
 	if err := f.EmitFrame(w, mode, func() error {
 		items := parseItems(f.ItemsRaw)
-		args := fmt.Sprintf("%q", f.ID)
-		if len(items) > 0 {
-			quoted := make([]string, len(items))
-			for i, it := range items {
-				quoted[i] = fmt.Sprintf("%q", it)
-			}
-			args = fmt.Sprintf("%q, %s", f.ID, strings.Join(quoted, ", "))
-		}
-		_, err := fmt.Fprintf(w, "Combo(%s).\n", args)
-		return err
+		return f.EmitConstructor(w, mode, "Combo", stringArgs(mode, items)...)
 	}); err != nil {
 		return err
 	}
 	if f.Value != "" {
-		fmt.Fprintf(w, "// TODO: Set(%q) — no Builder setter\n", f.Value)
+		if err := f.EmitTODO(w, mode, "Set(%q)", f.Value); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -3,6 +3,7 @@ package widgets
 import (
 	"fmt"
 	"io"
+	"strings"
 
 	. "github.com/tekugo/zeichenwerk/core"
 )
@@ -32,6 +33,11 @@ type ComponentForm struct {
 	Hidden   bool `group:"flags" label:"Hidden"`
 	Disabled bool `group:"flags" label:"Disabled"`
 
+	// variant is set for the variants of a Responsive, which shows and
+	// hides them itself. Their Hidden flag is neither loaded, stored
+	// nor emitted.
+	variant bool
+
 	// Codegen-only snapshot of the widget's default *Style. No
 	// struct tag → invisible to BuildFormGroup. Populated by Load
 	// and written back by Store (through Style.Modifiable, so the
@@ -48,8 +54,9 @@ func (f *ComponentForm) Load(c *Component) {
 	f.HintW = c.hwidth
 	f.HintH = c.hheight
 
+	_, f.variant = c.parent.(*Responsive)
 	f.Skip = c.Flag(FlagSkip)
-	f.Hidden = c.Flag(FlagHidden)
+	f.Hidden = !f.variant && c.Flag(FlagHidden)
 	f.Disabled = c.Flag(FlagDisabled)
 
 	f.style.Load(c.Style())
@@ -66,7 +73,9 @@ func (f *ComponentForm) Store(c *Component) {
 	c.hheight = f.HintH
 
 	c.SetFlag(FlagSkip, f.Skip)
-	c.SetFlag(FlagHidden, f.Hidden)
+	if !f.variant {
+		c.SetFlag(FlagHidden, f.Hidden)
+	}
 	c.SetFlag(FlagDisabled, f.Disabled)
 
 	c.SetStyle("", f.style.Store(c.Style()))
@@ -86,14 +95,12 @@ func (f *ComponentForm) Style() *StyleForm {
 // CheckBuilderMode validates that mode is a supported codegen mode.
 // Per-form Emit implementations either call this directly or via
 // EmitFrame, which calls it before invoking the constructor body.
-// Today only ModeBuilder is supported; ModeCompose is reserved and
-// returns an error.
+// Both ModeBuilder ("builder") and ModeCompose ("compose") are
+// supported.
 func (f *ComponentForm) CheckBuilderMode(mode string) error {
 	switch mode {
-	case "builder":
+	case "builder", "compose":
 		return nil
-	case "compose":
-		return fmt.Errorf("compose mode not implemented")
 	}
 	return fmt.Errorf("unknown mode %q", mode)
 }
@@ -139,12 +146,76 @@ func (f *ComponentForm) EmitStyle(w io.Writer) {
 	f.style.EmitBuilderChain(w)
 }
 
+// EmitOptions is the compose-mode counterpart of EmitChain: it
+// writes the Hint and Flag options that follow a widget's
+// constructor arguments. Each option ends with ",\n" so the next
+// option or child follows directly.
+func (f *ComponentForm) EmitOptions(w io.Writer) error {
+	if f.HintW != 0 || f.HintH != 0 {
+		if _, err := fmt.Fprintf(w, "Hint(%d, %d),\n", f.HintW, f.HintH); err != nil {
+			return err
+		}
+	}
+	if f.Skip {
+		if err := f.EmitFlag(w, "compose", "FlagSkip"); err != nil {
+			return err
+		}
+	}
+	if f.Hidden {
+		if err := f.EmitFlag(w, "compose", "FlagHidden"); err != nil {
+			return err
+		}
+	}
+	if f.Disabled {
+		return f.EmitFlag(w, "compose", "FlagDisabled")
+	}
+	return nil
+}
+
+// EmitStyleOptions is the compose-mode counterpart of EmitStyle,
+// writing the Padding/Margin/Border/Fg/Bg/Font options of a
+// non-themed style.
+func (f *ComponentForm) EmitStyleOptions(w io.Writer) error {
+	return f.style.EmitComposeOptions(w)
+}
+
+// EmitFlag writes a call setting a core flag: a chained
+// "Flag(FlagX, true).\n" in builder mode, a "Flag(core.FlagX),\n"
+// option in compose mode.
+func (f *ComponentForm) EmitFlag(w io.Writer, mode, flag string) error {
+	var err error
+	if mode == "compose" {
+		_, err = fmt.Fprintf(w, "Flag(core.%s),\n", flag)
+	} else {
+		_, err = fmt.Fprintf(w, "Flag(%s, true).\n", flag)
+	}
+	return err
+}
+
+// EmitTODO writes a "// TODO: call — reason" comment for a setting
+// the codegen mode has no call for. The reason names the missing
+// Builder setter or compose option, depending on mode.
+func (f *ComponentForm) EmitTODO(w io.Writer, mode, format string, args ...any) error {
+	reason := "no Builder setter"
+	if mode == "compose" {
+		reason = "no compose option"
+	}
+	_, err := fmt.Fprintf(w, "// TODO: %s — %s\n", fmt.Sprintf(format, args...), reason)
+	return err
+}
+
 // EmitFrame is the template method per-widget forms call to wrap
 // their constructor with the standard prefix-and-chain machinery.
 // body writes the kind-specific constructor — typically a single
 // ".\nKind(args...)" formatted line — and EmitFrame surrounds it
 // with EmitClassPrefix on entry and EmitChain + EmitStyle on exit.
 //
+// In compose mode there is no class prefix, since the class is a
+// constructor argument. body writes the opening "Kind(id, class,
+// args...,\n" of the constructor call, and EmitFrame follows it with
+// EmitOptions + EmitStyleOptions. The codegen walker appends the
+// children and closes the call.
+//
 // Forms that need a kind-specific tail (Grid emits .Rows / .Columns
 // after the standard chain) call EmitFrame for the standard part
 // and append the tail manually after EmitFrame returns. Forms that
@@ -155,6 +226,15 @@ func (f *ComponentForm) EmitFrame(w io.Writer, mode string, body func() error) e
 	if err := f.CheckBuilderMode(mode); err != nil {
 		return err
 	}
+	if mode == "compose" {
+		if err := body(); err != nil {
+			return err
+		}
+		if err := f.EmitOptions(w); err != nil {
+			return err
+		}
+		return f.EmitStyleOptions(w)
+	}
 	f.EmitClassPrefix(w)
 	if err := body(); err != nil {
 		return err
@@ -163,3 +243,50 @@ func (f *ComponentForm) EmitFrame(w io.Writer, mode string, body func() error) e
 	f.EmitStyle(w)
 	return nil
 }
+
+// EmitConstructor writes the constructor call of a widget for the
+// codegen mode. args are the Go expressions following the ID. In
+// builder mode the call is complete and chained:
+// "Kind(id, args...).\n". In compose mode the class follows the ID
+// and the call is left open for options and children:
+// "Kind(id, class, args...,\n".
+func (f *ComponentForm) EmitConstructor(w io.Writer, mode, kind string, args ...string) error {
+	var err error
+	if mode == "compose" {
+		args = append([]string{fmt.Sprintf("%q, %q", f.ID, f.Class)}, args...)
+		_, err = fmt.Fprintf(w, "%s(%s,\n", kind, strings.Join(args, ", "))
+	} else {
+		args = append([]string{fmt.Sprintf("%q", f.ID)}, args...)
+		_, err = fmt.Fprintf(w, "%s(%s).\n", kind, strings.Join(args, ", "))
+	}
+	return err
+}
+
+// coreIdent qualifies an identifier of the core package for the
+// codegen mode. Builder-mode files dot-import core; compose-mode
+// files import it by name, as its Flag type would clash with the
+// compose Flag option.
+func coreIdent(mode, name string) string {
+	if mode == "compose" {
+		return "core." + name
+	}
+	return name
+}
+
+// stringArgs formats a list of strings as constructor arguments:
+// variadic quoted strings in builder mode, a []string literal in
+// compose mode. An empty list produces no builder arguments and a
+// nil slice in compose mode.
+func stringArgs(mode string, items []string) []string {
+	quoted := make([]string, len(items))
+	for i, it := range items {
+		quoted[i] = fmt.Sprintf("%q", it)
+	}
+	if mode != "compose" {
+		return quoted
+	}
+	if len(items) == 0 {
+		return []string{"nil"}
+	}
+	return []string{"[]string{" + strings.Join(quoted, ", ") + "}"}
+}
@@ -351,7 +351,8 @@ func (c *CRT) Start(interval time.Duration) {
 }
 
 // PowerOff begins the power-off animation at the given tick interval. When
-// the animation completes, onDone is called (typically ui.Quit). If the
+// the animation completes, onDone is called (typically ui.Quit), on the
+// event loop if the CRT is part of a UI. If the
 // power-on animation is still running, PowerOff interrupts it and begins
 // contracting from the current position. Calling PowerOff a second time
 // while a power-off animation is already running is a no-op.
@@ -391,7 +392,12 @@ func (c *CRT) PowerOff(interval time.Duration, onDone func()) {
 
 	go func() {
 		<-savedDone
-		if onDone != nil {
+		if onDone == nil {
+			return
+		}
+		if root := FindRoot(c); root != nil {
+			root.Post(onDone)
+		} else {
 			onDone()
 		}
 	}()
@@ -1,8 +1,8 @@
 package widgets
 
-// DataProvider is the data source interface for Sparkline.
+// DataProvider is the data source interface for Sparkline and LineChart.
 // Get(0) returns the most recent (rightmost) value; Get(Size()-1) returns
-// the oldest. RingBuffer[float64] and TimeSeries[T] satisfy this interface.
+// the oldest. RingBuffer[float64] and TimeSeries[float64] satisfy this interface.
 type DataProvider interface {
 	Size() int
 	Get(index int) float64
@@ -0,0 +1,358 @@
+package widgets
+
+import (
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"github.com/gdamore/tcell/v3"
+	. "github.com/tekugo/zeichenwerk/core"
+)
+
+// DatePicker is an input for a date. It shows the formatted date and
+// opens a Calendar in a popup below it on Enter or a click, like Select
+// does for its options. With SetShowTime a TimeSpinner below the calendar
+// adds the time of day; in range mode the picker holds a range of dates.
+//
+// The calendar settings — bounds, disabled dates, week start, names and
+// range mode — are forwarded to the calendar of the popup.
+type DatePicker struct {
+	Component
+	date        time.Time // selected date; zero if none
+	end         time.Time // last date of the range in range mode
+	format      string    // layout of the date; "" = default of the mode
+	placeholder string    // text shown without a date
+	showTime    bool      // add a time spinner to the popup
+	ranged      bool      // range selection mode
+	weekStart   time.Weekday
+	minDate     time.Time
+	maxDate     time.Time
+	disabled    func(time.Time) bool
+	names       CalendarNames
+}
+
+// NewDatePicker creates a date picker without a date. Weeks start on
+// Monday and the names are English.
+func NewDatePicker(id, class string) *DatePicker {
+	d := &DatePicker{
+		Component: Component{id: id, class: class},
+		weekStart: time.Monday,
+		names:     CalendarLocales["en"],
+	}
+	d.SetFlag(FlagFocusable, true)
+	OnKey(d, d.handleKey)
+	OnMouse(d, d.handleMouse)
+	return d
+}
+
+// ---- Widget Methods -------------------------------------------------------
+
+// Apply applies the date picker styles.
+func (d *DatePicker) Apply(theme *Theme) {
+	theme.Apply(d, d.Selector("date-picker"), "disabled", "focused", "hovered")
+	theme.Apply(d, d.Selector("date-picker/placeholder"))
+}
+
+// Hint returns the width of a formatted date plus the dropdown indicator
+// and a height of 1.
+func (d *DatePicker) Hint() (int, int) {
+	if d.hwidth != 0 || d.hheight != 0 {
+		return d.hwidth, d.hheight
+	}
+	sample := time.Date(2006, time.September, 30, 23, 59, 0, 0, time.UTC)
+	w := utf8.RuneCountInString(sample.Format(d.Format()))
+	if d.ranged {
+		w = 2*w + 3
+	}
+	return max(w, utf8.RuneCountInString(d.placeholder)) + 2, 1
+}
+
+// Render draws the date, or the placeholder, and the dropdown indicator.
+func (d *DatePicker) Render(r *Renderer) {
+	if d.Flag(FlagHidden) {
+		return
+	}
+	d.Component.Render(r)
+
+	dropdown := r.Theme.String("date-picker.dropdown")
+	dw := utf8.RuneCountInString(dropdown)
+	cx, cy, cw, _ := d.Content()
+	state := d.State()
+	if state != "" {
+		state = ":" + state
+	}
+	if text := d.Text(); text == "" && d.placeholder != "" {
+		style := d.Style("placeholder" + state)
+		r.Set(style.Foreground(), style.Background(), style.Font())
+		r.Text(cx, cy, d.placeholder, cw-dw)
+	} else {
+		r.Text(cx, cy, text, cw-dw)
+	}
+	style := d.Style(state)
+	r.Set(style.Foreground(), style.Background(), style.Font())
+	r.Text(cx+cw-dw, cy, dropdown, dw)
+}
+
+// Summary returns the date for Dump output.
+func (d *DatePicker) Summary() string {
+	return fmt.Sprintf("date=%q", d.Text())
+}
+
+// ---- Getters and Setters --------------------------------------------------
+
+// Date returns the selected date, or the zero time if there is none.
+func (d *DatePicker) Date() time.Time {
+	return d.date
+}
+
+// SetDate sets the date; the zero time clears it. Without SetShowTime the
+// time of day is dropped. No events are dispatched.
+func (d *DatePicker) SetDate(t time.Time) {
+	if !d.showTime && !t.IsZero() {
+		t = calendarDay(t)
+	}
+	d.date = t
+	d.Refresh()
+}
+
+// Range returns the first and the last date in range mode.
+func (d *DatePicker) Range() (time.Time, time.Time) {
+	return d.date, d.end
+}
+
+// SetRange sets the range of dates. No events are dispatched.
+func (d *DatePicker) SetRange(start, end time.Time) {
+	if !start.IsZero() && !end.IsZero() && end.Before(start) {
+		start, end = end, start
+	}
+	d.date, d.end = start, end
+	d.Refresh()
+}
+
+// Text returns the formatted date, "start – end" in range mode, or "" if
+// there is no date.
+func (d *DatePicker) Text() string {
+	text := calendarFormat(d.date, d.Format())
+	if d.ranged && text != "" {
+		text += " – " + calendarFormat(d.end, d.Format())
+	}
+	return text
+}
+
+// Format returns the layout of the date. The default is "2006-01-02", or
+// "2006-01-02 15:04" if the time is shown.
+func (d *DatePicker) Format() string {
+	switch {
+	case d.format != "":
+		return d.format
+	case d.showTime:
+		return "2006-01-02 15:04"
+	}
+	return time.DateOnly
+}
+
+// SetFormat sets the layout of the date, see time.Time.Format. The empty
+// string restores the default.
+func (d *DatePicker) SetFormat(layout string) {
+	d.format = layout
+	Relayout(d)
+}
+
+// SetPlaceholder sets the text shown without a date.
+func (d *DatePicker) SetPlaceholder(text string) {
+	d.placeholder = text
+	Relayout(d)
+}
+
+// SetShowTime adds a time spinner to the popup and keeps the time of day
+// of the date. Range mode ignores it.
+func (d *DatePicker) SetShowTime(on bool) {
+	d.showTime = on
+	Relayout(d)
+}
+
+// SetRangeMode switches range selection on or off and clears the date.
+func (d *DatePicker) SetRangeMode(on bool) {
+	d.ranged = on
+	d.date, d.end = time.Time{}, time.Time{}
+	Relayout(d)
+}
+
+// SetWeekStart sets the first weekday of the calendar.
+func (d *DatePicker) SetWeekStart(day time.Weekday) {
+	d.weekStart = day % 7
+}
+
+// SetMinDate sets the first selectable date; the zero time removes the
+// bound.
+func (d *DatePicker) SetMinDate(t time.Time) {
+	d.minDate = t
+}
+
+// SetMaxDate sets the last selectable date; the zero time removes the
+// bound.
+func (d *DatePicker) SetMaxDate(t time.Time) {
+	d.maxDate = t
+}
+
+// SetDisabled sets a function that disables single dates of the calendar.
+func (d *DatePicker) SetDisabled(fn func(time.Time) bool) {
+	d.disabled = fn
+}
+
+// SetNames sets the month and weekday names of the calendar.
+func (d *DatePicker) SetNames(names CalendarNames) {
+	d.names = names
+}
+
+// SetLocale sets the calendar names of a locale from CalendarLocales and
+// reports whether it exists.
+func (d *DatePicker) SetLocale(tag string) bool {
+	c := Calendar{names: d.names}
+	ok := c.SetLocale(tag)
+	d.names = c.names
+	return ok
+}
+
+// ---- Internal Helpers -----------------------------------------------------
+
+// set stores the confirmed date and dispatches EvtChange with it.
+func (d *DatePicker) set(t time.Time) {
+	d.date = t
+	d.Refresh()
+	d.Dispatch(d, EvtChange, t)
+}
+
+// handleKey opens the popup on Enter and clears the date on Delete.
+func (d *DatePicker) handleKey(evt *tcell.EventKey) bool {
+	if d.Flag(FlagDisabled) || d.Flag(FlagReadonly) {
+		return false
+	}
+	switch evt.Key() {
+	case tcell.KeyEnter:
+		d.popup()
+		return true
+	case tcell.KeyDelete:
+		if d.date.IsZero() {
+			return false
+		}
+		d.end = time.Time{}
+		if d.ranged {
+			d.date = time.Time{}
+			d.Refresh()
+			d.Dispatch(d, EvtChange, time.Time{}, time.Time{})
+		} else {
+			d.set(time.Time{})
+		}
+		return true
+	}
+	return false
+}
+
+// handleMouse opens the popup on a click.
+func (d *DatePicker) handleMouse(event *tcell.EventMouse) bool {
+	if event.Buttons() != tcell.Button1 || d.Flag(FlagDisabled) || d.Flag(FlagReadonly) {
+		return false
+	}
+	d.popup()
+	return true
+}
+
+// popup shows the calendar, and the time spinner, below the picker or
+// above it if there is not enough room.
+func (d *DatePicker) popup() {
+	root := FindRoot(d)
+	if root == nil {
+		return
+	}
+
+	theme := root.Theme()
+	popup := NewBox("date-picker-popup", "popup", "")
+	popup.Apply(theme)
+	flex := NewFlex("date-picker-popup-content", "popup", Center, 1)
+	flex.SetFlag(FlagVertical, true)
+	flex.Apply(theme)
+	popup.Add(flex)
+
+	calendar := NewCalendar("date-picker-calendar", "popup")
+	calendar.Apply(theme)
+	calendar.SetWeekStart(d.weekStart)
+	calendar.SetMinDate(d.minDate)
+	calendar.SetMaxDate(d.maxDate)
+	calendar.SetDisabled(d.disabled)
+	calendar.SetNames(d.names)
+	calendar.SetRangeMode(d.ranged)
+	switch {
+	case d.ranged && !d.date.IsZero() && !d.end.IsZero():
+		calendar.SetRange(d.date, d.end)
+	case !d.date.IsZero():
+		calendar.SetDate(d.date)
+	}
+	flex.Add(calendar)
+
+	var spinner *TimeSpinner
+	if d.showTime && !d.ranged {
+		spinner = NewTimeSpinner("date-picker-time", "popup")
+		spinner.Apply(theme)
+		if !d.date.IsZero() {
+			spinner.SetTime(d.date.Hour(), d.date.Minute())
+		}
+		flex.Add(spinner)
+	}
+
+	// confirm takes the date of the calendar and the time of the spinner.
+	confirm := func(day time.Time) {
+		if spinner != nil {
+			hour, minute := spinner.Time()
+			day = time.Date(day.Year(), day.Month(), day.Day(), hour, minute, 0, 0, day.Location())
+		}
+		root.Close()
+		root.Focus(d)
+		d.set(day)
+	}
+
+	calendar.On(EvtActivate, func(_ Widget, _ Event, data ...any) bool {
+		if len(data) == 2 {
+			d.date, d.end = data[0].(time.Time), data[1].(time.Time)
+			root.Close()
+			root.Focus(d)
+			d.Refresh()
+			d.Dispatch(d, EvtChange, d.date, d.end)
+			return true
+		}
+		confirm(data[0].(time.Time))
+		return true
+	})
+
+	calendar.On(EvtCancel, func(_ Widget, _ Event, _ ...any) bool {
+		root.Close()
+		root.Focus(d)
+		return true
+	})
+
+	if spinner != nil {
+		OnKey(spinner, func(evt *tcell.EventKey) bool {
+			switch evt.Key() {
+			case tcell.KeyEnter:
+				confirm(calendar.Date())
+				return true
+			case tcell.KeyEsc:
+				root.Close()
+				root.Focus(d)
+				return true
+			}
+			return false
+		})
+	}
+
+	pw, ph := popup.Hint()
+	style := popup.Style()
+	pw += style.Horizontal()
+	ph += style.Vertical()
+	_, _, _, uiHeight := root.Bounds()
+	py := d.y + d.height
+	if py+ph > uiHeight {
+		py = d.y - ph
+	}
+	root.Popup(d.x, py, max(pw, d.width), ph, popup)
+}
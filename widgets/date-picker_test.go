@@ -0,0 +1,97 @@
+package widgets
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gdamore/tcell/v3"
+	. "github.com/tekugo/zeichenwerk/core"
+)
+
+func TestDatePicker_Text(t *testing.T) {
+	d := NewDatePicker("d", "")
+	if d.Text() != "" {
+		t.Errorf("Text() without a date = %q; want empty", d.Text())
+	}
+	d.SetDate(time.Date(2026, time.March, 10, 14, 30, 0, 0, time.Local))
+	if got := d.Text(); got != "2026-03-10" {
+		t.Errorf("Text() = %q; want the date only", got)
+	}
+	if w, h := d.Hint(); w != 12 || h != 1 {
+		t.Errorf("Hint() = %d, %d; want 12, 1", w, h)
+	}
+
+	d.SetShowTime(true)
+	d.SetDate(time.Date(2026, time.March, 10, 14, 30, 0, 0, time.Local))
+	if got := d.Text(); got != "2026-03-10 14:30" {
+		t.Errorf("Text() with time = %q", got)
+	}
+	d.SetFormat("02.01.2006")
+	if got := d.Text(); got != "10.03.2026" {
+		t.Errorf("Text() with format = %q", got)
+	}
+
+	d.SetRangeMode(true)
+	d.SetRange(date(2026, time.March, 20), date(2026, time.March, 13))
+	if got := d.Text(); got != "13.03.2026 – 20.03.2026" {
+		t.Errorf("Text() in range mode = %q; want the dates in order", got)
+	}
+}
+
+func TestDatePicker_Delete(t *testing.T) {
+	d := NewDatePicker("d", "")
+	d.SetDate(date(2026, time.March, 10))
+	var got []any
+	d.On(EvtChange, func(_ Widget, _ Event, data ...any) bool {
+		got = data
+		return true
+	})
+
+	d.SetFlag(FlagReadonly, true)
+	if d.handleKey(newKey(tcell.KeyDelete)) || d.Date().IsZero() {
+		t.Error("a readonly picker should keep its date")
+	}
+	d.SetFlag(FlagReadonly, false)
+	d.handleKey(newKey(tcell.KeyDelete))
+	if !d.Date().IsZero() || len(got) != 1 || !got[0].(time.Time).IsZero() {
+		t.Errorf("Delete: date = %s, EvtChange data = %v; want cleared", d.Text(), got)
+	}
+}
+
+func TestDatePicker_Form(t *testing.T) {
+	data := struct {
+		Name  string
+		Due   time.Time
+		Start time.Time `control:"datetime"`
+		Until time.Time `width:"20"`
+	}{
+		Name: "report",
+		Due:  date(2026, time.March, 10),
+	}
+	form := NewForm("form", "", "", &data)
+	group := NewFormGroup("group", "", "", false, 0)
+	BuildFormGroup(form, group, "", NewTheme())
+
+	due, ok := Find(group, "Due").(*DatePicker)
+	if !ok {
+		t.Fatal("time.Time field has no DatePicker")
+	}
+	if due.Text() != "2026-03-10" {
+		t.Errorf("Due = %q; want the field value", due.Text())
+	}
+	if w, _ := due.Hint(); w != 12 {
+		t.Errorf("Due width = %d; want the width of the date", w)
+	}
+	if w, _ := Find(group, "Until").Hint(); w != 20 {
+		t.Errorf("Until width = %d; want the width tag", w)
+	}
+	start, ok := Find(group, "Start").(*DatePicker)
+	if !ok || start.Format() != "2006-01-02 15:04" {
+		t.Error(`control:"datetime" should show the time`)
+	}
+
+	due.set(date(2026, time.April, 1))
+	if !data.Due.Equal(date(2026, time.April, 1)) {
+		t.Errorf("Due = %s after change; want the picked date", data.Due.Format(time.DateOnly))
+	}
+}
@@ -55,7 +55,6 @@ func (f *DeckForm) Emit(w io.Writer, mode string) error {
 		if h < 1 {
 			h = 1
 		}
-		_, err := fmt.Fprintf(w, "Deck(%q, deckRender /* TODO */, %d).\n", f.ID, h)
-		return err
+		return f.EmitConstructor(w, mode, "Deck", fmt.Sprintf("deckRender /* TODO */, %d", h))
 	})
 }
@@ -42,7 +42,6 @@ func (f *DialogForm) Validate(field string) error { return nil }
 
 func (f *DialogForm) Emit(w io.Writer, mode string) error {
 	return f.EmitFrame(w, mode, func() error {
-		_, err := fmt.Fprintf(w, "Dialog(%q, %q).\n", f.ID, f.Title)
-		return err
+		return f.EmitConstructor(w, mode, "Dialog", fmt.Sprintf("%q", f.Title))
 	})
 }
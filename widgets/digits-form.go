@@ -41,7 +41,6 @@ func (f *DigitsForm) Validate(field string) error { return nil }
 
 func (f *DigitsForm) Emit(w io.Writer, mode string) error {
 	return f.EmitFrame(w, mode, func() error {
-		_, err := fmt.Fprintf(w, "Digits(%q, %q).\n", f.ID, f.Text)
-		return err
+		return f.EmitConstructor(w, mode, "Digits", fmt.Sprintf("%q", f.Text))
 	})
 }
@@ -0,0 +1,455 @@
+package widgets
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gdamore/tcell/v3"
+	. "github.com/tekugo/zeichenwerk/core"
+)
+
+// Match is a single search result in an Editor. Positions are rune based,
+// like the cursor position. Matches never span lines.
+type Match struct {
+	Line   int // 0-based line index
+	Column int // 0-based column of the first matched rune
+	Length int // number of matched runes (always > 0)
+}
+
+// FindOptions controls how a search query is interpreted.
+type FindOptions struct {
+	CaseSensitive bool // match letter case exactly
+	Regex         bool // treat the query as a regular expression (RE2 syntax)
+}
+
+// ---- Search ---------------------------------------------------------------
+
+// Find returns all non-overlapping matches of query in the editor content,
+// in document order. It does not change the cursor, the selection or the
+// active search. An empty query or an invalid regular expression yields no
+// matches; use SetSearch to get the compile error.
+func (e *Editor) Find(query string, opts FindOptions) []Match {
+	if query == "" {
+		return nil
+	}
+	re, err := compileSearch(query, opts)
+	if err != nil {
+		return nil
+	}
+	var result []Match
+	for i := range e.content {
+		matches, _ := e.search(i, query, opts, re)
+		result = append(result, matches...)
+	}
+	return result
+}
+
+// FindNext selects the next match of the active search after the cursor,
+// wrapping around at the end of the document. It reports false if the
+// active search has no matches.
+func (e *Editor) FindNext() bool {
+	return e.findFrom(e.line, e.column, true)
+}
+
+// FindPrevious selects the match of the active search before the cursor
+// (or before the start of the selection), wrapping around at the start of
+// the document. It reports false if the active search has no matches.
+func (e *Editor) FindPrevious() bool {
+	line, column := e.line, e.column
+	if startLine, startCol, _, _, ok := e.selectionBounds(); ok {
+		line, column = startLine, startCol
+	}
+	matches := e.Find(e.query, e.options)
+	if len(matches) == 0 {
+		return false
+	}
+	target := matches[len(matches)-1]
+	for i := len(matches) - 1; i >= 0; i-- {
+		m := matches[i]
+		if m.Line < line || (m.Line == line && m.Column < column) {
+			target = m
+			break
+		}
+	}
+	e.selectMatch(target)
+	return true
+}
+
+// Replace replaces the selected text with replacement if the selection is
+// exactly a match of the active search, then selects the next match. In
+// regular expression mode, $1, ${name} etc. in replacement are expanded.
+// If the selection is not a match, Replace only moves to the next match.
+// It reports whether a replacement was made.
+func (e *Editor) Replace(replacement string) bool {
+	if e.disabled || e.query == "" {
+		return false
+	}
+	startLine, startCol, endLine, endCol, ok := e.selectionBounds()
+	if ok && startLine == endLine {
+		matches, subs := e.search(startLine, e.query, e.options, e.pattern)
+		for i, m := range matches {
+			if m.Column != startCol || m.Column+m.Length != endCol {
+				continue
+			}
+			text := replacement
+			if e.options.Regex {
+				text = string(e.pattern.ExpandString(nil, replacement, e.content[startLine].String(), subs[i]))
+			}
+			e.save("", false)
+			e.deleteSelection()
+			e.insertText(text)
+			e.history.done(e.position())
+			e.updateLongestLine()
+			e.adjustViewport()
+			e.Dispatch(e, EvtChange)
+			e.FindNext()
+			return true
+		}
+	}
+	e.FindNext()
+	return false
+}
+
+// ReplaceAll replaces every match of query with replacement as a single
+// undo step and returns the number of replacements. In regular expression
+// mode, $1, ${name} etc. in replacement are expanded. The selection is
+// cleared and the cursor stays on its line.
+func (e *Editor) ReplaceAll(query, replacement string, opts FindOptions) int {
+	if e.disabled || query == "" {
+		return 0
+	}
+	re, err := compileSearch(query, opts)
+	if err != nil {
+		return 0
+	}
+
+	count := 0
+	lines := e.Lines()
+	for i, line := range lines {
+		matches, subs := e.search(i, query, opts, re)
+		if len(matches) == 0 {
+			continue
+		}
+		if count == 0 {
+			e.save("", false)
+		}
+		var b strings.Builder
+		runes := []rune(line)
+		prev := 0
+		for j, m := range matches {
+			b.WriteString(string(runes[prev:m.Column]))
+			if opts.Regex {
+				b.Write(re.ExpandString(nil, replacement, line, subs[j]))
+			} else {
+				b.WriteString(replacement)
+			}
+			prev = m.Column + m.Length
+		}
+		b.WriteString(string(runes[prev:]))
+		lines[i] = b.String()
+		count += len(matches)
+	}
+	if count == 0 {
+		return 0
+	}
+
+	// Replacements may contain newlines, so the content is rebuilt.
+	line := e.line
+	text := strings.Join(lines, "\n")
+	lines = strings.Split(text, "\n")
+	e.content = make([]*GapBuffer, len(lines))
+	for i, l := range lines {
+		e.content[i] = NewGapBufferFromString(l, 32)
+	}
+	e.ClearSelection()
+	e.invalidate(0)
+	e.line = min(line, len(e.content)-1)
+	e.column = min(e.column, e.content[e.line].Length())
+	e.history.done(e.position())
+	e.updateLongestLine()
+	e.adjustViewport()
+	e.Dispatch(e, EvtChange)
+	e.Refresh()
+	return count
+}
+
+// Search returns the active search query and its options.
+func (e *Editor) Search() (string, FindOptions) {
+	return e.query, e.options
+}
+
+// SetSearch sets the active search. All matches of the active search are
+// highlighted with the "editor/match" style and FindNext, FindPrevious and
+// Replace operate on it. An empty query clears the highlighting. If the
+// query is an invalid regular expression, the active search is cleared
+// and an error wrapping ErrInvalidPattern is returned.
+func (e *Editor) SetSearch(query string, opts FindOptions) error {
+	e.query, e.options, e.pattern = "", opts, nil
+	defer e.Refresh()
+	if query == "" {
+		return nil
+	}
+	re, err := compileSearch(query, opts)
+	if err != nil {
+		return err
+	}
+	e.query, e.pattern = query, re
+	return nil
+}
+
+// compileSearch compiles a query into a regular expression. Plain text
+// queries are quoted. For case-sensitive plain text nil is returned, as
+// those are matched with GapBuffer.Find.
+func compileSearch(query string, opts FindOptions) (*regexp.Regexp, error) {
+	if !opts.Regex && opts.CaseSensitive {
+		return nil, nil
+	}
+	if !opts.Regex {
+		query = regexp.QuoteMeta(query)
+	}
+	if !opts.CaseSensitive {
+		query = "(?i)" + query
+	}
+	re, err := regexp.Compile(query)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPattern, err)
+	}
+	return re, nil
+}
+
+// search returns the non-overlapping, non-empty matches of a query in a
+// single line. For regular expressions it also returns the submatch
+// indexes of each match (byte offsets into the line), which are needed to
+// expand replacement templates.
+func (e *Editor) search(line int, query string, opts FindOptions, re *regexp.Regexp) ([]Match, [][]int) {
+	if re == nil {
+		length := utf8.RuneCountInString(query)
+		var result []Match
+		end := 0
+		for _, column := range e.content[line].Find(query) {
+			if column >= end {
+				result = append(result, Match{Line: line, Column: column, Length: length})
+				end = column + length
+			}
+		}
+		return result, nil
+	}
+
+	text := e.content[line].String()
+	var result []Match
+	var subs [][]int
+	for _, loc := range re.FindAllStringSubmatchIndex(text, -1) {
+		if loc[0] == loc[1] {
+			continue
+		}
+		column := utf8.RuneCountInString(text[:loc[0]])
+		result = append(result, Match{
+			Line:   line,
+			Column: column,
+			Length: utf8.RuneCountInString(text[loc[0]:loc[1]]),
+		})
+		subs = append(subs, loc)
+	}
+	return result, subs
+}
+
+// findFrom selects the first match of the active search at or after the
+// given position, wrapping around. If the current selection is that match
+// and skip is set, the following one is selected.
+func (e *Editor) findFrom(line, column int, skip bool) bool {
+	matches := e.Find(e.query, e.options)
+	if len(matches) == 0 {
+		return false
+	}
+	target := matches[0]
+	for _, m := range matches {
+		if m.Line > line || (m.Line == line && m.Column >= column) {
+			if skip && e.isSelected(m) {
+				continue
+			}
+			target = m
+			break
+		}
+	}
+	e.selectMatch(target)
+	return true
+}
+
+// isSelected reports whether the selection covers exactly the match.
+func (e *Editor) isSelected(m Match) bool {
+	startLine, startCol, endLine, endCol, ok := e.selectionBounds()
+	return ok && startLine == m.Line && endLine == m.Line && startCol == m.Column && endCol == m.Column+m.Length
+}
+
+// selectMatch selects a match with the cursor at its end.
+func (e *Editor) selectMatch(m Match) {
+	e.selecting = true
+	e.markLine = m.Line
+	e.markColumn = m.Column
+	e.line = m.Line
+	e.column = m.Column + m.Length
+	e.adjustViewport()
+	e.Refresh()
+}
+
+// ---- Find Bar -------------------------------------------------------------
+
+// OpenFind opens the find bar over the last row of the editor. It is bound
+// to Ctrl+F. Typing searches incrementally from the cursor and highlights
+// all matches; the bar's keys are:
+//
+//   - Enter, Down:  next match
+//   - Up:           previous match
+//   - Tab:          switch between the find and replace fields
+//   - Enter in the replace field: replace the selected match
+//   - Alt+A:        replace all matches
+//   - Alt+C:        toggle case-sensitive matching
+//   - Alt+R:        toggle regular expression mode
+//   - Esc:          close the bar, keeping the last match selected
+//
+// The bar consists of two inputs and a status, styled with the "find"
+// class. No-op if the editor is not part of a UI or the bar is already
+// open.
+func (e *Editor) OpenFind() {
+	root := FindRoot(e)
+	if root == nil || e.finding {
+		return
+	}
+	theme := root.Theme()
+
+	bar := NewFlex("editor-find", "find", Stretch, 1)
+	find := NewInput("editor-find-query", "find", e.recent, "Find")
+	find.SetHint(-2, 1)
+	replace := NewInput("editor-find-replace", "find", "", "Replace")
+	replace.SetHint(-1, 1)
+	if e.disabled {
+		replace.SetFlag(FlagReadonly, true)
+	}
+	status := NewStatic("editor-find-status", "find", "")
+	status.SetHint(12, 1)
+	bar.Add(find)
+	bar.Add(replace)
+	bar.Add(status)
+	bar.Apply(theme)
+	find.Apply(theme)
+	replace.Apply(theme)
+	status.Apply(theme)
+	find.End()
+
+	// Incremental search starts from where the cursor was when the bar
+	// opened, so refining the query never skips a closer match.
+	originLine, originColumn := e.line, e.column
+	if startLine, startCol, _, _, ok := e.selectionBounds(); ok {
+		originLine, originColumn = startLine, startCol
+	}
+
+	update := func() {
+		status.Set(e.findStatus())
+		status.Refresh()
+	}
+	search := func() {
+		e.recent = find.Get()
+		if err := e.SetSearch(e.recent, e.options); err != nil {
+			status.Set("invalid")
+			status.Refresh()
+			return
+		}
+		e.findFrom(originLine, originColumn, false)
+		update()
+	}
+
+	OnChange(find, func(string) bool {
+		search()
+		return false
+	})
+
+	// toggle handles the option keys shared by both fields.
+	toggle := func(evt *tcell.EventKey) bool {
+		if evt.Key() != tcell.KeyRune || evt.Modifiers()&tcell.ModAlt == 0 {
+			return false
+		}
+		switch strings.ToLower(evt.Str()) {
+		case "a":
+			e.ReplaceAll(e.query, replace.Get(), e.options)
+		case "c":
+			e.options.CaseSensitive = !e.options.CaseSensitive
+			search()
+		case "r":
+			e.options.Regex = !e.options.Regex
+			search()
+		default:
+			return false
+		}
+		update()
+		return true
+	}
+
+	OnKey(find, func(evt *tcell.EventKey) bool {
+		switch evt.Key() {
+		case tcell.KeyEnter, tcell.KeyDown:
+			e.FindNext()
+		case tcell.KeyUp:
+			e.FindPrevious()
+		default:
+			return toggle(evt)
+		}
+		update()
+		return true
+	})
+
+	OnKey(replace, func(evt *tcell.EventKey) bool {
+		switch evt.Key() {
+		case tcell.KeyEnter:
+			e.Replace(replace.Get())
+		case tcell.KeyDown:
+			e.FindNext()
+		case tcell.KeyUp:
+			e.FindPrevious()
+		default:
+			return toggle(evt)
+		}
+		update()
+		return true
+	})
+
+	bar.On(EvtClose, func(_ Widget, _ Event, _ ...any) bool {
+		e.finding = false
+		e.SetSearch("", e.options)
+		return false
+	})
+
+	x, y, w, h := e.Bounds()
+	e.finding = true
+	root.Popup(x, y+h-1, w, 1, bar)
+	if e.recent != "" {
+		search()
+	} else {
+		update()
+	}
+}
+
+// findStatus returns the find bar status: the position of the selected
+// match among all matches and the active options.
+func (e *Editor) findStatus() string {
+	var b strings.Builder
+	if e.query != "" {
+		matches := e.Find(e.query, e.options)
+		current := 0
+		for i, m := range matches {
+			if e.isSelected(m) {
+				current = i + 1
+				break
+			}
+		}
+		fmt.Fprintf(&b, "%d/%d", current, len(matches))
+	}
+	if e.options.CaseSensitive {
+		b.WriteString(" Aa")
+	}
+	if e.options.Regex {
+		b.WriteString(" .*")
+	}
+	return strings.TrimSpace(b.String())
+}
@@ -61,25 +61,36 @@ func (f *EditorForm) Validate(field string) error { return nil }
 // defaults emit as TODO comments after the frame.
 func (f *EditorForm) Emit(w io.Writer, mode string) error {
 	if err := f.EmitFrame(w, mode, func() error {
-		_, err := fmt.Fprintf(w, "Editor(%q).\n", f.ID)
-		return err
+		return f.EmitConstructor(w, mode, "Editor")
 	}); err != nil {
 		return err
 	}
 	if f.TabWidth != 0 && f.TabWidth != 4 {
-		fmt.Fprintf(w, "// TODO: SetTabWidth(%d) — no Builder setter\n", f.TabWidth)
+		if err := f.EmitTODO(w, mode, "SetTabWidth(%d)", f.TabWidth); err != nil {
+			return err
+		}
 	}
 	if f.UseSpaces {
-		fmt.Fprintf(w, "// TODO: UseSpaces(true) — no Builder setter\n")
+		if err := f.EmitTODO(w, mode, "UseSpaces(true)"); err != nil {
+			return err
+		}
 	}
-	if f.LineNumbers > 0 {
-		fmt.Fprintf(w, "// TODO: ShowLineNumbers(%d) — no Builder setter\n", f.LineNumbers)
+	if f.LineNumbers > 0 && mode == "compose" {
+		fmt.Fprintf(w, "LineNumbers(true),\n")
+	} else if f.LineNumbers > 0 {
+		if err := f.EmitTODO(w, mode, "ShowLineNumbers(%d)", f.LineNumbers); err != nil {
+			return err
+		}
 	}
 	if !f.AutoIndent {
-		fmt.Fprintf(w, "// TODO: SetAutoIndent(false) — no Builder setter\n")
+		if err := f.EmitTODO(w, mode, "SetAutoIndent(false)"); err != nil {
+			return err
+		}
 	}
 	if f.Readonly {
-		fmt.Fprintf(w, "// TODO: SetReadOnly(true) — no Builder setter\n")
+		if err := f.EmitTODO(w, mode, "SetReadOnly(true)"); err != nil {
+			return err
+		}
 	}
 	return nil
 }
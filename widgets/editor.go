@@ -2,6 +2,7 @@ package widgets
 
 import (
 	"os/exec"
+	"regexp"
 	"runtime"
 	"strconv"
 	"strings"
@@ -36,6 +37,30 @@ type Editor struct {
 	selecting  bool // true when a selection is active
 	markLine   int  // anchor line   (valid only when selecting)
 	markColumn int  // anchor column (valid only when selecting)
+
+	history history[editorState] // undo/redo steps
+
+	// ---- Search State ----
+	query   string         // active search query; matches are highlighted when non-empty
+	options FindOptions    // options of the active search
+	pattern *regexp.Regexp // compiled active query; nil for case-sensitive plain text
+	recent  string         // last query of the find bar, used to prefill it
+	finding bool           // true while the find bar is open
+
+	// ---- Highlighting State ----
+	highlighter Highlighter // syntax highlighter; nil renders plain text
+	states      []int       // cached highlighter state at the start of each line
+}
+
+// editorState is a snapshot of the editor content, cursor and selection,
+// taken before each edit for undo and redo.
+type editorState struct {
+	lines      []string
+	line       int
+	column     int
+	selecting  bool
+	markLine   int
+	markColumn int
 }
 
 // NewEditor creates a new multi-line text editor widget with the specified ID.
@@ -69,6 +94,13 @@ func (e *Editor) Apply(theme *Theme) {
 	theme.Apply(e, e.Selector("editor/line-numbers"))
 	theme.Apply(e, e.Selector("editor/separator"))
 	theme.Apply(e, e.Selector("editor/selection"))
+	theme.Apply(e, e.Selector("editor/match"))
+	for _, token := range []string{
+		TokenCode, TokenComment, TokenConstant, TokenEmphasis, TokenHeading, TokenKey,
+		TokenKeyword, TokenLink, TokenNumber, TokenString, TokenType, TokenVariable,
+	} {
+		theme.Apply(e, e.Selector("editor/"+token))
+	}
 }
 
 // Cursor returns the current cursor position relative to the content area.
@@ -113,7 +145,8 @@ func (e *Editor) Refresh() {
 
 // ---- Editor Methods -------------------------------------------------------
 
-// Load sets the editor content from a single string (lines separated by \n).
+// Load sets the editor content from a single string (lines separated by
+// \n). Like SetContent, it discards the undo history.
 func (e *Editor) Load(text string) {
 	lines := strings.Split(text, "\n")
 	e.SetContent(lines)
@@ -133,7 +166,9 @@ func (e *Editor) SetAutoIndent(auto bool) {
 	e.indent = auto
 }
 
-// SetContent replaces all editor content with the provided lines.
+// SetContent replaces all editor content with the provided lines. It is a
+// history boundary: all undo and redo steps are discarded, so the new
+// content cannot be undone.
 func (e *Editor) SetContent(lines []string) {
 	e.content = make([]*GapBuffer, len(lines))
 	for i, line := range lines {
@@ -147,11 +182,22 @@ func (e *Editor) SetContent(lines []string) {
 	e.offsetX = 0
 	e.offsetY = 0
 	e.ClearSelection()
+	e.history.reset()
+	e.invalidate(0)
 	e.updateLongestLine()
 	e.Dispatch(e, EvtChange)
 	e.Refresh()
 }
 
+// SetHighlighter installs a syntax highlighter, or removes it if h is nil.
+// Token spans are drawn with the "editor/<token>" styles, for example
+// "editor/keyword". See HighlighterFor for the built-in highlighters.
+func (e *Editor) SetHighlighter(h Highlighter) {
+	e.highlighter = h
+	e.states = nil
+	e.Refresh()
+}
+
 // SetReadOnly configures read-only mode.
 func (e *Editor) SetReadOnly(ro bool) {
 	e.disabled = ro
@@ -260,6 +306,20 @@ func (e *Editor) SelectionText() string {
 // DeleteSelection deletes the selected text, moves cursor to the selection
 // start, and clears the mark. No-op when no selection is active.
 func (e *Editor) DeleteSelection() {
+	if !e.HasSelection() {
+		return
+	}
+	e.save("", false)
+	e.deleteSelection()
+	e.history.done(e.position())
+	e.Dispatch(e, EvtChange)
+	e.Refresh()
+}
+
+// deleteSelection removes the selected text without recording an undo step
+// or notifying listeners; callers that are edits themselves use it to
+// replace the selection.
+func (e *Editor) deleteSelection() {
 	startLine, startCol, endLine, endCol, ok := e.selectionBounds()
 	if !ok {
 		return
@@ -295,10 +355,123 @@ func (e *Editor) DeleteSelection() {
 	e.ClearSelection()
 	e.updateLongestLine()
 	e.adjustViewport()
+}
+
+// ---- Undo/Redo ------------------------------------------------------------
+
+// CanRedo reports whether there is an undone edit that can be redone.
+func (e *Editor) CanRedo() bool {
+	return len(e.history.redo) > 0
+}
+
+// CanUndo reports whether there is an edit that can be undone.
+func (e *Editor) CanUndo() bool {
+	return len(e.history.undo) > 0
+}
+
+// Redo re-applies the most recently undone edit, restoring the text,
+// cursor and selection as they were after it. No-op in read-only mode or
+// when there is nothing to redo.
+func (e *Editor) Redo() {
+	if e.disabled {
+		return
+	}
+	if state, ok := e.history.forward(e.snapshot()); ok {
+		e.restore(state)
+	}
+}
+
+// Undo reverts the most recent edit, restoring the text, cursor and
+// selection as they were before it. Typing and consecutive deletions are
+// coalesced, so a whole word is undone at once. No-op in read-only mode or
+// when there is nothing to undo.
+func (e *Editor) Undo() {
+	if e.disabled {
+		return
+	}
+	if state, ok := e.history.back(e.snapshot()); ok {
+		e.restore(state)
+	}
+}
+
+// position returns the cursor position as used by the history to decide
+// whether consecutive edits are coalesced.
+func (e *Editor) position() [2]int {
+	return [2]int{e.line, e.column}
+}
+
+// restore replaces the editor state with a snapshot.
+func (e *Editor) restore(state editorState) {
+	e.content = make([]*GapBuffer, len(state.lines))
+	for i, line := range state.lines {
+		e.content[i] = NewGapBufferFromString(line, 32)
+	}
+	e.line = state.line
+	e.column = state.column
+	e.selecting = state.selecting
+	e.markLine = state.markLine
+	e.markColumn = state.markColumn
+	e.invalidate(0)
+	e.updateLongestLine()
+	e.adjustViewport()
 	e.Dispatch(e, EvtChange)
 	e.Refresh()
 }
 
+// save records the current state as an undo step before an edit of the
+// given kind. If join is false, the edit never continues the previous one.
+//
+// As every edit starts with save, it also invalidates the cached
+// highlighter states from the line before the cursor or selection on; that
+// covers the line a backspace at column 0 joins into.
+func (e *Editor) save(kind string, join bool) {
+	from := e.position()
+	if !join {
+		from = [2]int{-1, -1}
+	}
+	e.history.save(e.snapshot, kind, from)
+
+	first := e.line
+	if startLine, _, _, _, ok := e.selectionBounds(); ok {
+		first = min(first, startLine)
+	}
+	e.invalidate(first - 1)
+}
+
+// invalidate drops the cached highlighter states from the given line on.
+func (e *Editor) invalidate(line int) {
+	line = max(line, 0)
+	if len(e.states) > line {
+		e.states = e.states[:line]
+	}
+}
+
+// lineState returns the highlighter state at the start of a line,
+// highlighting and caching the states of all lines before it as needed.
+func (e *Editor) lineState(line int) int {
+	if len(e.states) == 0 {
+		e.states = append(e.states, 0)
+	}
+	for len(e.states) <= line {
+		last := len(e.states) - 1
+		_, next := e.highlighter.Highlight(e.content[last].String(), e.states[last])
+		e.states = append(e.states, next)
+	}
+	return e.states[line]
+}
+
+// snapshot captures the current content, cursor and selection.
+func (e *Editor) snapshot() editorState {
+	return editorState{
+		lines:      e.Lines(),
+		line:       e.line,
+		column:     e.column,
+		selecting:  e.selecting,
+		markLine:   e.markLine,
+		markColumn: e.markColumn,
+	}
+}
+
 // ---- Cut/Copy/Paste -------------------------------------------------------
 
 // Copy copies the selection to the internal (and optionally system) clipboard.
@@ -323,9 +496,6 @@ func (e *Editor) Paste() {
 	if e.disabled {
 		return
 	}
-	if e.HasSelection() {
-		e.DeleteSelection()
-	}
 
 	// Try system clipboard; fall back to internal.
 	text, err := systemPaste()
@@ -339,6 +509,19 @@ func (e *Editor) Paste() {
 		return
 	}
 
+	e.save("", false)
+	e.deleteSelection()
+	e.insertText(text)
+	e.history.done(e.position())
+	e.updateLongestLine()
+	e.adjustViewport()
+	e.Dispatch(e, EvtChange)
+	e.Refresh()
+}
+
+// insertText inserts text at the cursor, splitting lines at each \n without
+// auto-indent, and leaves the cursor after the inserted text.
+func (e *Editor) insertText(text string) {
 	lines := strings.Split(text, "\n")
 	for i, part := range lines {
 		if i > 0 {
@@ -360,11 +543,6 @@ func (e *Editor) Paste() {
 			e.column++
 		}
 	}
-
-	e.updateLongestLine()
-	e.adjustViewport()
-	e.Dispatch(e, EvtChange)
-	e.Refresh()
 }
 
 // ---- System Clipboard -----------------------------------------------------
@@ -634,7 +812,11 @@ func (e *Editor) Delete() {
 		e.DeleteSelection()
 		return
 	}
+	if e.line == 0 && e.column == 0 {
+		return
+	}
 
+	e.save("delete", true)
 	if e.column > 0 {
 		e.content[e.line].Move(e.column - 1)
 		e.content[e.line].Delete()
@@ -656,6 +838,7 @@ func (e *Editor) Delete() {
 		e.line = prev
 	}
 
+	e.history.done(e.position())
 	e.updateLongestLine()
 	e.adjustViewport()
 	e.Dispatch(e, EvtChange)
@@ -672,6 +855,11 @@ func (e *Editor) DeleteForward() {
 	}
 
 	lineLen := e.content[e.line].Length()
+	if e.column >= lineLen && e.line >= len(e.content)-1 {
+		return
+	}
+
+	e.save("delete-forward", true)
 	if e.column < lineLen {
 		e.content[e.line].Move(e.column)
 		e.content[e.line].Delete()
@@ -685,6 +873,7 @@ func (e *Editor) DeleteForward() {
 		e.content = append(e.content[:e.line+1], e.content[e.line+2:]...)
 	}
 
+	e.history.done(e.position())
 	e.updateLongestLine()
 	e.adjustViewport()
 	e.Dispatch(e, EvtChange)
@@ -695,9 +884,8 @@ func (e *Editor) Enter() {
 	if e.disabled {
 		return
 	}
-	if e.HasSelection() {
-		e.DeleteSelection()
-	}
+	e.save("", false)
+	e.deleteSelection()
 
 	// Split current line at cursor
 	currentLine := e.content[e.line]
@@ -737,6 +925,7 @@ func (e *Editor) Enter() {
 		e.column = 0
 	}
 
+	e.history.done(e.position())
 	e.updateLongestLine()
 	e.adjustViewport()
 	e.Dispatch(e, EvtChange)
@@ -747,9 +936,12 @@ func (e *Editor) Insert(ch rune) {
 	if e.disabled {
 		return
 	}
-	if e.HasSelection() {
-		e.DeleteSelection()
+	if ch == ' ' || ch == '\t' {
+		e.save("space", !e.HasSelection())
+	} else {
+		e.save("type", !e.HasSelection())
 	}
+	e.deleteSelection()
 
 	// Handle tab character
 	if ch == '\t' {
@@ -766,6 +958,7 @@ func (e *Editor) Insert(ch rune) {
 		e.column++
 	}
 
+	e.history.done(e.position())
 	e.updateLongestLine()
 	e.adjustViewport()
 	e.Dispatch(e, EvtChange)
@@ -959,8 +1152,8 @@ func (e *Editor) Render(r *Renderer) {
 	}
 }
 
-// renderLine draws a single visible line with optional selection highlighting.
-// It renders up to three segments: before-selection, selected, after-selection.
+// renderLine draws a single visible line with syntax, search match and
+// selection highlighting.
 func (e *Editor) renderLine(r *Renderer, lineIdx, textX, textY, usableW int) {
 	line := e.content[lineIdx].String()
 
@@ -975,11 +1168,43 @@ func (e *Editor) renderLine(r *Renderer, lineIdx, textX, textY, usableW int) {
 		normalStyle = e.Style()
 	}
 
+	// Render the whole line normally, then overlay syntax tokens, search
+	// matches and the selection on top of it. Tokens only change the
+	// foreground, so the current-line background is kept.
+	r.Set(normalStyle.Foreground(), normalStyle.Background(), normalStyle.Font())
+	r.Text(textX, textY, e.getVisibleLineContent(line, e.offsetX, usableW, e.tab), usableW)
+
+	var runes []rune
+	if e.highlighter != nil {
+		spans, _ := e.highlighter.Highlight(line, e.lineState(lineIdx))
+		if len(spans) > 0 {
+			runes = []rune(expandTabs(line, e.tab))
+			for _, span := range spans {
+				style := e.Style(span.Style)
+				r.Set(style.Foreground(), normalStyle.Background(), style.Font())
+				start := charToVisualCol(line, span.Start, e.tab)
+				end := charToVisualCol(line, span.End, e.tab)
+				e.renderVisualText(r, runes, start, end, textX, textY, usableW)
+			}
+		}
+	}
+	if e.query != "" {
+		matches, _ := e.search(lineIdx, e.query, e.options, e.pattern)
+		if len(matches) > 0 {
+			if runes == nil {
+				runes = []rune(expandTabs(line, e.tab))
+			}
+			matchStyle := e.Style("match")
+			for _, m := range matches {
+				start := charToVisualCol(line, m.Column, e.tab)
+				end := charToVisualCol(line, m.Column+m.Length, e.tab)
+				e.renderVisualRange(r, runes, start, end, textX, textY, usableW, matchStyle)
+			}
+		}
+	}
+
 	startLine, startCol, endLine, endCol, ok := e.selectionBounds()
 	if !ok || lineIdx < startLine || lineIdx > endLine {
-		// No selection on this line — render normally.
-		r.Set(normalStyle.Foreground(), normalStyle.Background(), normalStyle.Font())
-		r.Text(textX, textY, e.getVisibleLineContent(line, e.offsetX, usableW, e.tab), usableW)
 		return
 	}
 
@@ -1002,22 +1227,27 @@ func (e *Editor) renderLine(r *Renderer, lineIdx, textX, textY, usableW int) {
 	visualSelEnd := charToVisualCol(line, selEnd, e.tab)
 
 	// Expand tabs for segment rendering.
-	expanded := expandTabs(line, e.tab)
-	runes := []rune(expanded)
+	if runes == nil {
+		runes = []rune(expandTabs(line, e.tab))
+	}
 
 	selStyle := e.Style("selection")
 	if selStyle == nil {
 		selStyle = normalStyle
 	}
 
-	e.renderVisualRange(r, runes, 0, visualSelStart, textX, textY, usableW, normalStyle)
 	e.renderVisualRange(r, runes, visualSelStart, visualSelEnd, textX, textY, usableW, selStyle)
-	e.renderVisualRange(r, runes, visualSelEnd, e.offsetX+usableW, textX, textY, usableW, normalStyle)
 }
 
 // renderVisualRange renders a horizontal slice [visStart, visEnd) of the
 // expanded-tab rune slice, clipped to the visible viewport [offsetX, offsetX+usableW).
 func (e *Editor) renderVisualRange(r *Renderer, runes []rune, visStart, visEnd, textX, textY, usableW int, style *Style) {
+	r.Set(style.Foreground(), style.Background(), style.Font())
+	e.renderVisualText(r, runes, visStart, visEnd, textX, textY, usableW)
+}
+
+// renderVisualText is renderVisualRange without setting a style.
+func (e *Editor) renderVisualText(r *Renderer, runes []rune, visStart, visEnd, textX, textY, usableW int) {
 	clipStart := max(visStart, e.offsetX)
 	clipEnd := min(visEnd, e.offsetX+usableW)
 	if clipStart >= clipEnd {
@@ -1034,7 +1264,6 @@ func (e *Editor) renderVisualRange(r *Renderer, runes []rune, visStart, visEnd,
 		}
 	}
 
-	r.Set(style.Foreground(), style.Background(), style.Font())
 	r.Text(screenX, textY, text, width)
 }
 
@@ -1174,7 +1403,7 @@ func (e *Editor) handleKey(evt *tcell.EventKey) bool {
 		evt.Key() != tcell.KeyUp && evt.Key() != tcell.KeyDown &&
 		evt.Key() != tcell.KeyHome && evt.Key() != tcell.KeyEnd &&
 		evt.Key() != tcell.KeyPgUp && evt.Key() != tcell.KeyPgDn &&
-		evt.Key() != tcell.KeyCtrlC {
+		evt.Key() != tcell.KeyCtrlC && evt.Key() != tcell.KeyCtrlF {
 		return false
 	}
 
@@ -1237,6 +1466,9 @@ func (e *Editor) handleKey(evt *tcell.EventKey) bool {
 	case tcell.KeyCtrlE:
 		e.DocumentEnd()
 		return true
+	case tcell.KeyCtrlF:
+		e.OpenFind()
+		return true
 	case tcell.KeyCtrlC:
 		e.Copy()
 		return true
@@ -1246,6 +1478,16 @@ func (e *Editor) handleKey(evt *tcell.EventKey) bool {
 	case tcell.KeyCtrlV:
 		e.Paste()
 		return true
+	case tcell.KeyCtrlY:
+		e.Redo()
+		return true
+	case tcell.KeyCtrlZ:
+		if shift {
+			e.Redo()
+		} else {
+			e.Undo()
+		}
+		return true
 	case tcell.KeyBackspace, tcell.KeyBackspace2:
 		e.Delete()
 		return true
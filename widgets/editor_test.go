@@ -1,9 +1,12 @@
 package widgets
 
 import (
+	"errors"
+	"slices"
 	"testing"
 
 	"github.com/gdamore/tcell/v3"
+	. "github.com/tekugo/zeichenwerk/core"
 )
 
 // ---- helpers ---------------------------------------------------------------
@@ -510,6 +513,271 @@ func TestEditor_Paste_MultiLine(t *testing.T) {
 	}
 }
 
+// ---- Undo / Redo -----------------------------------------------------------
+
+func typeEditor(e *Editor, text string) {
+	for _, ch := range text {
+		e.Insert(ch)
+	}
+}
+
+func TestEditor_Undo_CoalescesWords(t *testing.T) {
+	e := newEditor("")
+	typeEditor(e, "hello world")
+	e.Undo()
+	if got := e.Text(); got != "hello " {
+		t.Errorf("after first Undo = %q; want %q", got, "hello ")
+	}
+	e.Undo()
+	if got := e.Text(); got != "" {
+		t.Errorf("after second Undo = %q; want empty", got)
+	}
+	if e.CanUndo() {
+		t.Error("CanUndo should be false after undoing everything")
+	}
+}
+
+func TestEditor_Undo_MoveBreaksCoalescing(t *testing.T) {
+	e := newEditor("")
+	typeEditor(e, "ac")
+	e.Left()
+	typeEditor(e, "b")
+	e.Undo()
+	if got := e.Text(); got != "ac" {
+		t.Errorf("after Undo = %q; want %q", got, "ac")
+	}
+}
+
+func TestEditor_Undo_RestoresCut(t *testing.T) {
+	e := newEditor("hello world", "second")
+	e.selecting = true
+	e.markLine = 0
+	e.markColumn = 6
+	e.line = 1
+	e.column = 3
+	e.Cut()
+	if got := e.Text(); got != "hello ond" {
+		t.Fatalf("after Cut = %q; want %q", got, "hello ond")
+	}
+	e.Undo()
+	if got := e.Text(); got != "hello world\nsecond" {
+		t.Errorf("after Undo = %q; want original text", got)
+	}
+	if !e.HasSelection() || e.SelectionText() != "world\nsec" {
+		t.Errorf("Undo should restore the selection; got %q", e.SelectionText())
+	}
+	if e.line != 1 || e.column != 3 {
+		t.Errorf("cursor = (%d,%d); want (1,3)", e.line, e.column)
+	}
+}
+
+func TestEditor_Undo_EnterAndBackspace(t *testing.T) {
+	e := newEditor("ab")
+	e.MoveTo(0, 1)
+	e.Enter()
+	e.Delete()
+	e.Delete()
+	if got := e.Text(); got != "b" {
+		t.Fatalf("after Enter and two Backspaces = %q; want %q", got, "b")
+	}
+	e.Undo() // both backspaces
+	if got := e.Text(); got != "a\nb" {
+		t.Errorf("after Undo = %q; want %q", got, "a\nb")
+	}
+	e.Undo() // Enter
+	if got := e.Text(); got != "ab" {
+		t.Errorf("after second Undo = %q; want %q", got, "ab")
+	}
+}
+
+func TestEditor_Redo(t *testing.T) {
+	e := newEditor("")
+	typeEditor(e, "abc")
+	e.Undo()
+	if !e.CanRedo() {
+		t.Fatal("CanRedo should be true after Undo")
+	}
+	e.Redo()
+	if got := e.Text(); got != "abc" {
+		t.Errorf("after Redo = %q; want %q", got, "abc")
+	}
+	if e.column != 3 {
+		t.Errorf("column after Redo = %d; want 3", e.column)
+	}
+
+	e.Undo()
+	typeEditor(e, "x")
+	if e.CanRedo() {
+		t.Error("a new edit should clear the redo stack")
+	}
+}
+
+func TestHistory_SnapshotPerStep(t *testing.T) {
+	var h history[string]
+	taken := 0
+	snapshot := func() string {
+		taken++
+		return "state"
+	}
+	for i := range 5 {
+		h.save(snapshot, "type", [2]int{0, i})
+		h.done([2]int{0, i + 1})
+	}
+	h.save(snapshot, "delete", [2]int{0, 5})
+	if taken != 2 || len(h.undo) != 2 {
+		t.Errorf("snapshots taken = %d for %d undo steps; want one per step", taken, len(h.undo))
+	}
+}
+
+func TestEditor_SetContent_ClearsHistory(t *testing.T) {
+	e := newEditor("")
+	typeEditor(e, "abc")
+	e.Load("new\ncontent")
+	if e.CanUndo() {
+		t.Error("Load should discard the undo history")
+	}
+	e.Undo()
+	if got := e.Text(); got != "new\ncontent" {
+		t.Errorf("Undo after Load = %q; want loaded content", got)
+	}
+}
+
+func TestEditor_KeyCtrlZ_CtrlY(t *testing.T) {
+	e := newEditor("")
+	typeEditor(e, "abc")
+	e.handleKey(tcell.NewEventKey(tcell.KeyCtrlZ, "", tcell.ModNone))
+	if got := e.Text(); got != "" {
+		t.Errorf("after Ctrl+Z = %q; want empty", got)
+	}
+	e.handleKey(tcell.NewEventKey(tcell.KeyCtrlY, "", tcell.ModNone))
+	if got := e.Text(); got != "abc" {
+		t.Errorf("after Ctrl+Y = %q; want %q", got, "abc")
+	}
+}
+
+// ---- Find / Replace --------------------------------------------------------
+
+func TestEditor_Find_Literal(t *testing.T) {
+	e := newEditor("foo Foo foo", "aaaa")
+	got := e.Find("foo", FindOptions{CaseSensitive: true})
+	want := []Match{{0, 0, 3}, {0, 8, 3}}
+	if !slices.Equal(got, want) {
+		t.Errorf("Find(foo, case) = %v; want %v", got, want)
+	}
+	if got := e.Find("foo", FindOptions{}); len(got) != 3 {
+		t.Errorf("Find(foo) found %d matches; want 3 ignoring case", len(got))
+	}
+	want = []Match{{1, 0, 2}, {1, 2, 2}}
+	if got := e.Find("aa", FindOptions{CaseSensitive: true}); !slices.Equal(got, want) {
+		t.Errorf("Find(aa) = %v; want non-overlapping %v", got, want)
+	}
+}
+
+func TestEditor_Find_Regex(t *testing.T) {
+	e := newEditor("käse = 10", "x = 200")
+	got := e.Find(`\d+`, FindOptions{Regex: true})
+	want := []Match{{0, 7, 2}, {1, 4, 3}}
+	if !slices.Equal(got, want) {
+		t.Errorf("Find(\\d+) = %v; want rune-based %v", got, want)
+	}
+	if got := e.Find(`x*`, FindOptions{Regex: true}); len(got) != 1 {
+		t.Errorf("Find(x*) = %v; want empty matches skipped", got)
+	}
+	if got := e.Find(`(`, FindOptions{Regex: true}); got != nil {
+		t.Errorf("Find with invalid regex = %v; want nil", got)
+	}
+}
+
+func TestEditor_SetSearch_InvalidRegex(t *testing.T) {
+	e := newEditor("abc")
+	err := e.SetSearch("(", FindOptions{Regex: true})
+	if !errors.Is(err, ErrInvalidPattern) {
+		t.Errorf("SetSearch error = %v; want ErrInvalidPattern", err)
+	}
+	if query, _ := e.Search(); query != "" {
+		t.Errorf("active query = %q after invalid regex; want empty", query)
+	}
+}
+
+func TestEditor_FindNext_WrapsAndSelects(t *testing.T) {
+	e := newEditor("ab ab", "ab")
+	e.SetSearch("ab", FindOptions{})
+	e.MoveTo(0, 1)
+	for _, want := range []Match{{0, 3, 2}, {1, 0, 2}, {0, 0, 2}} {
+		if !e.FindNext() {
+			t.Fatal("FindNext should find a match")
+		}
+		if e.SelectionText() != "ab" || !e.isSelected(want) {
+			t.Errorf("selection after FindNext = (%d,%d); want match %v", e.markLine, e.markColumn, want)
+		}
+	}
+	e.FindPrevious()
+	if !e.isSelected(Match{1, 0, 2}) {
+		t.Errorf("FindPrevious should wrap to the last match; cursor (%d,%d)", e.line, e.column)
+	}
+}
+
+func TestEditor_Replace_SelectedMatch(t *testing.T) {
+	e := newEditor("one two one")
+	e.SetSearch("one", FindOptions{})
+	e.Replace("1") // selection is not a match: only moves to the first match
+	if got := e.Text(); got != "one two one" {
+		t.Fatalf("first Replace changed text to %q", got)
+	}
+	e.Replace("1")
+	if got := e.Text(); got != "1 two one" {
+		t.Errorf("after Replace = %q; want %q", got, "1 two one")
+	}
+	if !e.isSelected(Match{0, 6, 3}) {
+		t.Error("Replace should select the next match")
+	}
+	e.Undo()
+	if got := e.Text(); got != "one two one" {
+		t.Errorf("after Undo = %q; want original", got)
+	}
+}
+
+func TestEditor_ReplaceAll(t *testing.T) {
+	e := newEditor("a=1, b=2", "c=3")
+	n := e.ReplaceAll(`(\w)=(\d)`, "$2:$1", FindOptions{Regex: true})
+	if n != 3 {
+		t.Errorf("ReplaceAll = %d; want 3", n)
+	}
+	if got := e.Text(); got != "1:a, 2:b\n3:c" {
+		t.Errorf("after ReplaceAll = %q; want %q", got, "1:a, 2:b\n3:c")
+	}
+	e.Undo()
+	if got := e.Text(); got != "a=1, b=2\nc=3" {
+		t.Errorf("ReplaceAll should be a single undo step; got %q", got)
+	}
+	if n := e.ReplaceAll(",", "\n", FindOptions{}); n != 1 || len(e.content) != 3 {
+		t.Errorf("ReplaceAll with newline: n=%d, lines=%d; want 1, 3", n, len(e.content))
+	}
+}
+
+func TestEditor_Render_HighlightsMatches(t *testing.T) {
+	theme := NewTheme()
+	theme.AddStyles(
+		NewStyle("editor").WithColors("white", "black"),
+		NewStyle("editor/match").WithColors("black", "yellow"),
+	)
+	e := newEditor("xx ab xx")
+	e.Apply(theme)
+	e.SetBounds(0, 0, 10, 2)
+	e.SetSearch("ab", FindOptions{})
+	screen := NewTestScreen()
+	e.Render(NewRenderer(screen, theme))
+	if screen.Get(3, 0) != "a" {
+		t.Fatalf("col 3 = %q; want %q", screen.Get(3, 0), "a")
+	}
+	if fg := screen.Fg(3, 0); fg != "black" {
+		t.Errorf("match foreground = %q; want black", fg)
+	}
+	if fg := screen.Fg(0, 0); fg != "white" {
+		t.Errorf("normal foreground = %q; want white", fg)
+	}
+}
+
 // ---- charToVisualCol -------------------------------------------------------
 
 func TestEditor_CharToVisualCol_NoTabs(t *testing.T) {
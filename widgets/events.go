@@ -16,6 +16,9 @@ const (
 	EvtChange Event = "change"
 	// EvtBlur is dispatched when a widget loses keyboard focus.
 	EvtBlur Event = "blur"
+	// EvtCancel is dispatched when the user cancels an input with Escape
+	// (e.g. Calendar). A key that no handler consumes bubbles up.
+	EvtCancel Event = "cancel"
 	// EvtClick is dispatched on a single mouse button-1 click.
 	EvtClick Event = "click"
 	// EvtClose is dispatched to a popup layer just before it is removed by
@@ -23,6 +26,10 @@ const (
 	EvtClose Event = "close"
 	// EvtEnter is dispatched if the Enter key is pressed.
 	EvtEnter Event = "enter"
+	// EvtExit is dispatched when a process started in a widget exits
+	// (e.g. Terminal.Start). The data is the exit code (int) and the error
+	// returned by exec.Cmd.Wait, which is nil on a zero exit status.
+	EvtExit Event = "exit"
 	// EvtFocus is dispatched when a widget gains keyboard focus.
 	EvtFocus Event = "focus"
 	// EvtHide is dispatched when a widget becomes hidden.
@@ -37,7 +44,8 @@ const (
 	// EvtMouse is dispatched for raw mouse events.
 	EvtMouse Event = "mouse"
 	// EvtMove is dispatched when the highlighted/selected position changes
-	// due to mouse movement.
+	// due to mouse movement, or before an item is moved (e.g. Board cards,
+	// where a handler returning true vetoes the move).
 	EvtMove Event = "move"
 	// EvtPaste is dispatched when text is pasted into a widget.
 	EvtPaste Event = "paste"
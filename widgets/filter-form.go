@@ -1,7 +1,6 @@
 package widgets
 
 import (
-	"fmt"
 	"io"
 
 	"github.com/tekugo/zeichenwerk/core"
@@ -54,19 +53,24 @@ func (f *FilterForm) Validate(field string) error { return nil }
 
 func (f *FilterForm) Emit(w io.Writer, mode string) error {
 	if err := f.EmitFrame(w, mode, func() error {
-		_, err := fmt.Fprintf(w, "Filter(%q).\n", f.ID)
-		return err
+		return f.EmitConstructor(w, mode, "Filter")
 	}); err != nil {
 		return err
 	}
 	if f.Masked {
-		fmt.Fprintf(w, "Flag(FlagMasked, true).\n")
+		if err := f.EmitFlag(w, mode, "FlagMasked"); err != nil {
+			return err
+		}
 	}
 	if f.Readonly {
-		fmt.Fprintf(w, "Flag(FlagReadonly, true).\n")
+		if err := f.EmitFlag(w, mode, "FlagReadonly"); err != nil {
+			return err
+		}
 	}
 	if f.Placeholder != "" {
-		fmt.Fprintf(w, "// TODO: SetPlaceholder(%q) — no Builder setter\n", f.Placeholder)
+		if err := f.EmitTODO(w, mode, "SetPlaceholder(%q)", f.Placeholder); err != nil {
+			return err
+		}
 	}
 	return nil
 }
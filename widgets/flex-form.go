@@ -3,20 +3,18 @@ package widgets
 import (
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/tekugo/zeichenwerk/core"
 )
 
-// FlexForm is the WidgetForm for *Flex.
-//
-// Flex is a container, but its Add method takes no per-child layout
-// arguments — children are positioned by alignment and spacing on the
-// container itself — so FlexForm satisfies core.WidgetForm only and
-// does not provide a LayoutForm.
+// FlexForm is the WidgetForm for *Flex. Per-child FlexItem parameters
+// are edited through FlexLayoutForm.
 type FlexForm struct {
 	ComponentForm
 
 	Vertical  bool   `group:"layout" label:"Vertical"`
+	Wrap      bool   `group:"layout" label:"Wrap"`
 	Alignment string `group:"layout" label:"Alignment" control:"select" options:"start,center,end,stretch,left,right"`
 	Spacing   int    `group:"layout" label:"Spacing"`
 }
@@ -29,6 +27,7 @@ func (f *FlexForm) Load(w core.Widget) {
 	x := w.(*Flex)
 	f.ComponentForm.Load(&x.Component)
 	f.Vertical = x.Flag(core.FlagVertical)
+	f.Wrap = x.Flag(core.FlagWrap)
 	f.Alignment = x.alignment.String()
 	f.Spacing = x.spacing
 }
@@ -37,6 +36,7 @@ func (f *FlexForm) Store(w core.Widget) {
 	x := w.(*Flex)
 	f.ComponentForm.Store(&x.Component)
 	x.SetFlag(core.FlagVertical, f.Vertical)
+	x.SetFlag(core.FlagWrap, f.Wrap)
 	x.alignment = parseAlignment(f.Alignment)
 	x.spacing = f.Spacing
 }
@@ -63,6 +63,9 @@ func (f *FlexForm) Emit(w io.Writer, mode string) error {
 	if err := f.CheckBuilderMode(mode); err != nil {
 		return err
 	}
+	if mode == "compose" {
+		return f.emitCompose(w)
+	}
 	f.EmitClassPrefix(w)
 	ctor := "HFlex"
 	if f.Vertical {
@@ -76,6 +79,9 @@ func (f *FlexForm) Emit(w io.Writer, mode string) error {
 	if f.HintW != 0 || f.HintH != 0 {
 		fmt.Fprintf(w, "Hint(%d, %d).\n", f.HintW, f.HintH)
 	}
+	if f.Wrap {
+		fmt.Fprintf(w, "Flag(FlagWrap, true).\n")
+	}
 	if f.Skip {
 		fmt.Fprintf(w, "Flag(FlagSkip, true).\n")
 	}
@@ -89,6 +95,120 @@ func (f *FlexForm) Emit(w io.Writer, mode string) error {
 	return nil
 }
 
+// emitCompose writes the opening HFlex or VFlex call with its options.
+// As in builder mode, FlagVertical is implied by VFlex.
+func (f *FlexForm) emitCompose(w io.Writer) error {
+	ctor := "HFlex"
+	if f.Vertical {
+		ctor = "VFlex"
+	}
+	args := fmt.Sprintf("%s, %d", coreIdent("compose", alignmentConst(f.Alignment)), f.Spacing)
+	if err := f.EmitConstructor(w, "compose", ctor, args); err != nil {
+		return err
+	}
+	if f.HintW != 0 || f.HintH != 0 {
+		if _, err := fmt.Fprintf(w, "Hint(%d, %d),\n", f.HintW, f.HintH); err != nil {
+			return err
+		}
+	}
+	if f.Wrap {
+		if err := f.EmitFlag(w, "compose", "FlagWrap"); err != nil {
+			return err
+		}
+	}
+	if f.Skip {
+		if err := f.EmitFlag(w, "compose", "FlagSkip"); err != nil {
+			return err
+		}
+	}
+	if f.Hidden {
+		if err := f.EmitFlag(w, "compose", "FlagHidden"); err != nil {
+			return err
+		}
+	}
+	if f.Disabled {
+		if err := f.EmitFlag(w, "compose", "FlagDisabled"); err != nil {
+			return err
+		}
+	}
+	return f.EmitStyleOptions(w)
+}
+
+// LayoutForm returns a fresh per-child layout form already loaded with
+// child's FlexItem on parent.
+func (f *FlexForm) LayoutForm(parent core.Container, child core.Widget) core.LayoutForm {
+	lf := &FlexLayoutForm{}
+	lf.Load(parent, child)
+	return lf
+}
+
+// FlexLayoutForm captures one child's FlexItem on a Flex.
+type FlexLayoutForm struct {
+	Basis    int  `group:"size" label:"Basis"`
+	Min      int  `group:"size" label:"Min"`
+	Max      int  `group:"size" label:"Max"`
+	Grow     int  `group:"size" label:"Grow"`
+	Shrink   int  `group:"size" label:"Shrink"`
+	Collapse bool `group:"size" label:"Collapse"`
+}
+
+// Load reads child's FlexItem from flex.
+func (f *FlexLayoutForm) Load(parent core.Container, child core.Widget) {
+	x, ok := parent.(*Flex)
+	if !ok {
+		return
+	}
+	item := x.Item(child)
+	f.Basis, f.Min, f.Max = item.Basis, item.Min, item.Max
+	f.Grow, f.Shrink, f.Collapse = item.Grow, item.Shrink, item.Collapse
+}
+
+// Store sets child's FlexItem on flex to match the form.
+func (f *FlexLayoutForm) Store(parent core.Container, child core.Widget) {
+	if x, ok := parent.(*Flex); ok {
+		x.SetItem(child, f.item())
+	}
+}
+
+func (f *FlexLayoutForm) Validate(field string) error { return nil }
+
+// Emit writes the Item prefix that precedes the child's constructor.
+// Children without parameters get no prefix. In compose mode the
+// prefix opens an Item wrapper, "Item(widgets.FlexItem{...}, ", which
+// the codegen walker closes after the child.
+func (f *FlexLayoutForm) Emit(w io.Writer, mode string) error {
+	if mode != "builder" && mode != "compose" {
+		return fmt.Errorf("unknown mode %q", mode)
+	}
+	item := f.item()
+	if item == (FlexItem{}) {
+		return nil
+	}
+	var fields []string
+	for _, field := range []struct {
+		name  string
+		value int
+	}{{"Basis", item.Basis}, {"Min", item.Min}, {"Max", item.Max}, {"Grow", item.Grow}, {"Shrink", item.Shrink}} {
+		if field.value != 0 {
+			fields = append(fields, fmt.Sprintf("%s: %d", field.name, field.value))
+		}
+	}
+	if item.Collapse {
+		fields = append(fields, "Collapse: true")
+	}
+	if mode == "compose" {
+		fmt.Fprintf(w, "Item(widgets.FlexItem{%s}, ", strings.Join(fields, ", "))
+	} else {
+		fmt.Fprintf(w, "Item(FlexItem{%s}).\n", strings.Join(fields, ", "))
+	}
+	return nil
+}
+
+// item returns the FlexItem the form describes.
+func (f *FlexLayoutForm) item() FlexItem {
+	return FlexItem{Basis: f.Basis, Min: f.Min, Max: f.Max, Grow: f.Grow, Shrink: f.Shrink, Collapse: f.Collapse}
+}
+
 // alignmentConst maps an Alignment.String() value back to the
 // exported core constant identifier so generated code reads as
 // "core.Center" or "Center" inside the widgets package.
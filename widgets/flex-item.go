@@ -0,0 +1,354 @@
+package widgets
+
+import (
+	. "github.com/tekugo/zeichenwerk/core"
+)
+
+// FlexItem holds the layout parameters of one Flex child. Pass it to Add
+// or Insert, or set it later with SetItem:
+//
+//	flex.Add(sidebar, FlexItem{Min: 20, Shrink: 1, Collapse: true})
+//	flex.Add(content, FlexItem{Grow: 1})
+//
+// Sizes are measured along the main axis of the flex, the width of a
+// horizontal and the height of a vertical one, and include the child's
+// margin, border and padding like its bounds do. Zero values leave a
+// parameter unset, so a zero FlexItem lays the child out by its size hint
+// alone.
+type FlexItem struct {
+	Basis    int  // Initial size; 0 uses the size hint
+	Min      int  // Minimum size
+	Max      int  // Maximum size; 0 for no limit
+	Grow     int  // Share of the free space; 0 for none
+	Shrink   int  // Share of the missing space if the children do not fit; 0 never shrinks
+	Collapse bool // Hide the child while its line cannot fit all children at their minimum size
+}
+
+// Item returns the layout parameters of a child.
+func (f *Flex) Item(child Widget) FlexItem {
+	return f.items[child]
+}
+
+// SetItem sets the layout parameters of a child. Children not in the flex
+// are ignored. The new parameters take effect on the next layout.
+func (f *Flex) SetItem(child Widget, item FlexItem) {
+	for _, c := range f.children {
+		if c == child {
+			f.setItem(child, item)
+			return
+		}
+	}
+}
+
+// Collapsed reports whether the last layout hid a child because it did
+// not fit (see [FlexItem.Collapse]).
+func (f *Flex) Collapsed(child Widget) bool {
+	return f.collapsed[child]
+}
+
+// setItem stores the parameters of a child; the zero item removes them.
+func (f *Flex) setItem(child Widget, item FlexItem) {
+	if item == (FlexItem{}) {
+		delete(f.items, child)
+		return
+	}
+	if f.items == nil {
+		f.items = make(map[Widget]FlexItem)
+	}
+	f.items[child] = item
+}
+
+// flexParams extracts a FlexItem from Add or Insert parameters.
+func flexParams(params []any) (FlexItem, bool) {
+	for _, param := range params {
+		switch p := param.(type) {
+		case FlexItem:
+			return p, true
+		case *FlexItem:
+			if p != nil {
+				return *p, true
+			}
+		}
+	}
+	return FlexItem{}, false
+}
+
+// itemHint adjusts the preferred main-axis size of a child by its
+// FlexItem.
+func (f *Flex) itemHint(child Widget, size int) int {
+	item, ok := f.items[child]
+	if !ok {
+		return size
+	}
+	if item.Basis > 0 {
+		size = item.Basis
+	}
+	return item.clamp(size)
+}
+
+// clamp limits a size to the minimum and maximum of the item. Sizes never
+// become negative.
+func (item FlexItem) clamp(size int) int {
+	if item.Max > 0 && size > item.Max {
+		size = item.Max
+	}
+	return max(size, item.Min, 0)
+}
+
+// ---- Layout ---------------------------------------------------------------
+
+// flexSlot is one child during layout. Sizes are outer sizes along the
+// main axis, cross is the preferred outer size on the cross axis.
+type flexSlot struct {
+	child     Widget
+	item      FlexItem
+	size      int  // Current main-axis size
+	cross     int  // Preferred cross-axis size
+	grow      int  // Grow factor, from the item or a fractional hint
+	frozen    bool // Size reached its minimum or maximum while distributing
+	collapsed bool // Hidden because the line is too small
+}
+
+// layoutHorizontal lays the children out from left to right.
+func (f *Flex) layoutHorizontal() {
+	f.layoutLines(false)
+}
+
+// layoutVertical lays the children out from top to bottom.
+func (f *Flex) layoutVertical() {
+	f.layoutLines(true)
+}
+
+// layoutLines positions the children along the main axis, wrapping onto
+// further lines with FlagWrap set.
+//
+// Layout algorithm, per line:
+//  1. Every child starts at its basis: FlexItem.Basis, the size hint plus
+//     style, or 0 for fractional (negative) hints, clamped to Min and Max
+//  2. Collapsible children are hidden, last first, while the minimum sizes
+//     of the line do not fit
+//  3. Free space goes to growing children in proportion to their grow
+//     factor (a negative hint grows by its fraction); missing space is
+//     taken from shrinking children in proportion to Shrink
+//  4. Children that hit Min or Max keep that size and the rest is
+//     distributed again among the others
+//
+// The last child of a distribution gets any remainder to avoid rounding
+// errors. Without wrapping, the single line spans the whole cross axis;
+// wrapped lines are as high (or wide) as their largest child.
+func (f *Flex) layoutLines(vertical bool) {
+	cx, cy, cw, ch := f.Content()
+	main, cross := cw, ch
+	if vertical {
+		main, cross = ch, cw
+	}
+
+	slots := make([]*flexSlot, len(f.children))
+	for i, child := range f.children {
+		slots[i] = f.slot(child, vertical)
+	}
+
+	lines := [][]*flexSlot{slots}
+	if f.Flag(FlagWrap) {
+		lines = f.wrap(slots, main)
+	}
+
+	offset := 0
+	for _, line := range lines {
+		f.collapse(line, main)
+		f.distribute(line, main)
+
+		size := cross
+		if len(lines) > 1 {
+			size = 0
+			for _, s := range line {
+				if !s.collapsed {
+					size = max(size, s.cross)
+				}
+			}
+		}
+
+		pos := 0
+		for _, s := range line {
+			if s.collapsed != f.collapsed[s.child] {
+				s.child.SetFlag(FlagHidden, s.collapsed)
+				if s.collapsed {
+					if f.collapsed == nil {
+						f.collapsed = make(map[Widget]bool)
+					}
+					f.collapsed[s.child] = true
+				} else {
+					delete(f.collapsed, s.child)
+				}
+			}
+			if s.collapsed {
+				s.size = 0
+			}
+			at, extent := align(f.alignment, offset, offset+size, s.cross)
+			if vertical {
+				s.child.SetBounds(cx+at, cy+pos, extent, s.size)
+			} else {
+				s.child.SetBounds(cx+pos, cy+at, s.size, extent)
+			}
+			if !s.collapsed {
+				pos += s.size + f.spacing
+			}
+		}
+		offset += size + f.spacing
+	}
+}
+
+// slot prepares a child for layout.
+func (f *Flex) slot(child Widget, vertical bool) *flexSlot {
+	hw, hh := child.Hint()
+	style := child.Style()
+	hint, extra := hw, style.Horizontal()
+	cross := hh + style.Vertical()
+	if vertical {
+		hint, extra = hh, style.Vertical()
+		cross = hw + style.Horizontal()
+	}
+
+	item := f.items[child]
+	s := &flexSlot{child: child, item: item, cross: cross, grow: item.Grow}
+	switch {
+	case item.Basis > 0:
+		s.size = item.Basis
+	case hint < 0:
+		s.size = 0
+	default:
+		s.size = hint + extra
+	}
+	if hint < 0 && item.Grow == 0 {
+		s.grow = -hint
+	}
+	s.size = item.clamp(s.size)
+	return s
+}
+
+// wrap breaks the children into lines that fit their basis into the main
+// axis. Every line holds at least one child.
+func (f *Flex) wrap(slots []*flexSlot, main int) [][]*flexSlot {
+	var lines [][]*flexSlot
+	var line []*flexSlot
+	used := 0
+	for _, s := range slots {
+		if len(line) > 0 && used+f.spacing+s.size > main {
+			lines = append(lines, line)
+			line, used = nil, 0
+		}
+		if len(line) > 0 {
+			used += f.spacing
+		}
+		used += s.size
+		line = append(line, s)
+	}
+	if len(line) > 0 {
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// collapse hides collapsible children, last first, until the minimum sizes
+// of the line fit into the main axis or nothing is left to collapse.
+func (f *Flex) collapse(line []*flexSlot, main int) {
+	for {
+		need, n := 0, 0
+		for _, s := range line {
+			if s.collapsed {
+				continue
+			}
+			if s.item.Shrink > 0 {
+				need += s.item.clamp(0)
+			} else {
+				need += s.size
+			}
+			n++
+		}
+		if n > 1 {
+			need += f.spacing * (n - 1)
+		}
+		if need <= main {
+			return
+		}
+		i := len(line) - 1
+		for i >= 0 && (line[i].collapsed || !line[i].item.Collapse) {
+			i--
+		}
+		if i < 0 {
+			return
+		}
+		line[i].collapsed = true
+	}
+}
+
+// distribute grows or shrinks the visible children of a line to fill the
+// main axis. Each round freezes the children that hit a limit, so the loop
+// ends after at most one round per child.
+func (f *Flex) distribute(line []*flexSlot, main int) {
+	var visible []*flexSlot
+	for _, s := range line {
+		if !s.collapsed {
+			visible = append(visible, s)
+		}
+	}
+
+	for {
+		free := main
+		for i, s := range visible {
+			free -= s.size
+			if i > 0 {
+				free -= f.spacing
+			}
+		}
+
+		var active []*flexSlot
+		var weights []int
+		total := 0
+		for _, s := range visible {
+			weight := s.grow
+			if free < 0 {
+				weight = s.item.Shrink
+			}
+			if free != 0 && !s.frozen && weight > 0 {
+				active = append(active, s)
+				weights = append(weights, weight)
+				total += weight
+			}
+		}
+		if len(active) == 0 {
+			return
+		}
+
+		saved := make([]int, len(active))
+		fraction := free / total
+		rest := free
+		for i, s := range active {
+			saved[i] = s.size
+			delta := fraction * weights[i]
+			if i == len(active)-1 {
+				delta = rest // Last child gets the remainder
+			}
+			s.size += delta
+			rest -= delta
+		}
+
+		violated := false
+		for _, s := range active {
+			if s.item.clamp(s.size) != s.size {
+				violated = true
+			}
+		}
+		if !violated {
+			return
+		}
+		for i, s := range active {
+			if limit := s.item.clamp(s.size); limit != s.size {
+				s.size = limit
+				s.frozen = true
+			} else {
+				s.size = saved[i]
+			}
+		}
+	}
+}
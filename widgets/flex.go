@@ -13,11 +13,18 @@ import (
 //   - Auto sizes: Zero values use the widget's preferred size hint
 //   - Flexible sizes: Negative values are treated as fractions of remaining space
 //   - Spacing: Applied between adjacent children (not at edges)
+//   - Constraints: A [FlexItem] passed to Add sets the basis, minimum and
+//     maximum size, grow and shrink factors of a child, and lets it
+//     collapse when there is not enough room
+//   - Wrapping: With FlagWrap, children that do not fit flow onto
+//     additional rows (or columns in a vertical flex)
 type Flex struct {
 	Component
-	children  []Widget  // Child widgets managed by this flex container
-	alignment Alignment // Child alignment: "start", "center", "end", or "stretch"
-	spacing   int       // Pixels/characters of spacing between children
+	children  []Widget            // Child widgets managed by this flex container
+	alignment Alignment           // Child alignment: "start", "center", "end", or "stretch"
+	spacing   int                 // Pixels/characters of spacing between children
+	items     map[Widget]FlexItem // Layout parameters of children, if set
+	collapsed map[Widget]bool     // Children hidden by the last layout, see FlexItem.Collapse
 }
 
 // NewFlex creates a new flex container widget with the specified configuration.
@@ -60,7 +67,9 @@ func (f *Flex) Apply(theme *Theme) {
 func (f *Flex) Render(r *Renderer) {
 	f.Component.Render(r)
 	for _, child := range f.children {
-		child.Render(r)
+		if !f.collapsed[child] {
+			child.Render(r)
+		}
 	}
 }
 
@@ -72,10 +81,14 @@ func (f *Flex) Render(r *Renderer) {
 //
 // Parameters:
 //   - widget: The widget to add as a child of this flex container
+//   - params: An optional [FlexItem] with the layout parameters of the child
 func (f *Flex) Add(widget Widget, params ...any) error {
 	if widget != nil {
 		widget.SetParent(f)
 		f.children = append(f.children, widget)
+		if item, ok := flexParams(params); ok {
+			f.setItem(widget, item)
+		}
 		return nil
 	} else {
 		return ErrChildIsNil
@@ -94,8 +107,9 @@ func (f *Flex) Children() []Widget {
 
 // Insert places widget at index in the children slice, shifting later
 // siblings up by one. Index values outside [0, len(children)] are
-// clamped to the closest valid endpoint.
-func (f *Flex) Insert(index int, widget Widget, _ ...any) error {
+// clamped to the closest valid endpoint. Like Add, it takes an optional
+// [FlexItem].
+func (f *Flex) Insert(index int, widget Widget, params ...any) error {
 	if widget == nil {
 		return ErrChildIsNil
 	}
@@ -109,6 +123,9 @@ func (f *Flex) Insert(index int, widget Widget, _ ...any) error {
 	f.children = append(f.children, nil)
 	copy(f.children[index+1:], f.children[index:])
 	f.children[index] = widget
+	if item, ok := flexParams(params); ok {
+		f.setItem(widget, item)
+	}
 	return nil
 }
 
@@ -121,6 +138,11 @@ func (f *Flex) Remove(child Widget) error {
 	for i, c := range f.children {
 		if c == child {
 			f.children = append(f.children[:i], f.children[i+1:]...)
+			delete(f.items, child)
+			if f.collapsed[child] {
+				delete(f.collapsed, child)
+				child.SetFlag(FlagHidden, false)
+			}
 			child.SetParent(nil)
 			return nil
 		}
@@ -155,6 +177,8 @@ func (f *Flex) Summary() string {
 //   - Vertical layout: Width = max child width, Height = sum of child heights + spacing
 //   - Includes child margins, padding, and borders in calculations
 //   - Accounts for spacing between children (but not at edges)
+//   - Respects the basis, minimum and maximum of a child's [FlexItem]
+//   - Reports the size of a single line, even with FlagWrap
 //
 // Returns:
 //   - int: Preferred width in characters/pixels
@@ -172,7 +196,7 @@ func (f *Flex) Hint() (int, int) {
 		// Horizontal layout: sum widths, take max height
 		for i, child := range f.children {
 			cw, ch := child.Hint()
-			cw += child.Style().Horizontal()
+			cw = f.itemHint(child, cw+child.Style().Horizontal())
 			ch += child.Style().Vertical()
 			width += cw
 			if i > 0 { // Add spacing between children (not before first)
@@ -187,7 +211,7 @@ func (f *Flex) Hint() (int, int) {
 		for i, child := range f.children {
 			cw, ch := child.Hint()
 			cw += child.Style().Horizontal()
-			ch += child.Style().Vertical()
+			ch = f.itemHint(child, ch+child.Style().Vertical())
 			height += ch
 			if i > 0 { // Add spacing between children (not before first)
 				height += f.spacing
@@ -216,145 +240,6 @@ func (f *Flex) Layout() error {
 	return Layout(f)
 }
 
-// layoutHorizontal performs horizontal layout of child widgets.
-// This method arranges children from left to right, calculating widths
-// based on style hints and distributing remaining space among flexible children.
-//
-// Layout algorithm:
-//  1. Calculate total fractions and remaining space after fixed-size children
-//  2. Determine fraction size (remaining space / total fractions)
-//  3. Position each child with calculated width and full container height
-//  4. Apply spacing between adjacent children
-//
-// Width calculation:
-//   - Fixed width (positive): Use exact value plus horizontal margins/padding
-//   - Flexible width (negative): Use fractional units of remaining space
-//   - Last flexible child gets any remaining pixels to avoid rounding errors
-func (f *Flex) layoutHorizontal() {
-	var style *Style
-	var fraction int
-
-	cx, cy, cw, ch := f.Content()
-
-	// Calculate fractional size for 1 fraction unit
-	fractions := 0
-	last := -1
-	rest := cw
-	for i, child := range f.children {
-		hw, _ := child.Hint()
-		style = child.Style()
-
-		if hw < 0 {
-			fractions -= hw // Accumulate fraction units (negative values)
-			last = i
-		} else {
-			rest = rest - hw - style.Horizontal()
-		}
-		if i > 0 {
-			rest -= f.spacing
-		}
-	}
-
-	// Calculate space per fraction unit
-	if fractions > 0 {
-		fraction = rest / fractions
-	}
-
-	// Position children from left to right
-	x := cx
-	for i, child := range f.children {
-		hw, hh := child.Hint()
-		style = child.Style()
-
-		// Calculate x position and width according to alignment
-		wy, wh := align(f.alignment, cy, cy+ch, hh+style.Vertical())
-		if hw < 0 {
-			if i != last {
-				child.SetBounds(x, wy, -fraction*hw, wh)
-			} else {
-				// Last flexible child gets remaining space to handle rounding
-				child.SetBounds(x, wy, rest, wh)
-			}
-			rest = rest - fraction*(-hw)
-		} else {
-			child.SetBounds(x, wy, hw+style.Horizontal(), wh)
-		}
-		_, _, width, _ := child.Bounds()
-		x += width + f.spacing
-	}
-}
-
-// layoutVertical performs vertical layout of child widgets.
-// This method arranges children from top to bottom, calculating heights
-// based on style hints and distributing remaining space among flexible children.
-// It also applies horizontal alignment for each child.
-//
-// Layout algorithm:
-//  1. Calculate total fractions and remaining space after fixed-size children
-//  2. Determine fraction size (remaining space / total fractions)
-//  3. Position each child with calculated height and aligned width
-//  4. Apply spacing between adjacent children
-//
-// Height calculation:
-//   - Fixed height (positive): Use exact value plus vertical margins/padding
-//   - Auto height (zero): Use widget's preferred height hint
-//   - Flexible height (negative): Use fractional units of remaining space
-//   - Last flexible child gets any remaining pixels to avoid rounding errors
-func (f *Flex) layoutVertical() {
-	var style *Style
-	var fraction int
-
-	cx, cy, cw, ch := f.Content()
-
-	// Calculate fractional size for 1 fraction unit
-	fractions := 0
-	last := -1
-	rest := ch
-	for i, child := range f.children {
-		_, hh := child.Hint()
-		style = child.Style()
-
-		if hh < 0 {
-			fractions -= hh // Accumulate fraction units (negative values)
-			last = i
-		} else {
-			rest = rest - hh - style.Vertical()
-		}
-		if i > 0 {
-			rest -= f.spacing
-		}
-	}
-
-	// Calculate space per fraction unit
-	if fractions > 0 {
-		fraction = rest / fractions
-	}
-
-	// Position children from top to bottom
-	y := cy
-	for i, child := range f.children {
-		hw, hh := child.Hint()
-		style = child.Style()
-
-		// Calculate x position and width according to alignment
-		wx, ww := align(f.alignment, cx, cx+cw, hw+style.Horizontal())
-		if hh < 0 {
-			if i != last {
-				child.SetBounds(wx, y, ww, -fraction*hh)
-				rest = rest - fraction*(-hh)
-			} else {
-				// Last flexible child gets remaining space to handle rounding
-				child.SetBounds(wx, y, ww, rest)
-			}
-		} else {
-			child.SetBounds(wx, y, ww, hh+style.Vertical())
-		}
-		_, _, _, height := child.Bounds()
-		f.Log(f, Debug, "Child", "i", i, "ID", child.ID(), "hint", hh, "height", height)
-		y += height + f.spacing
-	}
-}
-
 // align calculates the position and size for a widget within a container
 // based on the specified alignment mode. This function is used for cross-axis
 // alignment in flex layouts (horizontal alignment in vertical flex, etc.).
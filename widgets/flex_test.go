@@ -529,3 +529,146 @@ func TestAlign(t *testing.T) {
 		})
 	}
 }
+
+// ── FlexItem ─────────────────────────────────────────────────────────────────
+
+// flexWidths lays out f and returns the x positions and widths of its
+// children.
+func flexWidths(f *Flex) (xs, ws []int) {
+	f.Layout()
+	for _, child := range f.Children() {
+		x, _, w, _ := child.Bounds()
+		xs = append(xs, x)
+		ws = append(ws, w)
+	}
+	return xs, ws
+}
+
+// sized returns a component with the given size hint.
+func sized(id string, w, h int) *Component {
+	c := NewComponent(id, "")
+	c.SetHint(w, h)
+	return c
+}
+
+func TestFlex_Item_GrowAndMax(t *testing.T) {
+	f := NewFlex("flex", "", Stretch, 0)
+	f.SetBounds(0, 0, 60, 5)
+	f.Add(sized("a", 10, 1), FlexItem{Grow: 1, Max: 20})
+	f.Add(sized("b", 10, 1), FlexItem{Grow: 1})
+	f.Add(sized("c", 10, 1))
+
+	_, ws := flexWidths(f)
+	// 30 free cells: a would get 15 but stops at 20, b takes the rest.
+	if ws[0] != 20 || ws[1] != 30 || ws[2] != 10 {
+		t.Errorf("widths = %v; want [20 30 10]", ws)
+	}
+}
+
+func TestFlex_Item_BasisAndFraction(t *testing.T) {
+	f := NewFlex("flex", "", Stretch, 2)
+	f.SetBounds(0, 0, 50, 5)
+	f.Add(sized("a", 10, 1), FlexItem{Basis: 16})
+	f.Add(sized("b", -1, 1), FlexItem{Min: 40})
+	f.Add(sized("c", -1, 1))
+
+	xs, ws := flexWidths(f)
+	// b grows by its fraction but is held at its minimum, so c gets nothing.
+	if ws[0] != 16 || ws[1] != 40 || ws[2] != 0 {
+		t.Errorf("widths = %v; want [16 40 0]", ws)
+	}
+	if xs[1] != 18 {
+		t.Errorf("b x = %d; want 18 after basis and spacing", xs[1])
+	}
+}
+
+func TestFlex_Item_ShrinkToMin(t *testing.T) {
+	f := NewFlex("flex", "", Stretch, 0)
+	f.SetBounds(0, 0, 40, 5)
+	f.Add(sized("a", 30, 1), FlexItem{Shrink: 1, Min: 25})
+	f.Add(sized("b", 30, 1), FlexItem{Shrink: 1})
+
+	_, ws := flexWidths(f)
+	// 20 cells missing: 10 each, but a stops at 25 so b gives up 15.
+	if ws[0] != 25 || ws[1] != 15 {
+		t.Errorf("widths = %v; want [25 15]", ws)
+	}
+}
+
+func TestFlex_Item_Collapse(t *testing.T) {
+	f := NewFlex("flex", "", Stretch, 1)
+	sidebar := sized("sidebar", 25, 1)
+	content := sized("content", 0, 1)
+	f.Add(sidebar, FlexItem{Min: 20, Shrink: 1, Collapse: true})
+	f.Add(content, FlexItem{Min: 20, Grow: 1})
+
+	f.SetBounds(0, 0, 60, 5)
+	_, ws := flexWidths(f)
+	if ws[0] != 25 || ws[1] != 34 || f.Collapsed(sidebar) {
+		t.Errorf("wide: widths = %v collapsed = %t; want [25 34] false", ws, f.Collapsed(sidebar))
+	}
+
+	f.SetBounds(0, 0, 45, 5)
+	_, ws = flexWidths(f)
+	// 44 cells after spacing, one short of 25 + 20: the sidebar shrinks.
+	if ws[0] != 24 || ws[1] != 20 {
+		t.Errorf("narrow: widths = %v; want the sidebar shrunk to [24 20]", ws)
+	}
+
+	f.SetBounds(0, 0, 30, 5)
+	xs, ws := flexWidths(f)
+	if !f.Collapsed(sidebar) || !sidebar.Flag(FlagHidden) {
+		t.Fatal("sidebar should collapse below the minimum sizes")
+	}
+	if xs[1] != 0 || ws[1] != 30 {
+		t.Errorf("content = x %d, w %d; want the full width", xs[1], ws[1])
+	}
+
+	f.SetBounds(0, 0, 60, 5)
+	f.Layout()
+	if f.Collapsed(sidebar) || sidebar.Flag(FlagHidden) {
+		t.Error("sidebar should reappear when there is room again")
+	}
+}
+
+func TestFlex_Wrap(t *testing.T) {
+	f := NewFlex("flex", "", Start, 1)
+	f.SetFlag(FlagWrap, true)
+	f.SetBounds(0, 0, 20, 10)
+	a, b, c := sized("a", 8, 1), sized("b", 8, 2), sized("c", 8, 1)
+	f.Add(a)
+	f.Add(b)
+	f.Add(c, FlexItem{Grow: 1})
+	f.Layout()
+
+	for _, tc := range []struct {
+		w           *Component
+		x, y, width int
+	}{{a, 0, 0, 8}, {b, 9, 0, 8}, {c, 0, 3, 20}} {
+		x, y, w, _ := tc.w.Bounds()
+		if x != tc.x || y != tc.y || w != tc.width {
+			t.Errorf("%s = %d,%d w %d; want %d,%d w %d", tc.w.ID(), x, y, w, tc.x, tc.y, tc.width)
+		}
+	}
+}
+
+func TestFlex_Item_InsertAndRemove(t *testing.T) {
+	f := NewFlex("flex", "", Stretch, 0)
+	a := sized("a", 5, 1)
+	f.Add(a)
+	f.Insert(0, sized("b", 5, 1), &FlexItem{Grow: 2})
+	if got := f.Item(f.Children()[0]); got.Grow != 2 {
+		t.Errorf("inserted item = %+v; want Grow 2", got)
+	}
+	f.SetItem(a, FlexItem{Max: 3})
+	if got := f.Item(a); got.Max != 3 {
+		t.Errorf("SetItem: item = %+v; want Max 3", got)
+	}
+	f.Remove(a)
+	if got := f.Item(a); got != (FlexItem{}) {
+		t.Errorf("removed child keeps item %+v", got)
+	}
+	if w, _ := f.Hint(); w != 5 {
+		t.Errorf("Hint width = %d; want 5", w)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"time"
 
 	. "github.com/tekugo/zeichenwerk/core"
 )
@@ -147,6 +148,7 @@ func (f *Form) Render(r *Renderer) {
 //   - Input → int field:    parses decimal; ignores the change on parse error
 //   - Checkbox → bool:      sets the struct field to the new boolean
 //   - Select → string field: sets the struct field to the selected value
+//   - DatePicker → time.Time: sets the struct field to the picked date
 func (f *Form) Update(value reflect.Value) Handler {
 	return func(widget Widget, event Event, data ...any) bool {
 		switch widget.(type) {
@@ -192,6 +194,13 @@ func (f *Form) Update(value reflect.Value) Handler {
 			if value.Kind() == reflect.String {
 				value.SetString(str)
 			}
+		case *DatePicker:
+			if len(data) == 0 {
+				return false
+			}
+			if t, ok := data[0].(time.Time); ok && value.Type() == timeType {
+				value.Set(reflect.ValueOf(t))
+			}
 		default:
 			widget.Log(widget, Warning, "Unknown widget type to update")
 		}
@@ -255,7 +264,7 @@ func BuildFormGroupAt(form *Form, group *FormGroup, v reflect.Value, name string
 		if !sf.IsExported() {
 			continue
 		}
-		if !supportedFieldKind(fv.Kind()) {
+		if !supportedField(fv) {
 			continue
 		}
 		buildOneFieldControl(form, group, sf, fv, name, theme, &line)
@@ -280,7 +289,8 @@ func buildOneFieldControl(form *Form, group *FormGroup, sf reflect.StructField,
 	control := sf.Tag.Get("control")
 	options := sf.Tag.Get("options")
 	_, readonly := sf.Tag.Lookup("readonly")
-	width, err := strconv.Atoi(sf.Tag.Get("width"))
+	tag, sized := sf.Tag.Lookup("width")
+	width, err := strconv.Atoi(tag)
 	if err != nil {
 		width = 10
 	}
@@ -296,6 +306,10 @@ func buildOneFieldControl(form *Form, group *FormGroup, sf reflect.StructField,
 		// + color preview) is layout-only.
 		bound.SetFlag(FlagReadonly, true)
 	}
+	if !sized && fv.Type() == timeType {
+		// Dates are wider than the default; use the picker's own width.
+		width, _ = outer.Hint()
+	}
 	outer.SetHint(width, 1)
 	bound.On(EvtChange, form.Update(fv))
 	group.Add(outer, *line, label)
@@ -323,13 +337,22 @@ func buildGroupFields(form *Form, group *FormGroup, v reflect.Value, name string
 		if !sf.IsExported() {
 			continue
 		}
-		if !supportedFieldKind(fv.Kind()) {
+		if !supportedField(fv) {
 			continue
 		}
 		buildOneFieldControl(form, group, sf, fv, name, theme, line)
 	}
 }
 
+// timeType is the type of time.Time fields, which get a DatePicker.
+var timeType = reflect.TypeFor[time.Time]()
+
+// supportedField reports whether the field has a matching control in
+// buildFormControl: time.Time or one of the supported kinds.
+func supportedField(v reflect.Value) bool {
+	return v.Type() == timeType || supportedFieldKind(v.Kind())
+}
+
 // supportedFieldKind reports whether the field's reflect.Kind has a
 // matching control in buildFormControl. Unsupported kinds (Slice, Array,
 // Map, Chan, Func, Interface, etc.) are skipped during form rendering.
@@ -361,8 +384,10 @@ func supportedFieldKind(k reflect.Kind) bool {
 // use either.
 func buildFormControl(control, id, class string, v reflect.Value, options string, theme *Theme) (outer, bound Widget) {
 	if control == "" {
-		switch v.Kind() {
-		case reflect.Bool:
+		switch {
+		case v.Type() == timeType:
+			control = "date"
+		case v.Kind() == reflect.Bool:
 			control = "checkbox"
 		default:
 			control = "input"
@@ -423,6 +448,16 @@ func buildFormControl(control, id, class string, v reflect.Value, options string
 		w.Apply(theme)
 		w.Select(v.String())
 		return w, w
+	case "date", "datetime":
+		// time.Time fields. "datetime" adds the time spinner to the
+		// calendar popup and shows the time of day.
+		w := NewDatePicker(id, class)
+		w.Apply(theme)
+		w.SetShowTime(control == "datetime")
+		if t, ok := v.Interface().(time.Time); ok {
+			w.SetDate(t)
+		}
+		return w, w
 	case "color":
 		// Composite: an Input the user types into, plus a small
 		// Static block whose foreground tracks the current
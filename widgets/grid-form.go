@@ -106,10 +106,17 @@ func (f *GridForm) LayoutForm(parent core.Container, child core.Widget) core.Lay
 // walker is responsible for the closing ".End()". Rows and Columns
 // emit after the standard ComponentForm chain (Hint / flags /
 // style) because they are kind-specific tail methods on the Grid.
+// In compose mode, rows and columns are constructor arguments.
 func (f *GridForm) Emit(w io.Writer, mode string) error {
 	rows, _ := parseIntCSV(f.Rows)
 	cols, _ := parseIntCSV(f.Columns)
 
+	if mode == "compose" {
+		return f.EmitFrame(w, mode, func() error {
+			return f.EmitConstructor(w, mode, "Grid",
+				fmt.Sprintf("[]int{%s}, []int{%s}, %t", intsCSV(rows), intsCSV(cols), f.Lines))
+		})
+	}
 	if err := f.EmitFrame(w, mode, func() error {
 		_, err := fmt.Fprintf(w, "Grid(%q, %d, %d, %t).\n",
 			f.ID, len(rows), len(cols), f.Lines)
@@ -167,14 +174,17 @@ func (f *GridLayoutForm) Validate(field string) error { return nil }
 
 // Emit writes the Cell prefix that precedes the child's constructor.
 // Indentation is gofmt's responsibility; trailing ".\n" continues
-// the chain to the child's call.
+// the chain to the child's call. In compose mode the prefix opens a
+// Cell wrapper, "Cell(x, y, w, h, ", which the codegen walker closes
+// after the child.
 func (f *GridLayoutForm) Emit(w io.Writer, mode string) error {
 	switch mode {
 	case "builder":
 		fmt.Fprintf(w, "Cell(%d, %d, %d, %d).\n", f.X, f.Y, f.W, f.H)
 		return nil
 	case "compose":
-		return fmt.Errorf("compose mode not implemented")
+		fmt.Fprintf(w, "Cell(%d, %d, %d, %d, ", f.X, f.Y, f.W, f.H)
+		return nil
 	}
 	return fmt.Errorf("unknown mode %q", mode)
 }
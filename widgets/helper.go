@@ -2,6 +2,8 @@ package widgets
 
 import (
 	"reflect"
+	"strings"
+	"unicode/utf8"
 
 	. "github.com/tekugo/zeichenwerk/core"
 )
@@ -54,6 +56,24 @@ func Relayout(widget Widget) {
 	}
 }
 
+// capture routes all mouse events to the widget while on is set, so a drag
+// keeps working outside of its bounds. It does nothing if the root does not
+// support mouse capture.
+func capture(widget Widget, on bool) {
+	root, ok := FindRoot(widget).(interface {
+		Capture(Widget)
+		Release(Widget)
+	})
+	if !ok {
+		return
+	}
+	if on {
+		root.Capture(widget)
+	} else {
+		root.Release(widget)
+	}
+}
+
 // WidgetType returns a clean, human-readable string representation of the
 // widget's type, using the unqualified Go type name.
 func WidgetType(widget Widget) string {
@@ -69,3 +89,25 @@ func WidgetType(widget Widget) string {
 	}
 	return "Widget"
 }
+
+// wrapWords word-wraps text to lines of at most width runes, keeping the
+// line breaks in it. Words longer than a line are cut when drawn.
+func wrapWords(text string, width int) []string {
+	var lines []string
+	for _, para := range strings.Split(text, "\n") {
+		line := ""
+		for _, word := range strings.Fields(para) {
+			switch {
+			case line == "":
+				line = word
+			case utf8.RuneCountInString(line)+1+utf8.RuneCountInString(word) > width:
+				lines = append(lines, line)
+				line = word
+			default:
+				line += " " + word
+			}
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
@@ -0,0 +1,105 @@
+package widgets
+
+// goKeywords are the Go language keywords.
+var goKeywords = map[string]bool{
+	"break": true, "case": true, "chan": true, "const": true, "continue": true,
+	"default": true, "defer": true, "else": true, "fallthrough": true, "for": true,
+	"func": true, "go": true, "goto": true, "if": true, "import": true,
+	"interface": true, "map": true, "package": true, "range": true, "return": true,
+	"select": true, "struct": true, "switch": true, "type": true, "var": true,
+}
+
+// goTypes are the predeclared Go types.
+var goTypes = map[string]bool{
+	"any": true, "bool": true, "byte": true, "comparable": true, "complex64": true,
+	"complex128": true, "error": true, "float32": true, "float64": true, "int": true,
+	"int8": true, "int16": true, "int32": true, "int64": true, "rune": true,
+	"string": true, "uint": true, "uint8": true, "uint16": true, "uint32": true,
+	"uint64": true, "uintptr": true,
+}
+
+// goConstants are the predeclared Go constants and the zero value nil.
+var goConstants = map[string]bool{
+	"true": true, "false": true, "iota": true, "nil": true,
+}
+
+// Line states of the Go highlighter.
+const (
+	goNormal       = iota // outside multi-line constructs
+	goBlockComment        // inside /* ... */
+	goRawString           // inside a `raw string`
+)
+
+// GoHighlighter highlights Go source code: keywords, predeclared types
+// and constants, numbers, interpreted, raw and rune literals, and line
+// and block comments. Block comments and raw strings may span lines.
+type GoHighlighter struct{}
+
+// Highlight implements Highlighter.
+func (GoHighlighter) Highlight(line string, state int) ([]TokenSpan, int) {
+	runes := []rune(line)
+	var spans []TokenSpan
+	i := 0
+
+	switch state {
+	case goBlockComment:
+		end := indexFrom(runes, 0, "*/")
+		if end < 0 {
+			return []TokenSpan{{0, len(runes), TokenComment}}, goBlockComment
+		}
+		spans = append(spans, TokenSpan{0, end + 2, TokenComment})
+		i = end + 2
+	case goRawString:
+		end, closed := scanQuoted(runes, 0, '`', false)
+		spans = append(spans, TokenSpan{0, end, TokenString})
+		if !closed {
+			return spans, goRawString
+		}
+		i = end
+	}
+
+	for i < len(runes) {
+		ch := runes[i]
+		switch {
+		case ch == '/' && i+1 < len(runes) && runes[i+1] == '/':
+			return append(spans, TokenSpan{i, len(runes), TokenComment}), goNormal
+		case ch == '/' && i+1 < len(runes) && runes[i+1] == '*':
+			end := indexFrom(runes, i+2, "*/")
+			if end < 0 {
+				return append(spans, TokenSpan{i, len(runes), TokenComment}), goBlockComment
+			}
+			spans = append(spans, TokenSpan{i, end + 2, TokenComment})
+			i = end + 2
+		case ch == '`':
+			end, closed := scanQuoted(runes, i+1, '`', false)
+			spans = append(spans, TokenSpan{i, end, TokenString})
+			if !closed {
+				return spans, goRawString
+			}
+			i = end
+		case ch == '"' || ch == '\'':
+			end, _ := scanQuoted(runes, i+1, ch, true)
+			spans = append(spans, TokenSpan{i, end, TokenString})
+			i = end
+		case isDigit(ch) || (ch == '.' && i+1 < len(runes) && isDigit(runes[i+1])):
+			end := scanNumber(runes, i)
+			spans = append(spans, TokenSpan{i, end, TokenNumber})
+			i = end
+		case isIdentStart(ch):
+			end := scanIdent(runes, i)
+			word := string(runes[i:end])
+			switch {
+			case goKeywords[word]:
+				spans = append(spans, TokenSpan{i, end, TokenKeyword})
+			case goTypes[word]:
+				spans = append(spans, TokenSpan{i, end, TokenType})
+			case goConstants[word]:
+				spans = append(spans, TokenSpan{i, end, TokenConstant})
+			}
+			i = end
+		default:
+			i++
+		}
+	}
+	return spans, goNormal
+}
@@ -0,0 +1,45 @@
+package widgets
+
+// JSONHighlighter highlights JSON documents: object keys, string values,
+// numbers and the literals true, false and null. JSON has no multi-line
+// tokens, so the state is always 0.
+type JSONHighlighter struct{}
+
+// Highlight implements Highlighter.
+func (JSONHighlighter) Highlight(line string, _ int) ([]TokenSpan, int) {
+	runes := []rune(line)
+	var spans []TokenSpan
+	i := 0
+	for i < len(runes) {
+		ch := runes[i]
+		switch {
+		case ch == '"':
+			end, _ := scanQuoted(runes, i+1, '"', true)
+			style := TokenString
+			// A string followed by a colon is an object key.
+			j := end
+			for j < len(runes) && (runes[j] == ' ' || runes[j] == '\t') {
+				j++
+			}
+			if j < len(runes) && runes[j] == ':' {
+				style = TokenKey
+			}
+			spans = append(spans, TokenSpan{i, end, style})
+			i = end
+		case isDigit(ch) || (ch == '-' && i+1 < len(runes) && isDigit(runes[i+1])):
+			end := scanNumber(runes, i+1)
+			spans = append(spans, TokenSpan{i, end, TokenNumber})
+			i = end
+		case isIdentStart(ch):
+			end := scanIdent(runes, i)
+			switch string(runes[i:end]) {
+			case "true", "false", "null":
+				spans = append(spans, TokenSpan{i, end, TokenConstant})
+			}
+			i = end
+		default:
+			i++
+		}
+	}
+	return spans, 0
+}
@@ -0,0 +1,130 @@
+package widgets
+
+import "strings"
+
+// Line states of the Markdown highlighter.
+const (
+	mdNormal     = iota // outside fenced code blocks
+	mdFenceTick         // inside a ``` fenced code block
+	mdFenceTilde        // inside a ~~~ fenced code block
+)
+
+// MarkdownHighlighter highlights Markdown: headings, fenced code blocks
+// (which may span lines), block quotes, list markers, inline code,
+// emphasis and links.
+type MarkdownHighlighter struct{}
+
+// Highlight implements Highlighter.
+func (MarkdownHighlighter) Highlight(line string, state int) ([]TokenSpan, int) {
+	runes := []rune(line)
+	trimmed := strings.TrimLeft(line, " ")
+	indent := len(runes) - len([]rune(trimmed))
+	whole := []TokenSpan{{0, len(runes), TokenCode}}
+
+	switch state {
+	case mdFenceTick:
+		if strings.HasPrefix(trimmed, "```") {
+			return whole, mdNormal
+		}
+		return whole, mdFenceTick
+	case mdFenceTilde:
+		if strings.HasPrefix(trimmed, "~~~") {
+			return whole, mdNormal
+		}
+		return whole, mdFenceTilde
+	}
+
+	switch {
+	case strings.HasPrefix(trimmed, "```"):
+		return whole, mdFenceTick
+	case strings.HasPrefix(trimmed, "~~~"):
+		return whole, mdFenceTilde
+	case indent < 4 && strings.HasPrefix(trimmed, "#"):
+		level := len(trimmed) - len(strings.TrimLeft(trimmed, "#"))
+		if level <= 6 && (len(trimmed) == level || trimmed[level] == ' ') {
+			return []TokenSpan{{0, len(runes), TokenHeading}}, mdNormal
+		}
+	case strings.HasPrefix(trimmed, ">"):
+		return []TokenSpan{{0, len(runes), TokenComment}}, mdNormal
+	}
+
+	var spans []TokenSpan
+	i := indent
+	if end := markdownListMarker(runes, i); end > i {
+		spans = append(spans, TokenSpan{i, end, TokenKeyword})
+		i = end
+	}
+	return markdownInline(runes, i, spans), mdNormal
+}
+
+// markdownListMarker returns the end of a list marker ("-", "*", "+" or
+// "1." followed by a space) starting at i, or i if there is none.
+func markdownListMarker(runes []rune, i int) int {
+	if i >= len(runes) {
+		return i
+	}
+	end := i
+	switch runes[i] {
+	case '-', '*', '+':
+		end = i + 1
+	default:
+		for end < len(runes) && isDigit(runes[end]) {
+			end++
+		}
+		if end == i || end >= len(runes) || (runes[end] != '.' && runes[end] != ')') {
+			return i
+		}
+		end++
+	}
+	if end < len(runes) && runes[end] != ' ' {
+		return i
+	}
+	return end
+}
+
+// markdownInline highlights inline code, emphasis and links from i on.
+func markdownInline(runes []rune, i int, spans []TokenSpan) []TokenSpan {
+	for i < len(runes) {
+		ch := runes[i]
+		switch {
+		case ch == '\\':
+			i += 2
+		case ch == '`':
+			end := indexFrom(runes, i+1, "`")
+			if end < 0 {
+				i++
+				continue
+			}
+			spans = append(spans, TokenSpan{i, end + 1, TokenCode})
+			i = end + 1
+		case ch == '*' || ch == '_':
+			delim := string(ch)
+			if i+1 < len(runes) && runes[i+1] == ch {
+				delim += delim
+			}
+			end := indexFrom(runes, i+len(delim), delim)
+			if end <= i+len(delim) || runes[i+len(delim)] == ' ' {
+				i += len(delim)
+				continue
+			}
+			spans = append(spans, TokenSpan{i, end + len(delim), TokenEmphasis})
+			i = end + len(delim)
+		case ch == '[':
+			closing := indexFrom(runes, i+1, "](")
+			if closing < 0 {
+				i++
+				continue
+			}
+			end := indexFrom(runes, closing+2, ")")
+			if end < 0 {
+				i++
+				continue
+			}
+			spans = append(spans, TokenSpan{i, end + 1, TokenLink})
+			i = end + 1
+		default:
+			i++
+		}
+	}
+	return spans
+}
@@ -0,0 +1,114 @@
+package widgets
+
+import "strings"
+
+// shellKeywords are the reserved words and declaration builtins of POSIX
+// shells and bash.
+var shellKeywords = map[string]bool{
+	"if": true, "then": true, "else": true, "elif": true, "fi": true,
+	"for": true, "while": true, "until": true, "do": true, "done": true,
+	"case": true, "esac": true, "in": true, "function": true, "select": true,
+	"return": true, "exit": true, "export": true, "local": true,
+	"readonly": true, "declare": true, "unset": true, "shift": true,
+}
+
+// Line states of the shell highlighter.
+const (
+	shNormal = iota // outside multi-line strings
+	shSingle        // inside a '...' string
+	shDouble        // inside a "..." string
+)
+
+// ShellHighlighter highlights POSIX shell and bash scripts: keywords,
+// comments, single- and double-quoted strings (which may span lines),
+// command substitutions, variables and numbers.
+type ShellHighlighter struct{}
+
+// Highlight implements Highlighter.
+func (ShellHighlighter) Highlight(line string, state int) ([]TokenSpan, int) {
+	runes := []rune(line)
+	var spans []TokenSpan
+	i := 0
+
+	switch state {
+	case shSingle, shDouble:
+		quote := '\''
+		if state == shDouble {
+			quote = '"'
+		}
+		end, closed := scanQuoted(runes, 0, quote, state == shDouble)
+		spans = append(spans, TokenSpan{0, end, TokenString})
+		if !closed {
+			return spans, state
+		}
+		i = end
+	}
+
+	for i < len(runes) {
+		ch := runes[i]
+		switch {
+		case ch == '\\':
+			i += 2
+		case ch == '#' && (i == 0 || strings.ContainsRune(" \t;|&(", runes[i-1])):
+			return append(spans, TokenSpan{i, len(runes), TokenComment}), shNormal
+		case ch == '\'' || ch == '"':
+			end, closed := scanQuoted(runes, i+1, ch, ch == '"')
+			spans = append(spans, TokenSpan{i, end, TokenString})
+			if !closed {
+				if ch == '"' {
+					return spans, shDouble
+				}
+				return spans, shSingle
+			}
+			i = end
+		case ch == '`':
+			end, _ := scanQuoted(runes, i+1, '`', true)
+			spans = append(spans, TokenSpan{i, end, TokenString})
+			i = end
+		case ch == '$':
+			end := shellVariable(runes, i)
+			if end > i+1 {
+				spans = append(spans, TokenSpan{i, end, TokenVariable})
+			}
+			i = max(end, i+1)
+		case strings.ContainsRune(" \t;|&()<>", ch):
+			i++
+		default:
+			end := i
+			for end < len(runes) && !strings.ContainsRune(" \t;|&()<>'\"`$\\", runes[end]) {
+				end++
+			}
+			word := string(runes[i:end])
+			if shellKeywords[word] {
+				spans = append(spans, TokenSpan{i, end, TokenKeyword})
+			} else if isNumberWord(word) {
+				spans = append(spans, TokenSpan{i, end, TokenNumber})
+			}
+			i = max(end, i+1)
+		}
+	}
+	return spans, shNormal
+}
+
+// shellVariable returns the end of a variable reference or the opening of
+// a command substitution starting with the $ at i.
+func shellVariable(runes []rune, i int) int {
+	j := i + 1
+	if j >= len(runes) {
+		return j
+	}
+	switch ch := runes[j]; {
+	case ch == '{':
+		if end := indexFrom(runes, j+1, "}"); end >= 0 {
+			return end + 1
+		}
+		return len(runes)
+	case ch == '(':
+		return j + 1
+	case isIdentStart(ch):
+		return scanIdent(runes, j)
+	case isDigit(ch) || strings.ContainsRune("@*#?$!-", ch):
+		return j + 1
+	}
+	return j
+}
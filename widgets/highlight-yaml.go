@@ -0,0 +1,200 @@
+package widgets
+
+import "strings"
+
+// yamlConstants are the plain scalars resolved as booleans or null.
+var yamlConstants = map[string]bool{
+	"true": true, "false": true, "yes": true, "no": true, "on": true, "off": true,
+	"null": true, "~": true, "True": true, "False": true, "Null": true,
+	"TRUE": true, "FALSE": true, "NULL": true,
+}
+
+// YAMLHighlighter highlights YAML documents: mapping keys, quoted
+// strings, numbers, booleans and null, anchors and aliases, tags, comments,
+// sequence markers and document markers. Block scalars (| and >) are
+// highlighted as strings across lines; the state holds the indentation of
+// their parent plus one, or 0 outside a block scalar.
+type YAMLHighlighter struct{}
+
+// Highlight implements Highlighter.
+func (YAMLHighlighter) Highlight(line string, state int) ([]TokenSpan, int) {
+	runes := []rune(line)
+	indent := 0
+	for indent < len(runes) && runes[indent] == ' ' {
+		indent++
+	}
+
+	// Block scalar content continues while lines are blank or indented
+	// deeper than the parent.
+	if state > 0 {
+		if strings.TrimSpace(line) == "" {
+			return nil, state
+		}
+		if indent > state-1 {
+			return []TokenSpan{{indent, len(runes), TokenString}}, state
+		}
+	}
+
+	var spans []TokenSpan
+	i := indent
+	if i < len(runes) && runes[i] == '#' {
+		return []TokenSpan{{i, len(runes), TokenComment}}, 0
+	}
+	if i == 0 && (strings.HasPrefix(line, "---") || strings.HasPrefix(line, "...")) &&
+		(len(runes) == 3 || runes[3] == ' ') {
+		spans = append(spans, TokenSpan{0, 3, TokenKeyword})
+		i = 3
+	}
+
+	// Sequence item markers, possibly nested ("- - a").
+	for i < len(runes) && runes[i] == '-' && (i+1 == len(runes) || runes[i+1] == ' ') {
+		spans = append(spans, TokenSpan{i, i + 1, TokenKeyword})
+		i++
+		for i < len(runes) && runes[i] == ' ' {
+			i++
+		}
+	}
+
+	// Mapping key.
+	parent := indent
+	if end, ok := yamlKey(runes, i); ok {
+		spans = append(spans, TokenSpan{i, end, TokenKey})
+		parent = i
+		i = end + 1 // skip the colon
+	}
+
+	spans, block := yamlValue(runes, i, spans)
+	if block {
+		return spans, parent + 1
+	}
+	return spans, 0
+}
+
+// yamlKey detects a mapping key starting at i. It returns the column of
+// the colon that ends the key.
+func yamlKey(runes []rune, i int) (int, bool) {
+	if i >= len(runes) {
+		return 0, false
+	}
+	end := i
+	if runes[i] == '"' || runes[i] == '\'' {
+		end, _ = scanQuoted(runes, i+1, runes[i], runes[i] == '"')
+		if end < len(runes) && runes[end] == ':' && (end+1 == len(runes) || runes[end+1] == ' ') {
+			return end, true
+		}
+		return 0, false
+	}
+	if runes[i] == '[' || runes[i] == '{' || runes[i] == '#' {
+		return 0, false
+	}
+	for ; end < len(runes); end++ {
+		if runes[end] == ':' && (end+1 == len(runes) || runes[end+1] == ' ') {
+			return end, end > i
+		}
+		if runes[end] == '#' && end > 0 && runes[end-1] == ' ' {
+			return 0, false
+		}
+	}
+	return 0, false
+}
+
+// yamlValue highlights the value part of a line starting at i. It reports
+// whether the value opens a block scalar.
+func yamlValue(runes []rune, i int, spans []TokenSpan) ([]TokenSpan, bool) {
+	flow := 0
+	start := i
+	for i < len(runes) {
+		ch := runes[i]
+		switch {
+		case ch == ' ' || ch == '\t':
+			i++
+		case ch == '#' && (i == start || runes[i-1] == ' ' || runes[i-1] == '\t'):
+			return append(spans, TokenSpan{i, len(runes), TokenComment}), false
+		case ch == '"' || ch == '\'':
+			end, _ := scanQuoted(runes, i+1, ch, ch == '"')
+			spans = append(spans, TokenSpan{i, end, TokenString})
+			i = end
+		case ch == '&' || ch == '*':
+			end := yamlWordEnd(runes, i+1, flow > 0)
+			spans = append(spans, TokenSpan{i, end, TokenVariable})
+			i = end
+		case ch == '!':
+			end := yamlWordEnd(runes, i+1, flow > 0)
+			spans = append(spans, TokenSpan{i, end, TokenType})
+			i = end
+		case ch == '[' || ch == '{':
+			flow++
+			i++
+		case ch == ']' || ch == '}':
+			flow--
+			i++
+		case ch == ',' && flow > 0:
+			i++
+		case (ch == '|' || ch == '>') && flow == 0 && yamlBlockIndicator(runes, i):
+			spans = append(spans, TokenSpan{i, i + 1, TokenKeyword})
+			j := i + 1
+			for j < len(runes) && runes[j] != ' ' {
+				j++
+			}
+			return yamlValueRest(runes, j, spans), true
+		default:
+			end := yamlWordEnd(runes, i, flow > 0)
+			word := string(runes[i:end])
+			if yamlConstants[word] {
+				spans = append(spans, TokenSpan{i, end, TokenConstant})
+			} else if isNumberWord(word) {
+				spans = append(spans, TokenSpan{i, end, TokenNumber})
+			}
+			i = max(end, i+1)
+		}
+	}
+	return spans, false
+}
+
+// yamlValueRest highlights a trailing comment after a block indicator.
+func yamlValueRest(runes []rune, i int, spans []TokenSpan) []TokenSpan {
+	for ; i < len(runes); i++ {
+		if runes[i] == '#' {
+			return append(spans, TokenSpan{i, len(runes), TokenComment})
+		}
+	}
+	return spans
+}
+
+// yamlBlockIndicator reports whether the | or > at i starts a block scalar,
+// i.e. it is followed only by modifiers and an optional comment.
+func yamlBlockIndicator(runes []rune, i int) bool {
+	for j := i + 1; j < len(runes); j++ {
+		switch ch := runes[j]; {
+		case ch == '-' || ch == '+' || isDigit(ch):
+		case ch == ' ' || ch == '\t':
+			for k := j; k < len(runes); k++ {
+				if runes[k] == '#' {
+					return true
+				}
+				if runes[k] != ' ' && runes[k] != '\t' {
+					return false
+				}
+			}
+			return true
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// yamlWordEnd returns the end of a plain scalar word starting at i. In
+// flow context (inside [] or {}), commas and closing brackets end it too.
+func yamlWordEnd(runes []rune, i int, flow bool) int {
+	for ; i < len(runes); i++ {
+		ch := runes[i]
+		if ch == ' ' || ch == '\t' {
+			return i
+		}
+		if flow && (ch == ',' || ch == ']' || ch == '}') {
+			return i
+		}
+	}
+	return i
+}
@@ -0,0 +1,160 @@
+package widgets
+
+import (
+	"path/filepath"
+	"strings"
+	"unicode"
+)
+
+// Token classes returned in TokenSpan.Style by the built-in highlighters. Each
+// class is resolved against the editor's theme as a part selector, so
+// "keyword" is styled by "editor/keyword". Only the foreground colour and
+// font of a token style are used; the background stays that of the line.
+const (
+	TokenCode     = "code"     // inline or fenced code (Markdown)
+	TokenComment  = "comment"  // comments
+	TokenConstant = "constant" // true, false, nil, null and similar
+	TokenEmphasis = "emphasis" // emphasised or strong text (Markdown)
+	TokenHeading  = "heading"  // headings (Markdown)
+	TokenKey      = "key"      // object keys (JSON, YAML)
+	TokenKeyword  = "keyword"  // language keywords, list markers
+	TokenLink     = "link"     // links (Markdown)
+	TokenNumber   = "number"   // numeric literals
+	TokenString   = "string"   // string and rune literals
+	TokenType     = "type"     // predeclared types, YAML tags
+	TokenVariable = "variable" // shell variables, YAML anchors and aliases
+)
+
+// TokenSpan is a styled range of a line. Columns are rune based, like the
+// editor cursor.
+type TokenSpan struct {
+	Start int    // first column of the span
+	End   int    // column after the last rune of the span
+	Style string // token class, resolved as the "editor/<Style>" part
+}
+
+// Highlighter splits editor lines into styled spans. The editor calls
+// Highlight for each line it needs, in document order from the first
+// line, passing the state returned for the previous line (0 for the first
+// line). The state carries constructs that span lines, such as block
+// comments or fenced code blocks; a highlighter without such constructs
+// always returns 0.
+//
+// States must only depend on the text of the lines before, so that the
+// editor can cache them and re-highlight only from the first edited line
+// on. Spans must be sorted and must not overlap; text outside any span is
+// drawn in the normal editor style.
+type Highlighter interface {
+	Highlight(line string, state int) ([]TokenSpan, int)
+}
+
+// HighlighterFor returns the built-in highlighter for a language name
+// ("go", "json", "yaml", "markdown", "shell") or a file name or extension
+// (".yml", "main.go", "README.md"). It returns nil if no built-in
+// highlighter matches.
+func HighlighterFor(language string) Highlighter {
+	name := strings.ToLower(language)
+	if ext := filepath.Ext(name); ext != "" {
+		name = ext[1:]
+	}
+	switch name {
+	case "go", "golang":
+		return GoHighlighter{}
+	case "json":
+		return JSONHighlighter{}
+	case "yaml", "yml":
+		return YAMLHighlighter{}
+	case "markdown", "md":
+		return MarkdownHighlighter{}
+	case "shell", "sh", "bash", "zsh":
+		return ShellHighlighter{}
+	}
+	return nil
+}
+
+// ---- Scanner Helpers ------------------------------------------------------
+
+// isIdentStart reports whether ch can start an identifier.
+func isIdentStart(ch rune) bool {
+	return ch == '_' || unicode.IsLetter(ch)
+}
+
+// isIdentPart reports whether ch can continue an identifier.
+func isIdentPart(ch rune) bool {
+	return ch == '_' || unicode.IsLetter(ch) || unicode.IsDigit(ch)
+}
+
+// isDigit reports whether ch is an ASCII digit.
+func isDigit(ch rune) bool {
+	return ch >= '0' && ch <= '9'
+}
+
+// scanIdent returns the end of the identifier starting at i.
+func scanIdent(runes []rune, i int) int {
+	for i < len(runes) && isIdentPart(runes[i]) {
+		i++
+	}
+	return i
+}
+
+// scanNumber returns the end of the numeric literal starting at i. It is
+// deliberately lenient and accepts hex, octal, binary, float and exponent
+// forms as well as digit separators.
+func scanNumber(runes []rune, i int) int {
+	for i < len(runes) {
+		ch := runes[i]
+		switch {
+		case isDigit(ch), ch == '.', ch == '_', isIdentPart(ch):
+			i++
+		case (ch == '+' || ch == '-') && i > 0 && (runes[i-1] == 'e' || runes[i-1] == 'E' || runes[i-1] == 'p' || runes[i-1] == 'P'):
+			i++
+		default:
+			return i
+		}
+	}
+	return i
+}
+
+// scanQuoted scans the rest of a quoted literal from the rune after the
+// opening quote (or from the start of a continuation line). It returns the
+// column after the closing quote and whether the literal was closed on
+// this line. Backslash escapes are honoured if escapes is set.
+func scanQuoted(runes []rune, from int, quote rune, escapes bool) (int, bool) {
+	for j := from; j < len(runes); j++ {
+		if escapes && runes[j] == '\\' {
+			j++
+			continue
+		}
+		if runes[j] == quote {
+			return j + 1, true
+		}
+	}
+	return len(runes), false
+}
+
+// indexFrom returns the index of the first occurrence of substr in runes
+// at or after i, or -1.
+func indexFrom(runes []rune, i int, substr string) int {
+	needle := []rune(substr)
+	for j := i; j+len(needle) <= len(runes); j++ {
+		if string(runes[j:j+len(needle)]) == substr {
+			return j
+		}
+	}
+	return -1
+}
+
+// isNumberWord reports whether a whitespace-delimited word is a plain
+// decimal number, optionally signed and with a fraction. It is used for
+// languages whose numbers are not separate tokens, like YAML and shell.
+func isNumberWord(word string) bool {
+	if word == "" {
+		return false
+	}
+	for i, ch := range word {
+		if !isDigit(ch) && !(i > 0 && (ch == '.' || ch == '_')) && !(i == 0 && (ch == '-' || ch == '+') && len(word) > 1) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,241 @@
+package widgets
+
+import (
+	"slices"
+	"testing"
+
+	. "github.com/tekugo/zeichenwerk/core"
+)
+
+// ---- helpers ---------------------------------------------------------------
+
+// tokens returns the text and style of each span, for compact assertions.
+func tokens(line string, spans []TokenSpan) []string {
+	runes := []rune(line)
+	result := make([]string, len(spans))
+	for i, s := range spans {
+		result[i] = s.Style + ":" + string(runes[s.Start:s.End])
+	}
+	return result
+}
+
+// highlightLines highlights lines in order and returns the tokens of each
+// line and the final state.
+func highlightLines(h Highlighter, lines ...string) ([][]string, int) {
+	state := 0
+	result := make([][]string, len(lines))
+	for i, line := range lines {
+		var spans []TokenSpan
+		spans, state = h.Highlight(line, state)
+		result[i] = tokens(line, spans)
+	}
+	return result, state
+}
+
+func expectTokens(t *testing.T, got, want []string) {
+	t.Helper()
+	if !slices.Equal(got, want) {
+		t.Errorf("tokens = %q; want %q", got, want)
+	}
+}
+
+// ---- HighlighterFor --------------------------------------------------------
+
+func TestHighlighterFor(t *testing.T) {
+	tests := map[string]Highlighter{
+		"go":        GoHighlighter{},
+		"main.go":   GoHighlighter{},
+		"JSON":      JSONHighlighter{},
+		"ci.yml":    YAMLHighlighter{},
+		"README.md": MarkdownHighlighter{},
+		"bash":      ShellHighlighter{},
+		"x.txt":     nil,
+	}
+	for name, want := range tests {
+		if got := HighlighterFor(name); got != want {
+			t.Errorf("HighlighterFor(%q) = %T; want %T", name, got, want)
+		}
+	}
+}
+
+// ---- Go --------------------------------------------------------------------
+
+func TestGoHighlighter_Tokens(t *testing.T) {
+	line := `func f(s string) int { return len("a\"b") + 0x1F // done`
+	spans, state := GoHighlighter{}.Highlight(line, 0)
+	expectTokens(t, tokens(line, spans), []string{
+		"keyword:func", "type:string", "type:int", "keyword:return",
+		`string:"a\"b"`, "number:0x1F", "comment:// done",
+	})
+	if state != 0 {
+		t.Errorf("state = %d; want 0", state)
+	}
+}
+
+func TestGoHighlighter_MultiLine(t *testing.T) {
+	got, state := highlightLines(GoHighlighter{},
+		"x := 1 /* start",
+		"still comment",
+		"end */ y := `raw",
+		"raw` + nil",
+	)
+	expectTokens(t, got[0], []string{"number:1", "comment:/* start"})
+	expectTokens(t, got[1], []string{"comment:still comment"})
+	expectTokens(t, got[2], []string{"comment:end */", "string:`raw"})
+	expectTokens(t, got[3], []string{"string:raw`", "constant:nil"})
+	if state != 0 {
+		t.Errorf("final state = %d; want 0", state)
+	}
+}
+
+// ---- JSON ------------------------------------------------------------------
+
+func TestJSONHighlighter_Tokens(t *testing.T) {
+	line := `{"name": "x", "n": -1.5e3, "ok": true, "v": null}`
+	spans, _ := JSONHighlighter{}.Highlight(line, 0)
+	expectTokens(t, tokens(line, spans), []string{
+		`key:"name"`, `string:"x"`, `key:"n"`, "number:-1.5e3",
+		`key:"ok"`, "constant:true", `key:"v"`, "constant:null",
+	})
+}
+
+// ---- YAML ------------------------------------------------------------------
+
+func TestYAMLHighlighter_Tokens(t *testing.T) {
+	line := `- name: "web" # service`
+	spans, _ := YAMLHighlighter{}.Highlight(line, 0)
+	expectTokens(t, tokens(line, spans), []string{
+		"keyword:-", "key:name", `string:"web"`, "comment:# service",
+	})
+
+	line = "  ports: [80, 443, true]"
+	spans, _ = YAMLHighlighter{}.Highlight(line, 0)
+	expectTokens(t, tokens(line, spans), []string{
+		"key:ports", "number:80", "number:443", "constant:true",
+	})
+}
+
+func TestYAMLHighlighter_BlockScalar(t *testing.T) {
+	got, state := highlightLines(YAMLHighlighter{},
+		"script: |",
+		"  echo hi",
+		"",
+		"  exit 1",
+		"next: 2",
+	)
+	expectTokens(t, got[0], []string{"key:script", "keyword:|"})
+	expectTokens(t, got[1], []string{"string:echo hi"})
+	expectTokens(t, got[3], []string{"string:exit 1"})
+	expectTokens(t, got[4], []string{"key:next", "number:2"})
+	if state != 0 {
+		t.Errorf("final state = %d; want 0", state)
+	}
+}
+
+// ---- Markdown --------------------------------------------------------------
+
+func TestMarkdownHighlighter_Tokens(t *testing.T) {
+	line := "- see `code`, **bold** and [docs](x.md)"
+	spans, _ := MarkdownHighlighter{}.Highlight(line, 0)
+	expectTokens(t, tokens(line, spans), []string{
+		"keyword:-", "code:`code`", "emphasis:**bold**", "link:[docs](x.md)",
+	})
+
+	line = "## Title"
+	spans, _ = MarkdownHighlighter{}.Highlight(line, 0)
+	expectTokens(t, tokens(line, spans), []string{"heading:## Title"})
+}
+
+func TestMarkdownHighlighter_Fence(t *testing.T) {
+	got, state := highlightLines(MarkdownHighlighter{},
+		"```go",
+		"# not a heading",
+		"```",
+		"# heading",
+	)
+	expectTokens(t, got[1], []string{"code:# not a heading"})
+	expectTokens(t, got[3], []string{"heading:# heading"})
+	if state != 0 {
+		t.Errorf("final state = %d; want 0", state)
+	}
+}
+
+// ---- Shell -----------------------------------------------------------------
+
+func TestShellHighlighter_Tokens(t *testing.T) {
+	line := `if [ "$HOME" ]; then echo ${USER} 42 # hi`
+	spans, _ := ShellHighlighter{}.Highlight(line, 0)
+	expectTokens(t, tokens(line, spans), []string{
+		"keyword:if", `string:"$HOME"`, "keyword:then", "variable:${USER}",
+		"number:42", "comment:# hi",
+	})
+}
+
+func TestShellHighlighter_MultiLineString(t *testing.T) {
+	got, state := highlightLines(ShellHighlighter{},
+		`echo "one`,
+		`two" done`,
+	)
+	expectTokens(t, got[0], []string{`string:"one`})
+	expectTokens(t, got[1], []string{`string:two"`, "keyword:done"})
+	if state != 0 {
+		t.Errorf("final state = %d; want 0", state)
+	}
+}
+
+// ---- Editor integration ----------------------------------------------------
+
+func TestEditor_Highlighter_StateCache(t *testing.T) {
+	e := newEditor("a /* x", "b", "c */ d", "e")
+	e.SetHighlighter(GoHighlighter{})
+	if got := e.lineState(3); got != goNormal {
+		t.Errorf("state of line 3 = %d; want %d", got, goNormal)
+	}
+	if len(e.states) != 4 {
+		t.Fatalf("cached states = %d; want 4", len(e.states))
+	}
+
+	// Closing the comment on line 0 must invalidate the cached states.
+	e.MoveTo(0, 6)
+	typeEditor(e, " */")
+	if len(e.states) > 1 {
+		t.Errorf("cached states after edit = %d; want at most 1", len(e.states))
+	}
+	if got := e.lineState(1); got != goNormal {
+		t.Errorf("state of line 1 after edit = %d; want %d", got, goNormal)
+	}
+
+	e.Undo()
+	if got := e.lineState(1); got != goBlockComment {
+		t.Errorf("state of line 1 after undo = %d; want %d", got, goBlockComment)
+	}
+}
+
+func TestEditor_Render_HighlightsTokens(t *testing.T) {
+	theme := NewTheme()
+	theme.AddStyles(
+		NewStyle("editor").WithColors("white", "black"),
+		NewStyle("editor/keyword").WithForeground("purple"),
+		NewStyle("editor/string").WithForeground("green"),
+	)
+	e := newEditor(`	go "x"`)
+	e.SetHighlighter(GoHighlighter{})
+	e.Apply(theme)
+	e.SetBounds(0, 0, 20, 2)
+	screen := NewTestScreen()
+	e.Render(NewRenderer(screen, theme))
+
+	// The tab expands to 4 columns, so "go" starts at column 4.
+	if screen.Get(4, 0) != "g" {
+		t.Fatalf("col 4 = %q; want %q", screen.Get(4, 0), "g")
+	}
+	if fg := screen.Fg(4, 0); fg != "purple" {
+		t.Errorf("keyword foreground = %q; want purple", fg)
+	}
+	if fg := screen.Fg(7, 0); fg != "green" {
+		t.Errorf("string foreground = %q; want green", fg)
+	}
+	if fg := screen.Fg(6, 0); fg != "white" {
+		t.Errorf("plain foreground = %q; want white", fg)
+	}
+}
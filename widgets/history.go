@@ -0,0 +1,86 @@
+package widgets
+
+// historyLimit is the maximum number of undo steps kept per widget. The
+// oldest steps are dropped once the limit is reached.
+const historyLimit = 200
+
+// history is an undo/redo stack of widget state snapshots, shared by the
+// Editor and the Input. A widget saves a snapshot of its state (text,
+// cursor and selection) before every edit that starts an undo step;
+// undoing restores the snapshot and moves the state it replaces onto the
+// redo stack.
+//
+// Consecutive edits are coalesced into a single undo step when they have
+// the same kind and continue where the previous edit left off, so typing
+// a word or holding Backspace is undone at once. Typing whitespace after
+// a word still joins the word, but the next word starts a new step.
+type history[S any] struct {
+	undo []S    // snapshots before each undo step, oldest first
+	redo []S    // snapshots undone most recently last
+	kind string // kind of the most recent edit; empty if it must not be joined
+	at   [2]int // cursor position after the most recent edit
+}
+
+// save records the snapshot before an edit of the given kind that starts
+// at cursor position from. If the edit continues the previous one, the
+// edit joins the previous undo step and snapshot is not called, so typing
+// into a large text does not copy it on every keystroke. Pass an empty
+// kind for edits that must always form their own step. Every save clears
+// the redo stack.
+func (h *history[S]) save(snapshot func() S, kind string, from [2]int) {
+	h.redo = h.redo[:0]
+	if kind != "" && from == h.at && (kind == h.kind || (h.kind == "type" && kind == "space")) {
+		h.kind = kind
+		return
+	}
+	if kind == "space" {
+		// Whitespace typed on its own still joins the word that follows.
+		kind = "type"
+	}
+	if len(h.undo) >= historyLimit {
+		h.undo = append(h.undo[:0], h.undo[1:]...)
+	}
+	h.undo = append(h.undo, snapshot())
+	h.kind = kind
+}
+
+// done records the cursor position after an edit, which the next edit
+// must start at to be coalesced with it.
+func (h *history[S]) done(at [2]int) {
+	h.at = at
+}
+
+// back pops the most recent undo snapshot and pushes current onto the redo
+// stack. It reports false if there is nothing to undo.
+func (h *history[S]) back(current S) (S, bool) {
+	var zero S
+	if len(h.undo) == 0 {
+		return zero, false
+	}
+	state := h.undo[len(h.undo)-1]
+	h.undo = h.undo[:len(h.undo)-1]
+	h.redo = append(h.redo, current)
+	h.kind = ""
+	return state, true
+}
+
+// forward pops the most recent redo snapshot and pushes current onto the
+// undo stack. It reports false if there is nothing to redo.
+func (h *history[S]) forward(current S) (S, bool) {
+	var zero S
+	if len(h.redo) == 0 {
+		return zero, false
+	}
+	state := h.redo[len(h.redo)-1]
+	h.redo = h.redo[:len(h.redo)-1]
+	h.undo = append(h.undo, current)
+	h.kind = ""
+	return state, true
+}
+
+// reset discards all undo and redo steps.
+func (h *history[S]) reset() {
+	h.undo = nil
+	h.redo = nil
+	h.kind = ""
+}
@@ -47,8 +47,7 @@ func (f *IndicatorForm) Validate(field string) error { return nil }
 
 func (f *IndicatorForm) Emit(w io.Writer, mode string) error {
 	return f.EmitFrame(w, mode, func() error {
-		_, err := fmt.Fprintf(w, "Indicator(%q, %s, %q).\n", f.ID, levelConst(f.Level), f.Label)
-		return err
+		return f.EmitConstructor(w, mode, "Indicator", fmt.Sprintf("%s, %q", coreIdent(mode, levelConst(f.Level)), f.Label))
 	})
 }
 
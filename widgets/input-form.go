@@ -1,7 +1,6 @@
 package widgets
 
 import (
-	"fmt"
 	"io"
 
 	"github.com/tekugo/zeichenwerk/core"
@@ -73,40 +72,47 @@ func (f *InputForm) Validate(field string) error { return nil }
 // because the Builder has no chained setter for it.
 func (f *InputForm) Emit(w io.Writer, mode string) error {
 	if err := f.EmitFrame(w, mode, func() error {
-		_, err := fmt.Fprintf(w, "Input(%q%s).\n", f.ID, inputParamArgs(f.Text, f.Placeholder, f.Mask))
-		return err
+		params := inputParams(f.Text, f.Placeholder, f.Mask)
+		return f.EmitConstructor(w, mode, "Input", stringArgs(mode, params)...)
 	}); err != nil {
 		return err
 	}
 	if f.Masked {
-		fmt.Fprintf(w, "Flag(FlagMasked, true).\n")
+		if err := f.EmitFlag(w, mode, "FlagMasked"); err != nil {
+			return err
+		}
 	}
 	if f.Readonly {
-		fmt.Fprintf(w, "Flag(FlagReadonly, true).\n")
+		if err := f.EmitFlag(w, mode, "FlagReadonly"); err != nil {
+			return err
+		}
 	}
 	if f.Max > 0 {
-		fmt.Fprintf(w, "// TODO: SetMax(%d) — no Builder setter\n", f.Max)
+		if err := f.EmitTODO(w, mode, "SetMax(%d)", f.Max); err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
-// inputParamArgs renders the trailing variadic arguments to Input(id,
-// ...). Mask defaults to "*" inside NewInput, so when only the mask
-// would differ we still emit text+placeholder to keep parameter
-// positions correct. Returns "" when all three are empty / default.
-func inputParamArgs(text, placeholder, mask string) string {
+// inputParams returns the trailing params of NewInput to emit after
+// the ID and class. Mask defaults to "*" inside NewInput, so when only
+// the mask would differ we still emit text+placeholder to keep
+// parameter positions correct. Returns nil when all three are empty /
+// default.
+func inputParams(text, placeholder, mask string) []string {
 	const defaultMask = "*"
 	emitMask := mask != "" && mask != defaultMask
 	emitPlaceholder := emitMask || placeholder != ""
 	emitText := emitPlaceholder || text != ""
 	switch {
 	case emitMask:
-		return fmt.Sprintf(", %q, %q, %q", text, placeholder, mask)
+		return []string{text, placeholder, mask}
 	case emitPlaceholder:
-		return fmt.Sprintf(", %q, %q", text, placeholder)
+		return []string{text, placeholder}
 	case emitText:
-		return fmt.Sprintf(", %q", text)
+		return []string{text}
 	default:
-		return ""
+		return nil
 	}
 }
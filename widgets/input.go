@@ -16,13 +16,21 @@ import (
 // different position.
 type Input struct {
 	Component
-	buf         *GapBuffer // Backing store for text content
-	pos         int        // Current cursor position within the text (0-based character index)
-	offset      int        // Horizontal scroll offset for displaying long text (characters from start)
-	max         int        // Maximum allowed text length in characters (0 = unlimited)
-	placeholder string     // Placeholder text shown when input is empty
-	mask        string     // Character used for masking (typically '*', '•', or '●')
-	refresh     func()     // Optional refresh override; called by Refresh() instead of Redraw(i)
+	buf         *GapBuffer          // Backing store for text content
+	pos         int                 // Current cursor position within the text (0-based character index)
+	offset      int                 // Horizontal scroll offset for displaying long text (characters from start)
+	max         int                 // Maximum allowed text length in characters (0 = unlimited)
+	placeholder string              // Placeholder text shown when input is empty
+	mask        string              // Character used for masking (typically '*', '•', or '●')
+	refresh     func()              // Optional refresh override; called by Refresh() instead of Redraw(i)
+	history     history[inputState] // Undo/redo steps
+}
+
+// inputState is a snapshot of the input text and cursor, taken before each
+// edit for undo and redo.
+type inputState struct {
+	text string
+	pos  int
 }
 
 // NewInput creates a new text input widget with the specified ID and default configuration.
@@ -117,7 +125,8 @@ func (i *Input) Get() string {
 //
 // This method is safe to call at any time and will maintain the widget's
 // internal consistency regardless of the current state.
-// Set updates the input text without firing EvtChange.
+// Set updates the input text without firing EvtChange. It is a history
+// boundary: all undo and redo steps are discarded.
 func (i *Input) Set(text string) {
 	if i.Flag(FlagReadonly) {
 		return
@@ -133,6 +142,7 @@ func (i *Input) Set(text string) {
 	if i.pos > len(runes) {
 		i.pos = len(runes)
 	}
+	i.history.reset()
 	i.adjust()
 	i.Refresh()
 }
@@ -235,9 +245,15 @@ func (i *Input) Insert(ch string) {
 		return
 	}
 
+	if ch == " " {
+		i.save("space", true)
+	} else {
+		i.save("type", true)
+	}
 	i.buf.Move(i.pos)
 	i.buf.Insert([]rune(ch)[0])
 	i.pos++
+	i.history.done([2]int{0, i.pos})
 	i.adjust()
 	i.Refresh()
 
@@ -262,9 +278,11 @@ func (i *Input) Delete() {
 	}
 
 	// Move gap to pos-1, then forward-delete the character now immediately after the gap.
+	i.save("delete", true)
 	i.buf.Move(i.pos - 1)
 	i.buf.Delete()
 	i.pos--
+	i.history.done([2]int{0, i.pos})
 	i.adjust()
 	i.Refresh()
 
@@ -288,8 +306,10 @@ func (i *Input) DeleteForward() {
 		return
 	}
 
+	i.save("delete-forward", true)
 	i.buf.Move(i.pos)
 	i.buf.Delete()
+	i.history.done([2]int{0, i.pos})
 	i.adjust()
 	i.Refresh()
 
@@ -314,6 +334,7 @@ func (i *Input) Clear() {
 		return
 	}
 
+	i.save("", false)
 	i.buf = NewGapBuffer(16)
 	i.pos = 0
 	i.offset = 0
@@ -322,6 +343,69 @@ func (i *Input) Clear() {
 	i.Dispatch(i, EvtChange, "")
 }
 
+// ---- Undo/Redo ------------------------------------------------------------
+
+// CanRedo reports whether there is an undone edit that can be redone.
+func (i *Input) CanRedo() bool {
+	return len(i.history.redo) > 0
+}
+
+// CanUndo reports whether there is an edit that can be undone.
+func (i *Input) CanUndo() bool {
+	return len(i.history.undo) > 0
+}
+
+// Redo re-applies the most recently undone edit and fires EvtChange. No-op
+// in read-only mode or when there is nothing to redo.
+//
+// This method is typically called in response to Ctrl+Y or Ctrl+Shift+Z.
+func (i *Input) Redo() {
+	if i.Flag(FlagReadonly) {
+		return
+	}
+	if state, ok := i.history.forward(i.snapshot()); ok {
+		i.restore(state)
+	}
+}
+
+// Undo reverts the most recent edit and fires EvtChange. Typed characters
+// and consecutive deletions are coalesced, so a whole word is undone at
+// once. No-op in read-only mode or when there is nothing to undo.
+//
+// This method is typically called in response to Ctrl+Z.
+func (i *Input) Undo() {
+	if i.Flag(FlagReadonly) {
+		return
+	}
+	if state, ok := i.history.back(i.snapshot()); ok {
+		i.restore(state)
+	}
+}
+
+// restore replaces the text and cursor with a snapshot.
+func (i *Input) restore(state inputState) {
+	i.buf = NewGapBufferFromString(state.text, 16)
+	i.pos = state.pos
+	i.adjust()
+	i.Refresh()
+	i.Dispatch(i, EvtChange, i.buf.String())
+}
+
+// save records the current state as an undo step before an edit of the
+// given kind. If join is false, the edit never continues the previous one.
+func (i *Input) save(kind string, join bool) {
+	from := [2]int{0, i.pos}
+	if !join {
+		from = [2]int{-1, -1}
+	}
+	i.history.save(i.snapshot, kind, from)
+}
+
+// snapshot captures the current text and cursor position.
+func (i *Input) snapshot() inputState {
+	return inputState{text: i.buf.String(), pos: i.pos}
+}
+
 // ---- Internal methods -----------------------------------------------------
 
 // adjust adjusts the horizontal scroll offset to ensure the cursor remains visible
@@ -431,6 +515,7 @@ func (i *Input) handleKey(evt *tcell.EventKey) bool {
 	case tcell.KeyCtrlK:
 		// Delete from cursor to end of text
 		if !i.Flag(FlagReadonly) {
+			i.save("", false)
 			count := i.buf.Length() - i.pos
 			i.buf.Move(i.pos)
 			for j := 0; j < count; j++ {
@@ -444,6 +529,7 @@ func (i *Input) handleKey(evt *tcell.EventKey) bool {
 	case tcell.KeyCtrlU:
 		// Delete from beginning of text to cursor
 		if !i.Flag(FlagReadonly) {
+			i.save("", false)
 			runes := []rune(i.buf.String())
 			i.buf = NewGapBufferFromString(string(runes[i.pos:]), 16)
 			i.pos = 0
@@ -452,6 +538,16 @@ func (i *Input) handleKey(evt *tcell.EventKey) bool {
 			i.Dispatch(i, EvtChange, i.buf.String())
 			return true
 		}
+	case tcell.KeyCtrlY:
+		i.Redo()
+		return true
+	case tcell.KeyCtrlZ:
+		if evt.Modifiers()&tcell.ModShift != 0 {
+			i.Redo()
+		} else {
+			i.Undo()
+		}
+		return true
 	case tcell.KeyEnter:
 		i.Dispatch(i, EvtEnter, i.buf.String())
 		return true
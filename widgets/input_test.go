@@ -323,6 +323,66 @@ func TestInput_Keyboard_Rune_Inserts(t *testing.T) {
 	}
 }
 
+// ── Undo / Redo ───────────────────────────────────────────────────────────────
+
+func TestInput_Undo_CoalescesWords(t *testing.T) {
+	inp := NewInput("i", "")
+	for _, ch := range "foo bar" {
+		inp.Insert(string(ch))
+	}
+	inp.Undo()
+	if inp.Get() != "foo " {
+		t.Errorf("after Undo = %q; want %q", inp.Get(), "foo ")
+	}
+	if inp.pos != 4 {
+		t.Errorf("pos after Undo = %d; want 4", inp.pos)
+	}
+	inp.Redo()
+	if inp.Get() != "foo bar" {
+		t.Errorf("after Redo = %q; want %q", inp.Get(), "foo bar")
+	}
+}
+
+func TestInput_Undo_Clear(t *testing.T) {
+	inp := NewInput("i", "", "keep me")
+	inp.Clear()
+	var changed string
+	OnChange(inp, func(value string) bool {
+		changed = value
+		return true
+	})
+	inp.Undo()
+	if inp.Get() != "keep me" {
+		t.Errorf("after Undo = %q; want %q", inp.Get(), "keep me")
+	}
+	if changed != "keep me" {
+		t.Errorf("EvtChange value = %q; want %q", changed, "keep me")
+	}
+}
+
+func TestInput_Set_ClearsHistory(t *testing.T) {
+	inp := NewInput("i", "")
+	inp.Insert("a")
+	inp.Set("fresh")
+	if inp.CanUndo() {
+		t.Error("Set should discard the undo history")
+	}
+}
+
+func TestInput_KeyCtrlZ_CtrlShiftZ(t *testing.T) {
+	inp := NewInput("i", "")
+	inp.handleKey(tcell.NewEventKey(tcell.KeyRune, "x", tcell.ModNone))
+	inp.handleKey(tcell.NewEventKey(tcell.KeyBackspace, "", tcell.ModNone))
+	inp.handleKey(tcell.NewEventKey(tcell.KeyCtrlZ, "", tcell.ModNone))
+	if inp.Get() != "x" {
+		t.Errorf("after Ctrl+Z = %q; want %q", inp.Get(), "x")
+	}
+	inp.handleKey(tcell.NewEventKey(tcell.KeyCtrlZ, "", tcell.ModShift))
+	if inp.Get() != "" {
+		t.Errorf("after Ctrl+Shift+Z = %q; want empty", inp.Get())
+	}
+}
+
 // ── Render ────────────────────────────────────────────────────────────────────
 
 func TestInput_Render_ShowsText(t *testing.T) {
@@ -0,0 +1,903 @@
+package widgets
+
+import (
+	"fmt"
+	"math"
+	"time"
+	"unicode/utf8"
+
+	"github.com/gdamore/tcell/v3"
+	. "github.com/tekugo/zeichenwerk/core"
+)
+
+// brailleBits maps a dot position within a 2×4 Braille cell, indexed as
+// [row][column], to its bit in the U+2800 block. Dots 1–3 and 4–6 fill the
+// upper three rows of the left and right column; dots 7 and 8 the bottom row.
+var brailleBits = [4][2]uint8{
+	{0x01, 0x08},
+	{0x02, 0x10},
+	{0x04, 0x20},
+	{0x40, 0x80},
+}
+
+// lineChartSteps are the multiples of the time-axis interval that labels
+// may be spaced at, so that labelled points fall on round times.
+var lineChartSteps = []int{1, 2, 3, 5, 10, 15, 20, 30, 60, 120, 180, 360, 720, 1440}
+
+// LineSeries is a single data series in a [LineChart].
+//
+// The points are read from Provider when it is set and from Values
+// otherwise. Values is ordered oldest first; a provider follows the
+// DataProvider convention, Get(0) being the newest point. A NaN value
+// leaves a gap in the line.
+type LineSeries struct {
+	Label    string       // series name shown in the legend; may be empty
+	Values   []float64    // data points, oldest first
+	Provider DataProvider // live data source such as a RingBuffer or TimeSeries
+}
+
+// size returns the number of points in the series.
+func (s *LineSeries) size() int {
+	if s.Provider != nil {
+		return s.Provider.Size()
+	}
+	return len(s.Values)
+}
+
+// at returns the j-th point, counting from the oldest.
+func (s *LineSeries) at(j int) float64 {
+	if s.Provider != nil {
+		return s.Provider.Get(s.Provider.Size() - 1 - j)
+	}
+	return s.Values[j]
+}
+
+// timeWindow is the part of core.TimeSeries a LineChart reads its time
+// axis from.
+type timeWindow interface {
+	Start() time.Time
+	Interval() time.Duration
+}
+
+// LineChart renders one or more data series as lines over a shared x-axis.
+// Lines are drawn with Braille dots, giving each cell 2×4 dots of
+// resolution, and may be filled down to the baseline. The x-axis carries
+// category labels, time labels (see SetTimeAxis) or just the baseline.
+//
+// With a capacity set, the chart becomes a streaming chart: it shows the
+// latest capacity points right-aligned, and Append drops the oldest value
+// of a series once it is full, so the lines scroll left as values arrive.
+// A series backed by a TimeSeries scrolls the same way as the series is
+// shifted, and its window provides the time axis.
+//
+// Selecting a point, with the keyboard or by moving the mouse over the
+// chart, draws a cursor at it and reports the values of all series in
+// the row above the chart.
+//
+// Events:
+//   - [EvtSelect]   – int: selected x-index changed
+//   - [EvtActivate] – int: Enter pressed or the selected point clicked
+type LineChart struct {
+	Component
+	series     []LineSeries
+	categories []string
+	start      time.Time     // time of x-index 0 on an explicit time axis
+	interval   time.Duration // spacing of the explicit time axis; 0 = none
+	absolute   bool
+	min        float64
+	max        float64
+	showAxis   bool
+	showGrid   bool
+	showFill   bool
+	legend     bool
+	capacity   int
+	ticks      int
+	selected   int
+	// Characters read from theme strings in Apply; defaults set in constructor.
+	chCorner string
+	chHLine  string
+	chVLine  string
+	chTickX  string
+	chTickY  string
+	chGrid   string
+	chSwatch string
+	chCursor string
+}
+
+// NewLineChart creates a new LineChart with the y-axis, grid and legend
+// shown, no area fill, 5 y-axis ticks, relative scaling, unlimited
+// capacity and no point selected.
+func NewLineChart(id, class string) *LineChart {
+	c := &LineChart{
+		Component: Component{id: id, class: class},
+		max:       1,
+		showAxis:  true,
+		showGrid:  true,
+		legend:    true,
+		ticks:     5,
+		selected:  -1,
+
+		chCorner: "└",
+		chHLine:  "─",
+		chVLine:  "│",
+		chTickX:  "┬",
+		chTickY:  "┤",
+		chGrid:   "─",
+		chSwatch: "█",
+		chCursor: "│",
+	}
+	c.SetFlag(FlagFocusable, true)
+	OnKey(c, c.handleKey)
+	OnMouse(c, c.handleMouse)
+	return c
+}
+
+// ── Data setters ──────────────────────────────────────────────────────────────
+
+// SetSeries replaces all series and redraws.
+func (c *LineChart) SetSeries(s []LineSeries) {
+	c.series = s
+	c.trim()
+	c.clampSelected()
+	c.Refresh()
+}
+
+// AddSeries appends a series and redraws.
+func (c *LineChart) AddSeries(s LineSeries) {
+	c.series = append(c.series, s)
+	c.trim()
+	c.Refresh()
+}
+
+// Append adds v as the newest point of the series at index and redraws.
+// When a capacity is set and the series is full, its oldest point is
+// dropped. Append is a no-op for an index out of range and for series
+// backed by a provider, which manage their own data.
+func (c *LineChart) Append(index int, v float64) {
+	if index < 0 || index >= len(c.series) || c.series[index].Provider != nil {
+		return
+	}
+	s := &c.series[index]
+	s.Values = append(s.Values, v)
+	if c.capacity > 0 && len(s.Values) > c.capacity {
+		s.Values = s.Values[len(s.Values)-c.capacity:]
+	}
+	c.Refresh()
+}
+
+// SetCategories replaces the x-axis labels, one per x-index, and redraws.
+func (c *LineChart) SetCategories(labels []string) {
+	c.categories = labels
+	c.Refresh()
+}
+
+// SetTimeAxis labels the x-axis with times: x-index i stands for
+// start + i × interval. Labels are spaced at round multiples of the
+// interval and formatted to suit it. Pass a zero interval to remove the
+// time axis. Series backed by a TimeSeries provide a time axis of their
+// own, which is used when none is set explicitly.
+func (c *LineChart) SetTimeAxis(start time.Time, interval time.Duration) {
+	c.start = start
+	c.interval = interval
+	c.Refresh()
+}
+
+// Series returns the current series slice.
+func (c *LineChart) Series() []LineSeries { return c.series }
+
+// Categories returns the current category labels.
+func (c *LineChart) Categories() []string { return c.categories }
+
+// Count returns the number of x positions: the length of the longest
+// series, or the capacity when it is larger.
+func (c *LineChart) Count() int {
+	n := c.capacity
+	for i := range c.series {
+		n = max(n, c.series[i].size())
+	}
+	return n
+}
+
+// ValuesAt returns the value of every series at x-index index. Series
+// without a point there, or with a gap, report NaN.
+func (c *LineChart) ValuesAt(index int) []float64 {
+	n := c.Count()
+	values := make([]float64, len(c.series))
+	for i := range c.series {
+		values[i] = c.point(&c.series[i], index, n)
+	}
+	return values
+}
+
+// ── Display setters ───────────────────────────────────────────────────────────
+
+// SetAbsolute switches between relative (false, default) and absolute (true)
+// scaling. In absolute mode the [Min, Max] bracket is used and values
+// outside it are clamped. In relative mode the range is computed from the
+// values each render pass and widened to whole tick steps.
+func (c *LineChart) SetAbsolute(v bool) { c.absolute = v; c.Refresh() }
+
+// SetMin sets the lower bound for absolute scaling.
+func (c *LineChart) SetMin(v float64) { c.min = v; c.Refresh() }
+
+// SetMax sets the upper bound for absolute scaling.
+func (c *LineChart) SetMax(v float64) { c.max = v; c.Refresh() }
+
+// SetShowAxis shows or hides the y-axis labels and rule.
+func (c *LineChart) SetShowAxis(v bool) { c.showAxis = v; c.Refresh() }
+
+// SetShowGrid shows or hides horizontal grid lines at y-axis ticks.
+func (c *LineChart) SetShowGrid(v bool) { c.showGrid = v; c.Refresh() }
+
+// SetShowFill shows or hides the area fill below each line.
+func (c *LineChart) SetShowFill(v bool) { c.showFill = v; c.Refresh() }
+
+// SetLegend shows or hides the series legend row.
+func (c *LineChart) SetLegend(v bool) { c.legend = v; c.Refresh() }
+
+// SetCapacity sets how many points each series keeps; 0 means unlimited.
+// Series holding more values are trimmed to the newest ones right away.
+func (c *LineChart) SetCapacity(n int) {
+	if n < 0 {
+		n = 0
+	}
+	c.capacity = n
+	c.trim()
+	c.clampSelected()
+	c.Refresh()
+}
+
+// Capacity returns the number of points each series keeps, 0 if unlimited.
+func (c *LineChart) Capacity() int { return c.capacity }
+
+// SetTicks sets the approximate number of y-axis ticks (minimum 2).
+func (c *LineChart) SetTicks(n int) {
+	if n < 2 {
+		n = 2
+	}
+	c.ticks = n
+	c.Refresh()
+}
+
+// ── Navigation ────────────────────────────────────────────────────────────────
+
+// Select moves the cursor to an x-index, clamping to the valid range, and
+// dispatches [EvtSelect]. No-op when already selected.
+func (c *LineChart) Select(index int) {
+	n := c.Count()
+	if n == 0 {
+		c.selected = -1
+		return
+	}
+	index = max(0, min(index, n-1))
+	if index == c.selected {
+		return
+	}
+	c.selected = index
+	c.Dispatch(c, EvtSelect, index)
+	Redraw(c)
+}
+
+// Selected returns the selected x-index, or -1 if none.
+func (c *LineChart) Selected() int { return c.selected }
+
+// ── Theme / Apply ─────────────────────────────────────────────────────────────
+
+// Apply registers all line-chart style selectors and reads theme strings.
+func (c *LineChart) Apply(theme *Theme) {
+	theme.Apply(c, c.Selector("line-chart"), "focused", "hovered", "disabled")
+	theme.Apply(c, c.Selector("line-chart/axis"))
+	theme.Apply(c, c.Selector("line-chart/grid"))
+	theme.Apply(c, c.Selector("line-chart/fill"))
+	theme.Apply(c, c.Selector("line-chart/label"), "focused")
+	theme.Apply(c, c.Selector("line-chart/cursor"))
+	theme.Apply(c, c.Selector("line-chart/legend"))
+	for i := range 8 {
+		theme.Apply(c, c.Selector(fmt.Sprintf("line-chart/s%d", i)))
+	}
+	str := func(key, def string) string {
+		if s := theme.String(key); s != "" {
+			return s
+		}
+		return def
+	}
+	c.chCorner = str("line-chart.corner", "└")
+	c.chHLine = str("line-chart.hline", "─")
+	c.chVLine = str("line-chart.vline", "│")
+	c.chTickX = str("line-chart.tick-x", "┬")
+	c.chTickY = str("line-chart.tick-y", "┤")
+	c.chGrid = str("line-chart.grid", "─")
+	c.chSwatch = str("line-chart.swatch", "█")
+	c.chCursor = str("line-chart.cursor", "│")
+}
+
+// ── Layout ────────────────────────────────────────────────────────────────────
+
+// lineChartLayout is the geometry of one render pass, shared by Render
+// and the mouse handler.
+type lineChartLayout struct {
+	chartX, chartY int // top-left cell of the plot area
+	chartW, chartH int // plot area size in cells
+	yAxisW         int // width of the y-axis, 0 when hidden
+	n              int // number of x positions
+	lo, hi         float64
+}
+
+// layout computes the geometry for the current content area. ok is false
+// when the area is too small to draw a plot.
+//
+// Rows, top to bottom: cursor readout, plot, baseline, x labels (when
+// there are categories or a time axis), legend (when shown).
+func (c *LineChart) layout() (l lineChartLayout, ok bool) {
+	cx, cy, cw, ch := c.Content()
+	l.n = c.Count()
+	l.lo, l.hi = c.bounds()
+	if c.showAxis {
+		_, _, l.yAxisW = axisTicks(l.lo, l.hi, c.ticks)
+	}
+	below := 1
+	if c.hasXLabels() {
+		below++
+	}
+	if c.legend && c.hasLegendLabels() {
+		below++
+	}
+	l.chartX, l.chartY = cx+l.yAxisW, cy+1
+	l.chartW, l.chartH = cw-l.yAxisW, ch-1-below
+	return l, l.chartW >= 1 && l.chartH >= 1
+}
+
+// dotX maps an x-index to a dot column; the first and last index sit at
+// the left and right edge of the plot.
+func (l *lineChartLayout) dotX(xi int) int {
+	if l.n <= 1 {
+		return 0
+	}
+	return xi * (l.chartW*2 - 1) / (l.n - 1)
+}
+
+// dotY maps a value to a dot row, 0 being the top of the plot. Values
+// outside [lo, hi] are clamped to the edges.
+func (l *lineChartLayout) dotY(v float64) int {
+	f := 0.5
+	if l.hi != l.lo {
+		f = (v - l.lo) / (l.hi - l.lo)
+	}
+	f = max(0, min(f, 1))
+	return int((1-f)*float64(l.chartH*4-1) + 0.5)
+}
+
+// column returns the screen column of x-index xi.
+func (l *lineChartLayout) column(xi int) int {
+	return l.chartX + l.dotX(xi)/2
+}
+
+// index returns the x-index whose column is nearest to screen column x.
+func (l *lineChartLayout) index(x int) int {
+	if l.n <= 1 {
+		return 0
+	}
+	dx := float64(x-l.chartX)*2 + 0.5
+	xi := int(dx*float64(l.n-1)/float64(l.chartW*2-1) + 0.5)
+	return max(0, min(xi, l.n-1))
+}
+
+// ── Render ────────────────────────────────────────────────────────────────────
+
+// Render draws the line chart.
+func (c *LineChart) Render(r *Renderer) {
+	if c.Flag(FlagHidden) {
+		return
+	}
+	c.Component.Render(r)
+
+	l, ok := c.layout()
+	if !ok {
+		return
+	}
+	cx, _, cw, _ := c.Content()
+	baselineY := l.chartY + l.chartH
+	axisS := c.Style("axis")
+
+	// ── Y-axis and grid ───────────────────────────────────────────────────────
+	if c.showAxis || c.showGrid {
+		tickVals, tickLabels, _ := axisTicks(l.lo, l.hi, c.ticks)
+		if c.showAxis {
+			r.Set(axisS.Foreground(), axisS.Background(), axisS.Font())
+			for row := l.chartY; row < baselineY; row++ {
+				r.Put(l.chartX-1, row, c.chVLine)
+			}
+		}
+		gridS := c.Style("grid")
+		for i, tv := range tickVals {
+			if tv < l.lo || tv > l.hi {
+				continue
+			}
+			y := l.chartY + l.dotY(tv)/4
+			if c.showAxis {
+				r.Set(axisS.Foreground(), axisS.Background(), axisS.Font())
+				r.Text(cx, y, fmt.Sprintf("%*s ", l.yAxisW-2, tickLabels[i]), l.yAxisW-1)
+				r.Put(l.chartX-1, y, c.chTickY)
+			}
+			// No grid line on the bottom row; the plot sits on it.
+			if c.showGrid && l.dotY(tv) < l.chartH*4-1 {
+				r.Set(gridS.Foreground(), gridS.Background(), gridS.Font())
+				for col := 0; col < l.chartW; col++ {
+					r.Put(l.chartX+col, y, c.chGrid)
+				}
+			}
+		}
+	}
+
+	// ── Lines ─────────────────────────────────────────────────────────────────
+	g := c.plot(&l)
+	chartBg := c.Style().Background()
+	fillS := c.Style("fill")
+	for row := range l.chartH {
+		for col := range l.chartW {
+			cell := g.cells[row*l.chartW+col]
+			if cell.line|cell.fill == 0 {
+				continue
+			}
+			if cell.line != 0 {
+				r.Set(c.Style(fmt.Sprintf("s%d", cell.series%8)).Foreground(), chartBg, "")
+			} else {
+				r.Set(fillS.Foreground(), fillS.Background(), fillS.Font())
+			}
+			r.Put(l.chartX+col, l.chartY+row, string(rune(0x2800+int(cell.line|cell.fill))))
+		}
+	}
+
+	// ── Cursor ────────────────────────────────────────────────────────────────
+	if c.selected >= 0 && c.selected < l.n {
+		col := l.column(c.selected)
+		r.Set(axisS.Foreground(), axisS.Background(), axisS.Font())
+		for row := range l.chartH {
+			if cell := g.cells[row*l.chartW+col-l.chartX]; cell.line|cell.fill == 0 {
+				r.Put(col, l.chartY+row, c.chCursor)
+			}
+		}
+		c.renderReadout(r, &l)
+	}
+
+	// ── X-axis ────────────────────────────────────────────────────────────────
+	r.Set(axisS.Foreground(), axisS.Background(), axisS.Font())
+	if c.showAxis {
+		r.Put(l.chartX-1, baselineY, c.chCorner)
+	}
+	for col := 0; col < l.chartW; col++ {
+		r.Put(l.chartX+col, baselineY, c.chHLine)
+	}
+	labelY := baselineY
+	if c.hasXLabels() {
+		labelY++
+		labelS := c.Style("label")
+		selLabelS := c.Style("label:focused")
+		lastEnd := cx
+		for _, xl := range c.xLabels(&l) {
+			col := l.column(xl.index)
+			r.Set(axisS.Foreground(), axisS.Background(), axisS.Font())
+			r.Put(col, baselineY, c.chTickX)
+
+			w := utf8.RuneCountInString(xl.text)
+			x := max(col-w/2, lastEnd, cx)
+			if x+w > cx+cw {
+				x = cx + cw - w
+			}
+			if x < lastEnd {
+				continue
+			}
+			if c.Flag(FlagFocused) && xl.index == c.selected {
+				r.Set(selLabelS.Foreground(), selLabelS.Background(), selLabelS.Font())
+			} else {
+				r.Set(labelS.Foreground(), labelS.Background(), labelS.Font())
+			}
+			r.Text(x, labelY, xl.text, w)
+			lastEnd = x + w + 1
+		}
+	}
+
+	// ── Legend ────────────────────────────────────────────────────────────────
+	if c.legend && c.hasLegendLabels() {
+		c.renderLegend(r, cx, labelY+1, cw)
+	}
+}
+
+// renderReadout writes the x label of the selected point and the value
+// of each series there into the row above the plot.
+func (c *LineChart) renderReadout(r *Renderer, l *lineChartLayout) {
+	cx, cy, cw, _ := c.Content()
+	curS := c.Style("cursor")
+	x, end := l.chartX, cx+cw
+	text := func(s string) {
+		if w := min(utf8.RuneCountInString(s), end-x); w > 0 {
+			r.Text(x, cy, s, w)
+			x += w
+		}
+	}
+	r.Set(curS.Foreground(), curS.Background(), curS.Font())
+	text(c.xLabel(c.selected))
+	for i, v := range c.ValuesAt(c.selected) {
+		if x >= end {
+			break
+		}
+		r.Set(curS.Foreground(), curS.Background(), curS.Font())
+		text("  ")
+		sS := c.Style(fmt.Sprintf("s%d", i%8))
+		r.Set(sS.Foreground(), curS.Background(), "")
+		text(c.chSwatch)
+		r.Set(curS.Foreground(), curS.Background(), curS.Font())
+		if label := c.series[i].Label; label != "" {
+			text(" " + label)
+		}
+		if math.IsNaN(v) {
+			text(" –")
+		} else {
+			text(fmt.Sprintf(" %g", v))
+		}
+	}
+}
+
+func (c *LineChart) renderLegend(r *Renderer, cx, legendY, cw int) {
+	legS := c.Style("legend")
+	x := cx
+	for i, s := range c.series {
+		if s.Label == "" {
+			continue
+		}
+		if x >= cx+cw {
+			break
+		}
+		sS := c.Style(fmt.Sprintf("s%d", i%8))
+		r.Set(sS.Foreground(), legS.Background(), "")
+		r.Put(x, legendY, c.chSwatch)
+		r.Set(legS.Foreground(), legS.Background(), legS.Font())
+		entry := " " + s.Label + "   "
+		ew := utf8.RuneCountInString(entry)
+		r.Text(x+1, legendY, entry, min(ew, cx+cw-x-1))
+		x += 1 + ew
+	}
+}
+
+// ── Braille plot ──────────────────────────────────────────────────────────────
+
+// lineCell accumulates the Braille dots of one plot cell. line and fill
+// keep the dots of the lines and of the area below them apart, so a
+// cell holding only fill dots can be drawn in the fill style. series is
+// the highest series index that set a dot in the cell.
+type lineCell struct {
+	line, fill uint8
+	series     int
+}
+
+// lineGrid is the dot grid of a plot area, w×h cells of 2×4 dots each.
+type lineGrid struct {
+	w, h  int
+	cells []lineCell
+	fill  bool // set the dots below every line dot as well
+}
+
+// set turns on the line dot (x, y) for series s and, when filling, the
+// dots below it down to the baseline.
+func (g *lineGrid) set(x, y, s int) {
+	if x < 0 || y < 0 || x >= g.w*2 || y >= g.h*4 {
+		return
+	}
+	cell := &g.cells[(y/4)*g.w+x/2]
+	cell.line |= brailleBits[y%4][x%2]
+	cell.series = s
+	if g.fill {
+		for fy := y + 1; fy < g.h*4; fy++ {
+			cell := &g.cells[(fy/4)*g.w+x/2]
+			cell.fill |= brailleBits[fy%4][x%2]
+			cell.series = max(cell.series, s)
+		}
+	}
+}
+
+// line sets the dots of a Bresenham line from (x0, y0) to (x1, y1).
+func (g *lineGrid) line(x0, y0, x1, y1, s int) {
+	dx, dy := x1-x0, y0-y1
+	sx, sy := 1, 1
+	if dx < 0 {
+		dx, sx = -dx, -1
+	}
+	if dy > 0 {
+		dy, sy = -dy, -1
+	}
+	e := dx + dy
+	for {
+		g.set(x0, y0, s)
+		if x0 == x1 && y0 == y1 {
+			return
+		}
+		e2 := 2 * e
+		if e2 >= dy {
+			e += dy
+			x0 += sx
+		}
+		if e2 <= dx {
+			e += dx
+			y0 += sy
+		}
+	}
+}
+
+// plot draws every series into a fresh dot grid, in ascending index
+// order so later series win the colour of shared cells.
+func (c *LineChart) plot(l *lineChartLayout) *lineGrid {
+	g := &lineGrid{w: l.chartW, h: l.chartH, cells: make([]lineCell, l.chartW*l.chartH), fill: c.showFill}
+	for i := range c.series {
+		s := &c.series[i]
+		prev := false
+		var px, py int
+		for xi := range l.n {
+			v := c.point(s, xi, l.n)
+			if math.IsNaN(v) {
+				prev = false
+				continue
+			}
+			x, y := l.dotX(xi), l.dotY(v)
+			if prev {
+				g.line(px, py, x, y, i)
+			} else {
+				g.set(x, y, i)
+			}
+			px, py, prev = x, y, true
+		}
+	}
+	return g
+}
+
+// ── Helpers ───────────────────────────────────────────────────────────────────
+
+// point returns the value of series s at x-index xi of n, or NaN when
+// the series has no point there. With a capacity set, series are
+// right-aligned so their newest points line up.
+func (c *LineChart) point(s *LineSeries, xi, n int) float64 {
+	size := s.size()
+	j := xi
+	if c.capacity > 0 {
+		j -= n - size
+	}
+	if j < 0 || j >= size {
+		return math.NaN()
+	}
+	return s.at(j)
+}
+
+// bounds returns the y range. Absolute mode uses [min, max]; relative
+// mode spans the finite values of all series, widened to whole tick
+// steps so the grid lines frame the data.
+func (c *LineChart) bounds() (lo, hi float64) {
+	if c.absolute {
+		if c.max <= c.min {
+			return c.min, c.min + 1
+		}
+		return c.min, c.max
+	}
+	lo, hi = math.Inf(1), math.Inf(-1)
+	n := c.Count()
+	for i := range c.series {
+		for xi := range n {
+			if v := c.point(&c.series[i], xi, n); !math.IsNaN(v) && !math.IsInf(v, 0) {
+				lo, hi = min(lo, v), max(hi, v)
+			}
+		}
+	}
+	if lo > hi {
+		return 0, 1
+	}
+	if lo == hi {
+		lo, hi = lo-1, hi+1
+	}
+	step := niceCeil((hi - lo) / float64(c.ticks))
+	return math.Floor(lo/step) * step, math.Ceil(hi/step) * step
+}
+
+// timeAxis returns the time of x-index 0 and the spacing of the time
+// axis: the one set with SetTimeAxis, or else that of the first series
+// backed by a TimeSeries.
+func (c *LineChart) timeAxis() (time.Time, time.Duration, bool) {
+	if c.interval > 0 {
+		return c.start, c.interval, true
+	}
+	n := c.Count()
+	for i := range c.series {
+		if tw, ok := c.series[i].Provider.(timeWindow); ok && tw.Interval() > 0 {
+			start := tw.Start()
+			if c.capacity > 0 {
+				start = start.Add(-time.Duration(n-c.series[i].size()) * tw.Interval())
+			}
+			return start, tw.Interval(), true
+		}
+	}
+	return time.Time{}, 0, false
+}
+
+// hasXLabels reports whether the x-axis has a label row.
+func (c *LineChart) hasXLabels() bool {
+	if len(c.categories) > 0 {
+		return true
+	}
+	_, _, ok := c.timeAxis()
+	return ok
+}
+
+// xLabel returns the label of x-index xi: its category, its time, or
+// the index itself.
+func (c *LineChart) xLabel(xi int) string {
+	if xi < len(c.categories) {
+		return c.categories[xi]
+	}
+	if start, interval, ok := c.timeAxis(); ok {
+		return start.Add(time.Duration(xi) * interval).Format(timeLayout(interval))
+	}
+	return fmt.Sprintf("#%d", xi)
+}
+
+// lineChartLabel is one x-axis label and the index it belongs to.
+type lineChartLabel struct {
+	index int
+	text  string
+}
+
+// xLabels picks the x-axis labels that fit the plot width. Categories
+// are thinned to every k-th one when they would overlap. Time labels
+// are spaced at a multiple of the interval from lineChartSteps and
+// placed on the points whose time is a whole multiple of that spacing,
+// so they read as round times.
+func (c *LineChart) xLabels(l *lineChartLayout) []lineChartLabel {
+	if l.n == 0 {
+		return nil
+	}
+	pitch := float64(l.chartW) / float64(l.n)
+	var out []lineChartLabel
+	if len(c.categories) > 0 {
+		widest := 0
+		for _, cat := range c.categories {
+			widest = max(widest, utf8.RuneCountInString(cat))
+		}
+		k := max(1, int(math.Ceil(float64(widest+1)/pitch)))
+		for xi := 0; xi < min(l.n, len(c.categories)); xi += k {
+			text := c.categories[xi]
+			if room := max(1, int(pitch*float64(k))-1); utf8.RuneCountInString(text) > room {
+				text = string([]rune(text)[:room])
+			}
+			out = append(out, lineChartLabel{xi, text})
+		}
+		return out
+	}
+
+	start, interval, ok := c.timeAxis()
+	if !ok {
+		return nil
+	}
+	layout := timeLayout(interval)
+	w := utf8.RuneCountInString(start.Format(layout))
+	k := lineChartSteps[len(lineChartSteps)-1]
+	for _, step := range lineChartSteps {
+		if float64(step)*pitch >= float64(w+1) {
+			k = step
+			break
+		}
+	}
+	span := time.Duration(k) * interval
+	first := 0
+	for xi := range min(k, l.n) {
+		if t := start.Add(time.Duration(xi) * interval); t.Truncate(span).Equal(t) {
+			first = xi
+			break
+		}
+	}
+	for xi := first; xi < l.n; xi += k {
+		out = append(out, lineChartLabel{xi, start.Add(time.Duration(xi) * interval).Format(layout)})
+	}
+	return out
+}
+
+// timeLayout returns the time format for labels spaced interval apart.
+func timeLayout(interval time.Duration) string {
+	switch {
+	case interval < time.Minute:
+		return "15:04:05"
+	case interval < 24*time.Hour:
+		return "15:04"
+	default:
+		return "Jan 2"
+	}
+}
+
+func (c *LineChart) hasLegendLabels() bool {
+	for _, s := range c.series {
+		if s.Label != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// trim drops the oldest values of series over capacity.
+func (c *LineChart) trim() {
+	if c.capacity == 0 {
+		return
+	}
+	for i := range c.series {
+		if s := &c.series[i]; len(s.Values) > c.capacity {
+			s.Values = s.Values[len(s.Values)-c.capacity:]
+		}
+	}
+}
+
+// clampSelected keeps the selection inside the data after it shrank.
+func (c *LineChart) clampSelected() {
+	if c.selected >= c.Count() {
+		c.selected = c.Count() - 1
+	}
+}
+
+// ── Keyboard ──────────────────────────────────────────────────────────────────
+
+func (c *LineChart) handleKey(evt *tcell.EventKey) bool {
+	n := c.Count()
+	if n == 0 {
+		return false
+	}
+	switch evt.Key() {
+	case tcell.KeyLeft:
+		c.move(-1)
+		return true
+	case tcell.KeyRight:
+		c.move(+1)
+		return true
+	case tcell.KeyHome:
+		c.Select(0)
+		return true
+	case tcell.KeyEnd:
+		c.Select(n - 1)
+		return true
+	case tcell.KeyEnter:
+		if c.selected >= 0 {
+			c.Dispatch(c, EvtActivate, c.selected)
+		}
+		return true
+	}
+	return false
+}
+
+func (c *LineChart) move(delta int) {
+	next := c.selected + delta
+	if c.selected < 0 {
+		if delta > 0 {
+			next = 0
+		} else {
+			next = c.Count() - 1
+		}
+	}
+	c.Select(next)
+}
+
+// ── Mouse ─────────────────────────────────────────────────────────────────────
+
+// handleMouse moves the cursor to the point nearest to the mouse while it
+// hovers over the plot and activates the selected point on click.
+func (c *LineChart) handleMouse(evt *tcell.EventMouse) bool {
+	if evt.Buttons() != tcell.Button1 && evt.Buttons() != tcell.ButtonNone {
+		return false
+	}
+	l, ok := c.layout()
+	if !ok || l.n == 0 {
+		return false
+	}
+	mx, my := evt.Position()
+	if mx < l.chartX || mx >= l.chartX+l.chartW || my < l.chartY || my >= l.chartY+l.chartH {
+		return false
+	}
+	xi := l.index(mx)
+	if evt.Buttons() == tcell.Button1 && xi == c.selected {
+		c.Dispatch(c, EvtActivate, xi)
+	} else {
+		c.Select(xi)
+	}
+	return true
+}
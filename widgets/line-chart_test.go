@@ -0,0 +1,342 @@
+package widgets
+
+import (
+	"math"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/gdamore/tcell/v3"
+	. "github.com/tekugo/zeichenwerk/core"
+)
+
+// newPlainLineChart returns a line chart of the given size without axis,
+// grid or legend, so the plot starts at (0, 1) below the readout row and
+// fills the content area down to the baseline.
+func newPlainLineChart(w, h int) *LineChart {
+	lc := NewLineChart("lc", "")
+	lc.SetShowAxis(false)
+	lc.SetShowGrid(false)
+	lc.SetLegend(false)
+	lc.SetBounds(0, 0, w, h)
+	return lc
+}
+
+// braille returns the dot bits of the Braille rune at (x, y), or 0 when
+// the cell does not hold one.
+func braille(cs *TestScreen, x, y int) uint8 {
+	r := []rune(cs.Get(x, y))
+	if len(r) != 1 || r[0] < 0x2800 || r[0] > 0x28ff {
+		return 0
+	}
+	return uint8(r[0] - 0x2800)
+}
+
+func TestLineChart_Defaults(t *testing.T) {
+	lc := NewLineChart("lc", "")
+	if lc.ticks != 5 || lc.selected != -1 || lc.capacity != 0 {
+		t.Errorf("ticks, selected, capacity = %d, %d, %d; want 5, -1, 0", lc.ticks, lc.selected, lc.capacity)
+	}
+	if !lc.showAxis || !lc.showGrid || !lc.legend || lc.showFill || lc.absolute {
+		t.Error("expected axis, grid and legend on; fill and absolute off")
+	}
+	if !lc.Flag(FlagFocusable) {
+		t.Error("expected FlagFocusable to be set")
+	}
+}
+
+func TestLineChart_Bounds(t *testing.T) {
+	lc := NewLineChart("lc", "")
+	if lo, hi := lc.bounds(); lo != 0 || hi != 1 {
+		t.Errorf("empty bounds = %g, %g; want 0, 1", lo, hi)
+	}
+	lc.SetSeries([]LineSeries{{Values: []float64{3, math.NaN(), 47}}})
+	if lo, hi := lc.bounds(); lo != 0 || hi != 50 {
+		t.Errorf("relative bounds = %g, %g; want 0, 50", lo, hi)
+	}
+	lc.SetSeries([]LineSeries{{Values: []float64{-12, 7}}})
+	if lo, hi := lc.bounds(); lo != -15 || hi != 10 {
+		t.Errorf("negative bounds = %g, %g; want -15, 10", lo, hi)
+	}
+	lc.SetSeries([]LineSeries{{Values: []float64{4, 4}}})
+	if lo, hi := lc.bounds(); lo >= 4 || hi <= 4 {
+		t.Errorf("flat bounds = %g, %g; want a range around 4", lo, hi)
+	}
+	lc.SetAbsolute(true)
+	lc.SetMin(-1)
+	lc.SetMax(1)
+	if lo, hi := lc.bounds(); lo != -1 || hi != 1 {
+		t.Errorf("absolute bounds = %g, %g; want -1, 1", lo, hi)
+	}
+}
+
+// TestLineChart_AxisTicks checks that the y-axis shares the tick logic
+// of BarChart.
+func TestLineChart_AxisTicks(t *testing.T) {
+	bc := NewBarChart("bc", "")
+	bc.SetSeries([]BarSeries{{Values: []float64{47}}})
+	bc.SetCategories([]string{"A"})
+	wantVals, wantLabels, wantW := bc.yAxisLayout()
+	vals, labels, w := axisTicks(0, 47, 5)
+	if !slices.Equal(vals, wantVals) || !slices.Equal(labels, wantLabels) || w != wantW {
+		t.Errorf("axisTicks = %v %v %d; want %v %v %d", vals, labels, w, wantVals, wantLabels, wantW)
+	}
+	_, labels, _ = axisTicks(0, 0.5, 5)
+	if labels[1] != "0.1" {
+		t.Errorf("fractional label = %q; want 0.1", labels[1])
+	}
+}
+
+func TestLineChart_Render_HorizontalLine(t *testing.T) {
+	lc := newPlainLineChart(4, 4)
+	lc.SetAbsolute(true)
+	lc.SetMax(2)
+	lc.SetSeries([]LineSeries{{Values: []float64{1, 1}}})
+	cs, r := newBarChartRenderer()
+	lc.Render(r)
+
+	// chartH = 4 - 1 readout - 1 baseline = 2 rows, 8 dot rows; value 1
+	// of [0, 2] sits on dot row 4, the top row of the second cell row.
+	for x := range 4 {
+		if got := braille(cs, x, 2); got != 0x09 {
+			t.Errorf("cell (%d, 2) = %#x; want 0x09 (top dots)", x, got)
+		}
+		if got := braille(cs, x, 1); got != 0 {
+			t.Errorf("cell (%d, 1) = %#x; want empty", x, got)
+		}
+	}
+	if got := cs.Get(0, 3); got != "─" {
+		t.Errorf("baseline = %q; want ─", got)
+	}
+}
+
+func TestLineChart_Render_Diagonal(t *testing.T) {
+	lc := newPlainLineChart(1, 3)
+	lc.SetSeries([]LineSeries{{Values: []float64{0, 1}}})
+	lc.SetAbsolute(true)
+	cs, r := newBarChartRenderer()
+	lc.Render(r)
+	// One cell: the line rises from the bottom-left to the top-right dot.
+	got := braille(cs, 0, 1)
+	if got&0x40 == 0 || got&0x08 == 0 {
+		t.Errorf("diagonal = %#x; want dots 7 and 4 set", got)
+	}
+}
+
+func TestLineChart_Render_NaNGap(t *testing.T) {
+	lc := newPlainLineChart(5, 3)
+	lc.SetAbsolute(true)
+	lc.SetSeries([]LineSeries{{Values: []float64{0.5, 0.5, math.NaN(), math.NaN(), 0.5, 0.5}}})
+	cs, r := newBarChartRenderer()
+	lc.Render(r)
+	// Points sit on dot columns 0, 1, 3, 5, 7, 9 → the gap leaves cells
+	// 1 and 2 without line dots between the two segments.
+	if braille(cs, 0, 1) == 0 || braille(cs, 4, 1) == 0 {
+		t.Error("expected dots at both ends of the line")
+	}
+	if got := braille(cs, 2, 1); got != 0 {
+		t.Errorf("gap cell = %#x; want empty", got)
+	}
+}
+
+func TestLineChart_Render_Fill(t *testing.T) {
+	theme := NewTheme()
+	theme.AddStyles(
+		NewStyle("line-chart/s0").WithColors("s0fg", ""),
+		NewStyle("line-chart/fill").WithColors("fillfg", ""),
+	)
+	lc := newPlainLineChart(2, 4)
+	lc.Apply(theme)
+	lc.SetAbsolute(true)
+	lc.SetShowFill(true)
+	lc.SetSeries([]LineSeries{{Values: []float64{1, 1}}})
+	cs, r := newBarChartRenderer()
+	lc.Render(r)
+	// The line runs along the top row; the row below is all fill.
+	if got := braille(cs, 0, 2); got != 0xff {
+		t.Errorf("fill cell = %#x; want 0xff", got)
+	}
+	if got := cs.Fg(0, 2); got != "fillfg" {
+		t.Errorf("fill colour = %q; want fillfg", got)
+	}
+	if got := cs.Fg(0, 1); got != "s0fg" {
+		t.Errorf("line colour = %q; want s0fg", got)
+	}
+}
+
+func TestLineChart_Render_SeriesColour(t *testing.T) {
+	theme := NewTheme()
+	theme.AddStyles(
+		NewStyle("line-chart/s0").WithColors("s0fg", ""),
+		NewStyle("line-chart/s1").WithColors("s1fg", ""),
+	)
+	lc := newPlainLineChart(2, 3)
+	lc.Apply(theme)
+	lc.SetAbsolute(true)
+	lc.SetSeries([]LineSeries{
+		{Values: []float64{0, 0}},
+		{Values: []float64{1, 0}},
+	})
+	cs, r := newBarChartRenderer()
+	lc.Render(r)
+	// Both series end in the bottom-right cell; their dots are combined
+	// and the later series gives the colour.
+	if got := cs.Fg(1, 1); got != "s1fg" {
+		t.Errorf("shared cell colour = %q; want s1fg", got)
+	}
+}
+
+func TestLineChart_Append_Capacity(t *testing.T) {
+	lc := NewLineChart("lc", "")
+	lc.SetSeries([]LineSeries{{Values: []float64{1, 2, 3, 4}}})
+	lc.SetCapacity(3)
+	if got := lc.Series()[0].Values; !slices.Equal(got, []float64{2, 3, 4}) {
+		t.Errorf("after SetCapacity = %v; want [2 3 4]", got)
+	}
+	lc.Append(0, 5)
+	if got := lc.Series()[0].Values; !slices.Equal(got, []float64{3, 4, 5}) {
+		t.Errorf("after Append = %v; want [3 4 5]", got)
+	}
+	lc.Append(1, 6) // out of range: ignored
+	if lc.Count() != 3 {
+		t.Errorf("Count() = %d; want 3", lc.Count())
+	}
+}
+
+func TestLineChart_Capacity_RightAligned(t *testing.T) {
+	lc := NewLineChart("lc", "")
+	lc.SetCapacity(4)
+	lc.SetSeries([]LineSeries{{Values: []float64{7, 8}}})
+	got := lc.ValuesAt(3)
+	if got[0] != 8 {
+		t.Errorf("ValuesAt(3) = %v; want newest value 8", got)
+	}
+	if v := lc.ValuesAt(0)[0]; !math.IsNaN(v) {
+		t.Errorf("ValuesAt(0) = %g; want NaN before the data", v)
+	}
+}
+
+func TestLineChart_Provider_Streaming(t *testing.T) {
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	ts := NewTimeSeries[float64](start, time.Second, 4, false)
+	lc := NewLineChart("lc", "")
+	lc.SetSeries([]LineSeries{{Provider: ts}})
+
+	ts.Set(start.Add(3*time.Second), 9)
+	if v := lc.ValuesAt(3)[0]; v != 9 {
+		t.Errorf("ValuesAt(3) = %g; want 9", v)
+	}
+	ts.Shift(2 * time.Second)
+	// The window moved on: the 9 is now two points further left.
+	if v := lc.ValuesAt(1)[0]; v != 9 {
+		t.Errorf("after Shift, ValuesAt(1) = %g; want 9", v)
+	}
+	if got := lc.xLabel(1); got != "12:00:03" {
+		t.Errorf("xLabel(1) = %q; want 12:00:03", got)
+	}
+}
+
+func TestLineChart_TimeLabels(t *testing.T) {
+	lc := newPlainLineChart(40, 6)
+	start := time.Date(2026, 1, 1, 9, 58, 0, 0, time.UTC)
+	lc.SetTimeAxis(start, time.Minute)
+	lc.SetSeries([]LineSeries{{Values: make([]float64, 30)}})
+	l, ok := lc.layout()
+	if !ok {
+		t.Fatal("layout failed")
+	}
+	labels := lc.xLabels(&l)
+	if len(labels) == 0 {
+		t.Fatal("no time labels")
+	}
+	// "15:04" needs 6 columns; 30 points over 40 columns give 1.33
+	// columns each, so labels are 5 minutes apart on round times.
+	if labels[0].index != 2 || labels[0].text != "10:00" {
+		t.Errorf("first label = %+v; want 10:00 at index 2", labels[0])
+	}
+	if labels[1].text != "10:05" {
+		t.Errorf("second label = %q; want 10:05", labels[1].text)
+	}
+	if got := timeLayout(24 * time.Hour); got != "Jan 2" {
+		t.Errorf("daily layout = %q; want Jan 2", got)
+	}
+}
+
+func TestLineChart_Select(t *testing.T) {
+	lc := NewLineChart("lc", "")
+	lc.Select(3)
+	if lc.Selected() != -1 {
+		t.Errorf("Select on empty chart = %d; want -1", lc.Selected())
+	}
+	lc.SetSeries([]LineSeries{{Values: []float64{1, 2, 3}}})
+	fired := -1
+	lc.On(EvtSelect, func(_ Widget, _ Event, data ...any) bool {
+		fired = data[0].(int)
+		return true
+	})
+	lc.Select(10)
+	if lc.Selected() != 2 || fired != 2 {
+		t.Errorf("Select(10) = %d, event %d; want 2, 2", lc.Selected(), fired)
+	}
+	lc.handleKey(tcell.NewEventKey(tcell.KeyHome, "", tcell.ModNone))
+	if lc.Selected() != 0 {
+		t.Errorf("Home → %d; want 0", lc.Selected())
+	}
+	lc.handleKey(tcell.NewEventKey(tcell.KeyRight, "", tcell.ModNone))
+	if lc.Selected() != 1 {
+		t.Errorf("Right → %d; want 1", lc.Selected())
+	}
+}
+
+func TestLineChart_Mouse(t *testing.T) {
+	lc := newPlainLineChart(10, 5)
+	lc.SetSeries([]LineSeries{{Values: []float64{1, 2, 3, 4, 5, 6}}})
+	// 6 points over 20 dot columns: x-index i sits on dot 19i/5, so
+	// index 3 is in cell 5 and index 5 in cell 9.
+	lc.handleMouse(tcell.NewEventMouse(5, 2, tcell.ButtonNone, tcell.ModNone))
+	if lc.Selected() != 3 {
+		t.Errorf("hover at 5 = %d; want 3", lc.Selected())
+	}
+	fired := -1
+	lc.On(EvtActivate, func(_ Widget, _ Event, data ...any) bool {
+		fired = data[0].(int)
+		return true
+	})
+	ev := tcell.NewEventMouse(9, 2, tcell.Button1, tcell.ModNone)
+	lc.handleMouse(ev)
+	lc.handleMouse(ev)
+	if lc.Selected() != 5 || fired != 5 {
+		t.Errorf("click at 9 = %d, activate %d; want 5, 5", lc.Selected(), fired)
+	}
+	if lc.handleMouse(tcell.NewEventMouse(5, 0, tcell.ButtonNone, tcell.ModNone)) {
+		t.Error("hover over the readout row should not be handled")
+	}
+	if lc.handleMouse(tcell.NewEventMouse(5, 2, tcell.Button2, tcell.ModNone)) {
+		t.Error("handleMouse with Button2 should return false")
+	}
+}
+
+func TestLineChart_Render_Cursor(t *testing.T) {
+	lc := newPlainLineChart(20, 6)
+	lc.SetAbsolute(true)
+	lc.SetMax(10)
+	lc.SetCategories([]string{"Mo", "Tu", "We"})
+	lc.SetSeries([]LineSeries{{Label: "cpu", Values: []float64{0, 5, 0}}})
+	lc.Select(1)
+	cs, r := newBarChartRenderer()
+	lc.Render(r)
+	if got := cs.Get(0, 0) + cs.Get(1, 0); got != "Tu" {
+		t.Errorf("readout starts with %q; want Tu", got)
+	}
+	readout := ""
+	for x := range 20 {
+		readout += cs.Get(x, 0)
+	}
+	if want := "Tu  █ cpu 5"; readout[:len(want)] != want {
+		t.Errorf("readout = %q; want prefix %q", readout, want)
+	}
+	// Index 1 sits on dot column 19 → cell 9; the top row is empty there.
+	if got := cs.Get(9, 1); got != "│" {
+		t.Errorf("cursor cell = %q; want │", got)
+	}
+}
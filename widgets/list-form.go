@@ -1,7 +1,6 @@
 package widgets
 
 import (
-	"fmt"
 	"io"
 	"strings"
 
@@ -66,18 +65,7 @@ func (f *ListForm) Validate(field string) error { return nil }
 func (f *ListForm) Emit(w io.Writer, mode string) error {
 	return f.EmitFrame(w, mode, func() error {
 		items := parseItems(f.ItemsRaw)
-		quoted := make([]string, len(items))
-		for i, it := range items {
-			quoted[i] = fmt.Sprintf("%q", it)
-		}
-		args := f.ID
-		if len(items) > 0 {
-			args = fmt.Sprintf("%q, %s", f.ID, strings.Join(quoted, ", "))
-		} else {
-			args = fmt.Sprintf("%q", f.ID)
-		}
-		_, err := fmt.Fprintf(w, "List(%s).\n", args)
-		return err
+		return f.EmitConstructor(w, mode, "List", stringArgs(mode, items)...)
 	})
 }
 
@@ -1,7 +1,6 @@
 package widgets
 
 import (
-	"fmt"
 	"io"
 	"time"
 
@@ -82,19 +81,24 @@ func (f *MarqueeForm) Validate(field string) error { return nil }
 
 func (f *MarqueeForm) Emit(w io.Writer, mode string) error {
 	if err := f.EmitFrame(w, mode, func() error {
-		_, err := fmt.Fprintf(w, "Marquee(%q).\n", f.ID)
-		return err
+		return f.EmitConstructor(w, mode, "Marquee")
 	}); err != nil {
 		return err
 	}
 	if f.Text != "" {
-		fmt.Fprintf(w, "// TODO: SetText(%q) — no Builder setter\n", f.Text)
+		if err := f.EmitTODO(w, mode, "SetText(%q)", f.Text); err != nil {
+			return err
+		}
 	}
 	if f.Speed > 1 {
-		fmt.Fprintf(w, "// TODO: SetSpeed(%d) — no Builder setter\n", f.Speed)
+		if err := f.EmitTODO(w, mode, "SetSpeed(%d)", f.Speed); err != nil {
+			return err
+		}
 	}
 	if f.Gap != 4 {
-		fmt.Fprintf(w, "// TODO: SetGap(%d) — no Builder setter\n", f.Gap)
+		if err := f.EmitTODO(w, mode, "SetGap(%d)", f.Gap); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -0,0 +1,413 @@
+package widgets
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gdamore/tcell/v3"
+	. "github.com/tekugo/zeichenwerk/core"
+)
+
+// NotificationAction is a button on a notification. Clicking it runs Fn and
+// dismisses the notification.
+type NotificationAction struct {
+	Label string // button text, shown as [Label]
+	Fn    func() // called when the button is clicked; may be nil
+}
+
+// Notification is a structured message with a level icon, a bold title, a
+// word-wrapped body and optional action buttons:
+//
+//	╭──────────────────────────────────────────────╮
+//	│ ℹ  Title text                            [×] │
+//	│    Body text that describes the event in     │
+//	│    more detail, word-wrapped to width.       │
+//	│    [Undo] [Show]                             │
+//	╰──────────────────────────────────────────────╯
+//
+// The border and icon take the accent colour of the level through the
+// :info, :success, :warning and :error style variants. Notifications are
+// usually shown with UI.Notify, which stacks them in a corner of the screen
+// and dismisses them after a timeout, but they can be placed in any layout
+// as well.
+//
+// Notification is not focusable, so it never takes the focus away from the
+// widget the user is working with; it is operated with the mouse.
+type Notification struct {
+	Component
+	level     Level
+	icon      string // overrides the theme icon when not empty
+	title     string
+	text      string
+	count     int // number of times the notification was repeated
+	closeable bool
+	actions   []NotificationAction
+	dismissed bool
+	hot       int  // part under the mouse: 0 = close, i+1 = action i, -1 = none
+	down      bool // mouse button is down
+	pressed   int  // part the mouse button was pressed on
+
+	icons   map[Level]string // level icons, resolved from the theme
+	chClose string           // close button, resolved from "notification.close"
+}
+
+// NewNotification creates a closeable info notification without title and
+// text. Use the setters to configure it.
+func NewNotification(id, class string) *Notification {
+	n := &Notification{
+		Component: Component{id: id, class: class},
+		level:     Info,
+		count:     1,
+		closeable: true,
+		hot:       -1,
+		icons: map[Level]string{
+			Debug:   "·",
+			Info:    "ℹ",
+			Success: "✓",
+			Warning: "⚠",
+			Error:   "✗",
+			Fatal:   "✗",
+		},
+		chClose: "[×]",
+	}
+	OnMouse(n, n.handleMouse)
+	return n
+}
+
+// ---- Widget Methods -------------------------------------------------------
+
+// Apply registers the base "notification" style, one variant per level and
+// the icon, title, body, close and action parts, then resolves the level
+// icons and the close button from the theme strings.
+func (n *Notification) Apply(theme *Theme) {
+	theme.Apply(n, n.Selector("notification"), "debug", "info", "success", "warning", "error", "fatal")
+	theme.Apply(n, n.Selector("notification/icon"))
+	theme.Apply(n, n.Selector("notification/title"))
+	theme.Apply(n, n.Selector("notification/body"))
+	theme.Apply(n, n.Selector("notification/close"), "hovered")
+	theme.Apply(n, n.Selector("notification/action"), "hovered")
+	for level := range n.icons {
+		if s := theme.String("notification." + string(level)); s != "" {
+			n.icons[level] = s
+		}
+	}
+	if s := theme.String("notification.close"); s != "" {
+		n.chClose = s
+	}
+}
+
+// State returns the level as its string form, so that the style of the
+// level is used. An empty level is treated as info.
+func (n *Notification) State() string {
+	if n.level == "" {
+		return string(Info)
+	}
+	return string(n.level)
+}
+
+// Hint returns the hinted width, 0 to fill the parent, and the number of
+// rows: the title, the body wrapped to the text column and a row for the
+// action buttons. Without a hinted width the body is wrapped for a
+// notification of 48 columns including border and padding.
+func (n *Notification) Hint() (int, int) {
+	if n.hheight != 0 {
+		return n.hwidth, n.hheight
+	}
+	w := n.hwidth
+	if w <= 0 {
+		w = 48 - n.Style().Horizontal()
+	}
+	return n.hwidth, n.rows(w)
+}
+
+// Render draws background and border in the style of the level, the icon
+// in the level colour, the title, the body, the close button and the
+// action buttons. Component.Render is not used, because it does not see
+// the level state.
+func (n *Notification) Render(r *Renderer) {
+	if n.Flag(FlagHidden) {
+		return
+	}
+
+	style := n.Style(":" + n.State())
+	margin := style.Margin()
+	x, y := n.x+margin.Left, n.y+margin.Top
+	w, h := n.width-margin.Horizontal(), n.height-margin.Vertical()
+	r.Set(style.Foreground(), style.Background(), "")
+	r.Fill(x, y, w, h, " ")
+	if border := style.Border(); border != "" && border != "none" {
+		parts := strings.Fields(border)
+		if len(parts) > 1 {
+			bg := style.Background()
+			if len(parts) > 2 {
+				bg = parts[2]
+			}
+			r.Set(parts[1], bg, "")
+		}
+		r.Border(x, y, w, h, parts[0])
+	}
+
+	cx, cy, cw, ch := n.Content()
+	if cw <= 0 || ch <= 0 {
+		return
+	}
+	bg := style.Background()
+
+	// Icon in the level colour
+	icon := n.icon
+	if icon == "" {
+		icon = n.icons[Level(n.State())]
+	}
+	part := n.Style("icon")
+	r.Set(style.Foreground(), bg, part.Font())
+	r.Text(cx, cy, icon, min(3, cw))
+
+	tw := n.textWidth(cw)
+	if tw <= 0 {
+		return
+	}
+
+	// Title, with the repeat count
+	title := n.title
+	if n.count > 1 {
+		title = fmt.Sprintf("%s ×%d", title, n.count)
+	}
+	part = n.Style("title")
+	r.Set(part.Foreground(), bg, part.Font())
+	r.Text(cx+3, cy, title, tw)
+
+	// Close button
+	if n.closeable {
+		part = n.partStyle("close", 0)
+		r.Set(part.Foreground(), part.Background(), part.Font())
+		r.Text(cx+cw-n.closeWidth()+1, cy, n.chClose, n.closeWidth()-1)
+	}
+
+	// Body, up to the row of the action buttons
+	last := cy + ch
+	if len(n.actions) > 0 {
+		last--
+	}
+	part = n.Style("body")
+	r.Set(part.Foreground(), bg, part.Font())
+	if n.text != "" {
+		for i, line := range wrapWords(n.text, tw) {
+			if cy+1+i >= last {
+				break
+			}
+			r.Text(cx+3, cy+1+i, line, tw)
+		}
+	}
+
+	// Action buttons
+	if len(n.actions) > 0 && ch > 1 {
+		ax := cx + 3
+		for i, action := range n.actions {
+			label := "[" + action.Label + "]"
+			aw := min(utf8.RuneCountInString(label), cx+3+tw-ax)
+			if aw <= 0 {
+				break
+			}
+			part = n.partStyle("action", i+1)
+			r.Set(part.Foreground(), part.Background(), part.Font())
+			r.Text(ax, cy+ch-1, label, aw)
+			ax += aw + 1
+		}
+	}
+}
+
+// ---- Getters and Setters --------------------------------------------------
+
+// Level returns the severity level.
+func (n *Notification) Level() Level {
+	return n.level
+}
+
+// SetLevel sets the severity level, which selects the style and the icon.
+func (n *Notification) SetLevel(level Level) *Notification {
+	n.level = level
+	n.Refresh()
+	return n
+}
+
+// SetIcon overrides the icon of the level; an empty string restores it.
+func (n *Notification) SetIcon(icon string) *Notification {
+	n.icon = icon
+	n.Refresh()
+	return n
+}
+
+// Title returns the title.
+func (n *Notification) Title() string {
+	return n.title
+}
+
+// SetTitle sets the title shown in the first row.
+func (n *Notification) SetTitle(title string) *Notification {
+	n.title = title
+	n.Refresh()
+	return n
+}
+
+// Text returns the body text.
+func (n *Notification) Text() string {
+	return n.text
+}
+
+// SetText sets the body text, which is word-wrapped below the title and
+// may contain line breaks.
+func (n *Notification) SetText(text string) *Notification {
+	n.text = text
+	n.Refresh()
+	return n
+}
+
+// Count returns how often the notification was repeated, at least 1.
+func (n *Notification) Count() int {
+	return n.count
+}
+
+// SetCount sets the repeat count. A count above 1 is shown as ×N after the
+// title.
+func (n *Notification) SetCount(count int) *Notification {
+	n.count = max(1, count)
+	n.Refresh()
+	return n
+}
+
+// Closeable returns whether the close button is shown.
+func (n *Notification) Closeable() bool {
+	return n.closeable
+}
+
+// SetCloseable shows or hides the close button. Without it, the column is
+// used for the title and body.
+func (n *Notification) SetCloseable(closeable bool) *Notification {
+	n.closeable = closeable
+	n.Refresh()
+	return n
+}
+
+// Actions returns the action buttons.
+func (n *Notification) Actions() []NotificationAction {
+	return n.actions
+}
+
+// AddAction adds a button to the bottom row of the notification. Clicking
+// it calls fn and dismisses the notification.
+func (n *Notification) AddAction(label string, fn func()) *Notification {
+	n.actions = append(n.actions, NotificationAction{Label: label, Fn: fn})
+	n.Refresh()
+	return n
+}
+
+// Dismissed returns whether the notification was dismissed.
+func (n *Notification) Dismissed() bool {
+	return n.dismissed
+}
+
+// Dismiss dismisses the notification and dispatches EvtHide. The widget
+// itself stays where it is; UI.Notify removes dismissed notifications from
+// the screen. Calling Dismiss again has no effect.
+func (n *Notification) Dismiss() {
+	if n.dismissed {
+		return
+	}
+	n.dismissed = true
+	n.Dispatch(n, EvtHide)
+}
+
+// ---- Internal Helpers -----------------------------------------------------
+
+// closeWidth returns the columns reserved for the close button, including
+// the space before it.
+func (n *Notification) closeWidth() int {
+	if !n.closeable {
+		return 0
+	}
+	return utf8.RuneCountInString(n.chClose) + 1
+}
+
+// textWidth returns the width of the text column for a content width of cw.
+func (n *Notification) textWidth(cw int) int {
+	return cw - 3 - n.closeWidth()
+}
+
+// rows returns the content height for a content width of cw.
+func (n *Notification) rows(cw int) int {
+	rows := 1
+	if n.text != "" {
+		rows += len(wrapWords(n.text, max(1, n.textWidth(cw))))
+	}
+	if len(n.actions) > 0 {
+		rows++
+	}
+	return rows
+}
+
+// partStyle returns the style of the close button or an action button,
+// hovered while the mouse is over part.
+func (n *Notification) partStyle(name string, part int) *Style {
+	if n.hot == part && n.Flag(FlagHovered) {
+		return n.Style(name + ":hovered")
+	}
+	return n.Style(name)
+}
+
+// part returns the button at the screen position: 0 for the close button,
+// i+1 for action i and -1 for none.
+func (n *Notification) part(x, y int) int {
+	cx, cy, cw, ch := n.Content()
+	if n.closeable && y == cy && x >= cx+cw-n.closeWidth()+1 && x < cx+cw {
+		return 0
+	}
+	if len(n.actions) == 0 || ch < 2 || y != cy+ch-1 {
+		return -1
+	}
+	ax := cx + 3
+	for i, action := range n.actions {
+		aw := utf8.RuneCountInString(action.Label) + 2
+		if x >= ax && x < ax+aw {
+			return i + 1
+		}
+		ax += aw + 1
+	}
+	return -1
+}
+
+// handleMouse tracks the button under the mouse and runs it when the mouse
+// button is released over the button it was pressed on.
+func (n *Notification) handleMouse(event *tcell.EventMouse) bool {
+	x, y := event.Position()
+	part := n.part(x, y)
+	if part != n.hot {
+		n.hot = part
+		n.Refresh()
+	}
+
+	switch event.Buttons() {
+	case tcell.Button1:
+		if !n.down {
+			n.down = true
+			n.pressed = part
+		}
+		return part >= 0
+	case tcell.ButtonNone:
+		if !n.down {
+			return false
+		}
+		n.down = false
+		if part < 0 || part != n.pressed {
+			return false
+		}
+		if part > 0 {
+			if fn := n.actions[part-1].Fn; fn != nil {
+				fn()
+			}
+		}
+		n.Dismiss()
+		return true
+	}
+	return false
+}
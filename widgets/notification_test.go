@@ -0,0 +1,116 @@
+package widgets
+
+import (
+	"testing"
+
+	"github.com/gdamore/tcell/v3"
+	. "github.com/tekugo/zeichenwerk/core"
+)
+
+// newTestNotification returns a 20×3 notification without border, so the
+// content area is the whole widget: icon in columns 0-2, text from column
+// 3 to 15 and the close button in columns 17-19.
+func newTestNotification() *Notification {
+	n := NewNotification("n", "")
+	n.SetTitle("Saved").SetText("one two three four")
+	n.SetBounds(0, 0, 20, 3)
+	return n
+}
+
+func screenRow(cs *TestScreen, y, from, to int) string {
+	s := ""
+	for x := from; x < to; x++ {
+		s += cs.Get(x, y)
+	}
+	return s
+}
+
+func TestNotification_Hint(t *testing.T) {
+	n := newTestNotification()
+	n.SetHint(20, 0)
+	if w, h := n.Hint(); w != 20 || h != 3 {
+		t.Errorf("Hint() = %d, %d; want 20, 3 (title and two body rows)", w, h)
+	}
+	n.AddAction("Undo", nil)
+	if _, h := n.Hint(); h != 4 {
+		t.Errorf("Hint height with actions = %d; want 4", h)
+	}
+	n.SetCloseable(false)
+	n.SetText("one two three")
+	if _, h := n.Hint(); h != 3 {
+		t.Errorf("Hint height without close button = %d; want 3", h)
+	}
+}
+
+func TestNotification_Render(t *testing.T) {
+	cs := NewTestScreen()
+	n := newTestNotification()
+	n.SetLevel(Warning).SetCount(3)
+	n.Render(NewRenderer(cs, NewTheme()))
+
+	if got := cs.Get(0, 0); got != "⚠" {
+		t.Errorf("icon = %q; want ⚠", got)
+	}
+	if got := screenRow(cs, 0, 3, 11); got != "Saved ×3" {
+		t.Errorf("title = %q; want the repeat count", got)
+	}
+	if got := screenRow(cs, 0, 17, 20); got != "[×]" {
+		t.Errorf("close button = %q; want [×]", got)
+	}
+	if got := screenRow(cs, 1, 3, 16); got != "one two three" {
+		t.Errorf("first body row = %q", got)
+	}
+
+	cs = NewTestScreen()
+	n.SetIcon("!").SetCloseable(false)
+	n.SetBounds(0, 0, 21, 3)
+	n.Render(NewRenderer(cs, NewTheme()))
+	if got := cs.Get(0, 0); got != "!" {
+		t.Errorf("icon = %q; SetIcon should override the level icon", got)
+	}
+	if got := screenRow(cs, 1, 3, 21); got != "one two three four" {
+		t.Errorf("body without close button = %q; want it on one row", got)
+	}
+}
+
+func TestNotification_Close(t *testing.T) {
+	n := newTestNotification()
+	hidden := 0
+	n.On(EvtHide, func(_ Widget, _ Event, _ ...any) bool {
+		hidden++
+		return true
+	})
+
+	// Releasing the button elsewhere does not close.
+	n.handleMouse(mouse(18, 0, tcell.Button1))
+	n.handleMouse(mouse(5, 0, tcell.ButtonNone))
+	if n.Dismissed() {
+		t.Fatal("release outside the close button should not dismiss")
+	}
+	n.handleMouse(mouse(18, 0, tcell.Button1))
+	n.handleMouse(mouse(18, 0, tcell.ButtonNone))
+	n.Dismiss()
+	if !n.Dismissed() || hidden != 1 {
+		t.Errorf("EvtHide dispatched %d times; want once", hidden)
+	}
+}
+
+func TestNotification_Action(t *testing.T) {
+	n := newTestNotification()
+	var got []string
+	n.AddAction("Undo", func() { got = append(got, "undo") })
+	n.AddAction("Show", func() { got = append(got, "show") })
+	n.SetBounds(0, 0, 20, 4)
+
+	if part := n.part(3, 3); part != 1 {
+		t.Errorf("part at [Undo] = %d; want 1", part)
+	}
+	if part := n.part(9, 3); part != -1 {
+		t.Errorf("part between the buttons = %d; want -1", part)
+	}
+	n.handleMouse(mouse(11, 3, tcell.Button1))
+	n.handleMouse(mouse(11, 3, tcell.ButtonNone))
+	if len(got) != 1 || got[0] != "show" || !n.Dismissed() {
+		t.Errorf("actions run = %v, dismissed %t; want [show], true", got, n.Dismissed())
+	}
+}
@@ -50,16 +50,28 @@ func (f *ProgressForm) Validate(field string) error { return nil }
 // values emit as TODO comments.
 func (f *ProgressForm) Emit(w io.Writer, mode string) error {
 	if err := f.EmitFrame(w, mode, func() error {
-		_, err := fmt.Fprintf(w, "Progress(%q, %t).\n", f.ID, f.Horizontal)
-		return err
+		return f.EmitConstructor(w, mode, "Progress", fmt.Sprintf("%t", f.Horizontal))
 	}); err != nil {
 		return err
 	}
+	if mode == "compose" {
+		if f.Total > 0 {
+			fmt.Fprintf(w, "Total(%d),\n", f.Total)
+		}
+		if f.Value != 0 {
+			fmt.Fprintf(w, "Value(%d),\n", f.Value)
+		}
+		return nil
+	}
 	if f.Total > 0 {
-		fmt.Fprintf(w, "// TODO: SetTotal(%d) — no Builder setter\n", f.Total)
+		if err := f.EmitTODO(w, mode, "SetTotal(%d)", f.Total); err != nil {
+			return err
+		}
 	}
 	if f.Value != 0 {
-		fmt.Fprintf(w, "// TODO: Set(%d) — no Builder setter\n", f.Value)
+		if err := f.EmitTODO(w, mode, "Set(%d)", f.Value); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -1,7 +1,6 @@
 package widgets
 
 import (
-	"fmt"
 	"io"
 	"strings"
 
@@ -68,18 +67,18 @@ func (f *RadioForm) Validate(field string) error { return nil }
 // Builder setter and emits as a TODO comment after the frame.
 func (f *RadioForm) Emit(w io.Writer, mode string) error {
 	if err := f.EmitFrame(w, mode, func() error {
-		opts := parseSelectOptions(f.OptionsRaw)
-		args := fmt.Sprintf("%q", f.ID)
-		for _, o := range opts {
-			args += fmt.Sprintf(", %q, %q", o.value, o.text)
+		var args []string
+		for _, o := range parseSelectOptions(f.OptionsRaw) {
+			args = append(args, o.value, o.text)
 		}
-		_, err := fmt.Fprintf(w, "Radio(%s).\n", args)
-		return err
+		return f.EmitConstructor(w, mode, "Radio", stringArgs(mode, args)...)
 	}); err != nil {
 		return err
 	}
 	if f.Selected != "" {
-		fmt.Fprintf(w, "// TODO: Select(%q) — no Builder setter\n", f.Selected)
+		if err := f.EmitTODO(w, mode, "Select(%q)", f.Selected); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -0,0 +1,101 @@
+package widgets
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/tekugo/zeichenwerk/core"
+)
+
+// ResponsiveForm is the WidgetForm for *Responsive. The variant shown is
+// picked by the layout, so the form holds no value of its own; each
+// variant's Breakpoint is edited through ResponsiveLayoutForm.
+type ResponsiveForm struct {
+	ComponentForm
+}
+
+func (f *ResponsiveForm) Name() string  { return "Responsive" }
+func (f *ResponsiveForm) Group() string { return "container" }
+func (f *ResponsiveForm) Help() string {
+	return "Container that shows one variant depending on its size"
+}
+
+func (f *ResponsiveForm) Load(w core.Widget) {
+	f.ComponentForm.Load(&w.(*Responsive).Component)
+}
+
+func (f *ResponsiveForm) Store(w core.Widget) {
+	f.ComponentForm.Store(&w.(*Responsive).Component)
+}
+
+func (f *ResponsiveForm) New() core.Widget {
+	r := NewResponsive("", "")
+	f.Store(r)
+	return r
+}
+
+func (f *ResponsiveForm) Validate(field string) error { return nil }
+
+// Emit writes the Responsive constructor.
+func (f *ResponsiveForm) Emit(w io.Writer, mode string) error {
+	return f.EmitFrame(w, mode, func() error {
+		return f.EmitConstructor(w, mode, "Responsive")
+	})
+}
+
+// LayoutForm returns a fresh per-child layout form already loaded with
+// the child's Breakpoint.
+func (f *ResponsiveForm) LayoutForm(parent core.Container, child core.Widget) core.LayoutForm {
+	lf := &ResponsiveLayoutForm{}
+	lf.Load(parent, child)
+	return lf
+}
+
+// ResponsiveLayoutForm captures one variant's Breakpoint on a Responsive.
+type ResponsiveLayoutForm struct {
+	MinWidth  int `group:"breakpoint" label:"Min Width"`
+	MinHeight int `group:"breakpoint" label:"Min Height"`
+}
+
+// Load reads child's Breakpoint from the container.
+func (f *ResponsiveLayoutForm) Load(parent core.Container, child core.Widget) {
+	if r, ok := parent.(*Responsive); ok {
+		point := r.Breakpoint(child)
+		f.MinWidth, f.MinHeight = point.MinWidth, point.MinHeight
+	}
+}
+
+// Store sets child's Breakpoint on the container to match the form.
+func (f *ResponsiveLayoutForm) Store(parent core.Container, child core.Widget) {
+	if r, ok := parent.(*Responsive); ok {
+		r.SetBreakpoint(child, Breakpoint{MinWidth: f.MinWidth, MinHeight: f.MinHeight})
+	}
+}
+
+func (f *ResponsiveLayoutForm) Validate(field string) error {
+	if f.MinWidth < 0 || f.MinHeight < 0 {
+		return fmt.Errorf("breakpoint sizes must be ≥ 0")
+	}
+	return nil
+}
+
+// Emit writes the Breakpoint prefix that precedes the child's
+// constructor. Variants matching every size get no prefix. In compose
+// mode the prefix opens a Breakpoint wrapper, "Breakpoint(w, h, ",
+// which the codegen walker closes after the child.
+func (f *ResponsiveLayoutForm) Emit(w io.Writer, mode string) error {
+	var format string
+	switch mode {
+	case "builder":
+		format = "Breakpoint(%d, %d).\n"
+	case "compose":
+		format = "Breakpoint(%d, %d, "
+	default:
+		return fmt.Errorf("unknown mode %q", mode)
+	}
+	if f.MinWidth == 0 && f.MinHeight == 0 {
+		return nil
+	}
+	_, err := fmt.Fprintf(w, format, f.MinWidth, f.MinHeight)
+	return err
+}
@@ -0,0 +1,349 @@
+package widgets
+
+import (
+	"fmt"
+	"reflect"
+
+	. "github.com/tekugo/zeichenwerk/core"
+)
+
+// Breakpoint is the minimum content size at which a [Responsive] variant
+// is shown. Pass it to Add or Insert:
+//
+//	responsive.Add(narrow)                            // Fallback
+//	responsive.Add(wide, Breakpoint{MinWidth: 120})   // 120 columns and more
+//	responsive.Add(tall, Breakpoint{MinHeight: 40})   // 40 rows and more
+//
+// The zero Breakpoint matches every size.
+type Breakpoint struct {
+	MinWidth  int // Minimum content width; 0 for any width
+	MinHeight int // Minimum content height; 0 for any height
+}
+
+// fits reports whether a content size satisfies the breakpoint.
+func (b Breakpoint) fits(width, height int) bool {
+	return width >= b.MinWidth && height >= b.MinHeight
+}
+
+// Responsive is a container holding alternative subtrees, called variants,
+// of which it shows one at a time like a [Switcher]. Instead of being
+// selected explicitly, the variant is chosen on every layout by the size
+// of the content area, so a UI can change its arrangement when the
+// terminal is resized.
+//
+// Each variant has a [Breakpoint]. Of all variants whose breakpoint fits
+// the content area, the one with the largest MinWidth wins, then the one
+// with the largest MinHeight, then the one added last. If none fits, the
+// variant with the smallest breakpoint is shown.
+//
+// Variants usually contain widgets with the same IDs in different
+// arrangements. When the variant changes, the state of every widget in the
+// old variant is carried over to the widget with the same ID and type in
+// the new one, and keyboard focus moves along with it. See [Responsive.Layout].
+type Responsive struct {
+	Component
+	active   int          // Index of the visible variant
+	variants []Widget     // Alternative subtrees
+	points   []Breakpoint // Breakpoint of each variant
+}
+
+// NewResponsive creates a new, empty responsive container.
+func NewResponsive(id, class string) *Responsive {
+	return &Responsive{
+		Component: Component{id: id, class: class},
+	}
+}
+
+// ---- Widget Methods -------------------------------------------------------
+
+// Apply applies a theme's styles to the component.
+func (r *Responsive) Apply(theme *Theme) {
+	theme.Apply(r, r.Selector("responsive"))
+}
+
+// Hint returns the preferred size of the visible variant. Unlike a
+// Switcher it does not use the largest variant, because a parent that
+// sizes the container by its hint would otherwise never reach the smaller
+// breakpoints.
+func (r *Responsive) Hint() (int, int) {
+	if r.hwidth != 0 || r.hheight != 0 {
+		return r.hwidth, r.hheight
+	}
+	if r.active < len(r.variants) {
+		return r.variants[r.active].Hint()
+	}
+	return 0, 0
+}
+
+// Refresh redraws the container.
+func (r *Responsive) Refresh() {
+	Redraw(r)
+}
+
+// Render draws the container and its visible variant.
+func (r *Responsive) Render(renderer *Renderer) {
+	r.Component.Render(renderer)
+	if r.active < len(r.variants) {
+		r.variants[r.active].Render(renderer)
+	}
+}
+
+// ---- Container Methods ----------------------------------------------------
+
+// Add appends a variant. A [Breakpoint] parameter, as value or pointer, sets
+// its minimum size; without one, the variant matches every size. The first
+// variant is visible until the next layout picks one. Returns ErrChildIsNil
+// if widget is nil.
+func (r *Responsive) Add(widget Widget, params ...any) error {
+	return r.Insert(len(r.variants), widget, params...)
+}
+
+// Children returns all variants regardless of visibility.
+func (r *Responsive) Children() []Widget {
+	return r.variants
+}
+
+// Insert places a variant at index. Out-of-range indices are clamped. The
+// parameters are the same as for Add.
+func (r *Responsive) Insert(index int, widget Widget, params ...any) error {
+	if widget == nil {
+		return ErrChildIsNil
+	}
+	index = max(0, min(index, len(r.variants)))
+	widget.SetParent(r)
+	x, y, w, h := r.Content()
+	widget.SetBounds(x, y, w, h)
+
+	r.variants = append(r.variants, nil)
+	copy(r.variants[index+1:], r.variants[index:])
+	r.variants[index] = widget
+	r.points = append(r.points, Breakpoint{})
+	copy(r.points[index+1:], r.points[index:])
+	r.points[index] = breakpointParam(params)
+
+	if len(r.variants) > 1 && index <= r.active {
+		r.active++
+	}
+	widget.SetFlag(FlagHidden, index != r.active)
+	return nil
+}
+
+// Remove drops a variant. If it was visible, the first remaining variant
+// is shown until the next layout.
+func (r *Responsive) Remove(child Widget) error {
+	if child == nil {
+		return ErrChildIsNil
+	}
+	for i, v := range r.variants {
+		if v != child {
+			continue
+		}
+		r.variants = append(r.variants[:i], r.variants[i+1:]...)
+		r.points = append(r.points[:i], r.points[i+1:]...)
+		child.SetParent(nil)
+		child.SetFlag(FlagHidden, false)
+		switch {
+		case i < r.active:
+			r.active--
+		case i == r.active:
+			r.active = 0
+		}
+		if len(r.variants) > 0 {
+			r.variants[r.active].SetFlag(FlagHidden, false)
+		}
+		return nil
+	}
+	return ErrNotFound
+}
+
+// Layout shows the variant matching the content size and lays out all
+// variants to fill the content area.
+//
+// When the matching variant changes, the old one receives EvtHide, the new
+// one EvtShow and the container dispatches EvtChange with the index of the
+// new variant. Before that, state is carried over between widgets with the
+// same ID and type: if a widget has a Get method and a Set method accepting
+// its result, like Input, Checkbox or Slider, the value of the old widget is
+// set on the new one. If the old variant held the keyboard focus, it moves
+// to the widget with the same ID in the new variant, or to the first
+// focusable widget there.
+func (r *Responsive) Layout() error {
+	x, y, w, h := r.Content()
+	if next := r.match(w, h); next != r.active && next < len(r.variants) {
+		r.show(next)
+	}
+	for _, variant := range r.variants {
+		variant.SetBounds(x, y, w, h)
+	}
+	return Layout(r)
+}
+
+// ---- Breakpoints ----------------------------------------------------------
+
+// Active returns the index of the visible variant.
+func (r *Responsive) Active() int {
+	return r.active
+}
+
+// Breakpoint returns the breakpoint of a variant.
+func (r *Responsive) Breakpoint(child Widget) Breakpoint {
+	for i, v := range r.variants {
+		if v == child {
+			return r.points[i]
+		}
+	}
+	return Breakpoint{}
+}
+
+// SetBreakpoint changes the breakpoint of a variant. Children not in the
+// container are ignored. The new breakpoint takes effect on the next
+// layout.
+func (r *Responsive) SetBreakpoint(child Widget, point Breakpoint) {
+	for i, v := range r.variants {
+		if v == child {
+			r.points[i] = point
+			return
+		}
+	}
+}
+
+// match returns the index of the variant for a content size.
+func (r *Responsive) match(width, height int) int {
+	best, fallback := -1, 0
+	for i, p := range r.points {
+		if p.fits(width, height) {
+			if best < 0 || !larger(r.points[best], p) {
+				best = i
+			}
+		} else if larger(r.points[fallback], p) {
+			fallback = i
+		}
+	}
+	if best < 0 {
+		return fallback
+	}
+	return best
+}
+
+// larger reports whether breakpoint a is larger than b, comparing the
+// width first.
+func larger(a, b Breakpoint) bool {
+	if a.MinWidth != b.MinWidth {
+		return a.MinWidth > b.MinWidth
+	}
+	return a.MinHeight > b.MinHeight
+}
+
+// show switches to another variant, carrying over state and focus.
+func (r *Responsive) show(index int) {
+	old, next := r.variants[r.active], r.variants[index]
+	r.Log(r, Debug, "Switching variant", "from", r.active, "to", index, "ID", next.ID())
+
+	var focused Widget
+	visit(old, func(w Widget) {
+		if w.Flag(FlagFocused) {
+			focused = w
+		}
+		if w.ID() == "" {
+			return
+		}
+		if target := findIn(next, w.ID()); target != nil {
+			carry(w, target)
+		}
+	})
+
+	old.SetFlag(FlagHidden, true)
+	next.SetFlag(FlagHidden, false)
+	r.active = index
+	old.Dispatch(old, EvtHide)
+	next.Dispatch(next, EvtShow)
+	r.Dispatch(r, EvtChange, index)
+
+	if focused == nil {
+		return
+	}
+	if root := FindRoot(r); root != nil {
+		target := findIn(next, focused.ID())
+		if focused.ID() == "" || target == nil || !target.Flag(FlagFocusable) {
+			target = nil
+			visit(next, func(w Widget) {
+				if target == nil && w.Flag(FlagFocusable) && !w.Flag(FlagDisabled) {
+					target = w
+				}
+			})
+		}
+		root.Focus(target)
+	}
+}
+
+// breakpointParam extracts a Breakpoint from Add or Insert parameters.
+func breakpointParam(params []any) Breakpoint {
+	for _, param := range params {
+		switch p := param.(type) {
+		case Breakpoint:
+			return p
+		case *Breakpoint:
+			if p != nil {
+				return *p
+			}
+		}
+	}
+	return Breakpoint{}
+}
+
+// visit calls fn for widget and all its descendants.
+func visit(widget Widget, fn func(Widget)) {
+	fn(widget)
+	if container, ok := widget.(Container); ok {
+		Traverse(container, func(w Widget) bool {
+			fn(w)
+			return true
+		})
+	}
+}
+
+// findIn returns the widget with the given ID in a subtree, including its
+// root, or nil.
+func findIn(widget Widget, id string) Widget {
+	if container, ok := widget.(Container); ok {
+		return Find(container, id)
+	}
+	if widget.ID() == id {
+		return widget
+	}
+	return nil
+}
+
+// carry copies the value of one widget to another of the same type through
+// their Get and Set methods. Widgets without matching methods are left
+// alone. Inputs also keep their cursor position.
+func carry(from, to Widget) {
+	if from == to || reflect.TypeOf(from) != reflect.TypeOf(to) {
+		return
+	}
+	get := reflect.ValueOf(from).MethodByName("Get")
+	set := reflect.ValueOf(to).MethodByName("Set")
+	if !get.IsValid() || !set.IsValid() {
+		return
+	}
+	gt, st := get.Type(), set.Type()
+	if gt.NumIn() != 0 || gt.NumOut() != 1 || st.NumIn() != 1 || st.IsVariadic() || !gt.Out(0).AssignableTo(st.In(0)) {
+		return
+	}
+	set.Call(get.Call(nil))
+	if input, ok := from.(*Input); ok {
+		to.(*Input).pos = min(input.pos, to.(*Input).buf.Length())
+		to.(*Input).adjust()
+	}
+}
+
+// ---- Summary --------------------------------------------------------------
+
+// Summary returns the visible variant and its breakpoint for Dump output.
+func (r *Responsive) Summary() string {
+	if r.active >= len(r.points) {
+		return "empty"
+	}
+	p := r.points[r.active]
+	return fmt.Sprintf("showing=%d min=%dx%d", r.active, p.MinWidth, p.MinHeight)
+}
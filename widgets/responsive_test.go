@@ -0,0 +1,132 @@
+package widgets
+
+import (
+	"testing"
+
+	. "github.com/tekugo/zeichenwerk/core"
+)
+
+// responsiveVariants builds a container with a fallback, a wide and a tall
+// variant.
+func responsiveVariants() (*Responsive, []*Static) {
+	r := NewResponsive("r", "")
+	fallback := NewStatic("fallback", "", "")
+	wide := NewStatic("wide", "", "")
+	tall := NewStatic("tall", "", "")
+	r.Add(fallback)
+	r.Add(wide, Breakpoint{MinWidth: 80})
+	r.Add(tall, &Breakpoint{MinHeight: 30})
+	return r, []*Static{fallback, wide, tall}
+}
+
+// ── Add ───────────────────────────────────────────────────────────────────────
+
+func TestResponsive_Add_FirstVariantVisible(t *testing.T) {
+	r, variants := responsiveVariants()
+	if variants[0].Flag(FlagHidden) || !variants[1].Flag(FlagHidden) || !variants[2].Flag(FlagHidden) {
+		t.Error("only the first variant should be visible before the first layout")
+	}
+	if got := r.Breakpoint(variants[2]); got != (Breakpoint{MinHeight: 30}) {
+		t.Errorf("Breakpoint = %+v; want MinHeight 30 from a pointer parameter", got)
+	}
+	if err := r.Add(nil); err == nil {
+		t.Error("Add(nil) should return ErrChildIsNil")
+	}
+}
+
+// ── Layout ────────────────────────────────────────────────────────────────────
+
+func TestResponsive_Layout_PicksBreakpoint(t *testing.T) {
+	r, variants := responsiveVariants()
+	cases := []struct {
+		w, h, want int
+	}{
+		{40, 10, 0},
+		{80, 10, 1},
+		{40, 30, 2},
+		{100, 40, 1}, // Width wins over height
+		{79, 29, 0},
+	}
+	for _, c := range cases {
+		r.SetBounds(0, 0, c.w, c.h)
+		r.Layout()
+		if r.Active() != c.want {
+			t.Errorf("%dx%d: Active = %d; want %d", c.w, c.h, r.Active(), c.want)
+		}
+		for i, v := range variants {
+			if v.Flag(FlagHidden) != (i != c.want) {
+				t.Errorf("%dx%d: variant %d hidden = %v", c.w, c.h, i, v.Flag(FlagHidden))
+			}
+		}
+		if _, _, w, h := variants[c.want].Bounds(); w != c.w || h != c.h {
+			t.Errorf("%dx%d: variant bounds %dx%d", c.w, c.h, w, h)
+		}
+	}
+}
+
+func TestResponsive_Layout_SmallestFallback(t *testing.T) {
+	r := NewResponsive("r", "")
+	r.Add(NewStatic("large", "", ""), Breakpoint{MinWidth: 120})
+	r.Add(NewStatic("medium", "", ""), Breakpoint{MinWidth: 80})
+	r.SetBounds(0, 0, 40, 10)
+	r.Layout()
+	if r.Active() != 1 {
+		t.Errorf("Active = %d; want the variant with the smallest breakpoint", r.Active())
+	}
+}
+
+func TestResponsive_Layout_CarriesStateAndEvents(t *testing.T) {
+	r := NewResponsive("r", "")
+	narrow := NewFlex("narrow", "", Stretch, 0)
+	narrow.Add(NewInput("name", "", "Ada"))
+	narrow.Add(NewCheckbox("ok", "", "OK", true))
+	wide := NewFlex("wide", "", Stretch, 0)
+	wide.Add(NewInput("name", "", ""))
+	wide.Add(NewCheckbox("ok", "", "OK", false))
+	wide.Add(NewStatic("name2", "", ""))
+	r.Add(narrow)
+	r.Add(wide, Breakpoint{MinWidth: 80})
+
+	var events []string
+	narrow.On(EvtHide, func(_ Widget, _ Event, _ ...any) bool { events = append(events, "hide"); return true })
+	wide.On(EvtShow, func(_ Widget, _ Event, _ ...any) bool { events = append(events, "show"); return true })
+	r.On(EvtChange, func(_ Widget, _ Event, data ...any) bool {
+		events = append(events, "change")
+		if data[0] != 1 {
+			t.Errorf("EvtChange data = %v; want 1", data)
+		}
+		return true
+	})
+
+	r.SetBounds(0, 0, 100, 10)
+	r.Layout()
+	if got := Find(wide, "name").(*Input).Get(); got != "Ada" {
+		t.Errorf("input = %q; want Ada", got)
+	}
+	if !Find(wide, "ok").(*Checkbox).Get() {
+		t.Error("checkbox state was not carried over")
+	}
+	if len(events) != 3 || events[0] != "hide" || events[1] != "show" || events[2] != "change" {
+		t.Errorf("events = %v; want [hide show change]", events)
+	}
+}
+
+// ── Remove ────────────────────────────────────────────────────────────────────
+
+func TestResponsive_Remove_Active(t *testing.T) {
+	r, variants := responsiveVariants()
+	r.SetBounds(0, 0, 100, 10)
+	r.Layout()
+	if err := r.Remove(variants[1]); err != nil {
+		t.Fatal(err)
+	}
+	if r.Active() != 0 || variants[0].Flag(FlagHidden) {
+		t.Error("removing the visible variant should show the first one")
+	}
+	if variants[1].Parent() != nil || variants[1].Flag(FlagHidden) {
+		t.Error("removed variant should be detached and visible")
+	}
+	if got := r.Breakpoint(variants[2]); got.MinHeight != 30 {
+		t.Errorf("breakpoints out of step after Remove: %+v", got)
+	}
+}
@@ -64,11 +64,21 @@ func (f *RuleForm) Emit(w io.Writer, mode string) error {
 	if err := f.CheckBuilderMode(mode); err != nil {
 		return err
 	}
-	f.EmitClassPrefix(w)
 	ctor := "HRule"
 	if !f.Horizontal {
 		ctor = "VRule"
 	}
+	if mode == "compose" {
+		// Compose takes the class as first parameter instead.
+		if _, err := fmt.Fprintf(w, "%s(%q, %q,\n", ctor, f.Class, f.BorderStyle); err != nil {
+			return err
+		}
+		if err := f.EmitOptions(w); err != nil {
+			return err
+		}
+		return f.EmitStyleOptions(w)
+	}
+	f.EmitClassPrefix(w)
 	fmt.Fprintf(w, "%s(%q).\n", ctor, f.BorderStyle)
 	f.EmitChain(w)
 	f.EmitStyle(w)
@@ -69,7 +69,6 @@ func (f *ScannerForm) Emit(w io.Writer, mode string) error {
 		if style == "" {
 			style = "blocks"
 		}
-		_, err := fmt.Fprintf(w, "Scanner(%q, %d, %q).\n", f.ID, width, style)
-		return err
+		return f.EmitConstructor(w, mode, "Scanner", fmt.Sprintf("%d, %q", width, style))
 	})
 }
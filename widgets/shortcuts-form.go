@@ -1,7 +1,6 @@
 package widgets
 
 import (
-	"fmt"
 	"io"
 	"strings"
 
@@ -49,13 +48,11 @@ func (f *ShortcutsForm) Validate(field string) error { return nil }
 
 func (f *ShortcutsForm) Emit(w io.Writer, mode string) error {
 	return f.EmitFrame(w, mode, func() error {
-		pairs := parseShortcutPairs(f.PairsRaw)
-		args := fmt.Sprintf("%q", f.ID)
-		for _, p := range pairs {
-			args += fmt.Sprintf(", %q, %q", p.key, p.label)
+		var args []string
+		for _, p := range parseShortcutPairs(f.PairsRaw) {
+			args = append(args, p.key, p.label)
 		}
-		_, err := fmt.Fprintf(w, "Shortcuts(%s).\n", args)
-		return err
+		return f.EmitConstructor(w, mode, "Shortcuts", stringArgs(mode, args)...)
 	})
 }
 
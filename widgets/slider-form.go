@@ -60,19 +60,36 @@ func (f *SliderForm) Validate(field string) error {
 // chained on the Builder, so non-default values emit as TODO comments.
 func (f *SliderForm) Emit(w io.Writer, mode string) error {
 	if err := f.EmitFrame(w, mode, func() error {
-		_, err := fmt.Fprintf(w, "Slider(%q).\n", f.ID)
-		return err
+		return f.EmitConstructor(w, mode, "Slider")
 	}); err != nil {
 		return err
 	}
+	if mode == "compose" {
+		if f.Minimum != 0 || f.Maximum != 100 {
+			fmt.Fprintf(w, "Range(%d, %d),\n", f.Minimum, f.Maximum)
+		}
+		if f.Step != 1 {
+			fmt.Fprintf(w, "Step(%d),\n", f.Step)
+		}
+		if f.Value != 0 {
+			fmt.Fprintf(w, "Value(%d),\n", f.Value)
+		}
+		return nil
+	}
 	if f.Minimum != 0 || f.Maximum != 100 {
-		fmt.Fprintf(w, "// TODO: SetMin(%d) / SetMax(%d) — no Builder setter\n", f.Minimum, f.Maximum)
+		if err := f.EmitTODO(w, mode, "SetMin(%d) / SetMax(%d)", f.Minimum, f.Maximum); err != nil {
+			return err
+		}
 	}
 	if f.Step != 1 {
-		fmt.Fprintf(w, "// TODO: SetStep(%d) — no Builder setter\n", f.Step)
+		if err := f.EmitTODO(w, mode, "SetStep(%d)", f.Step); err != nil {
+			return err
+		}
 	}
 	if f.Value != 0 {
-		fmt.Fprintf(w, "// TODO: Set(%d) — no Builder setter\n", f.Value)
+		if err := f.EmitTODO(w, mode, "Set(%d)", f.Value); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -68,6 +68,9 @@ func (s *Slider) Refresh() {
 // Value returns the current slider value.
 func (s *Slider) Value() int { return s.value }
 
+// Get returns the current slider value. It mirrors Set for generic access.
+func (s *Slider) Get() int { return s.value }
+
 // Min returns the minimum allowed value.
 func (s *Slider) Min() int { return s.min }
 
@@ -44,7 +44,6 @@ func (f *SpinnerForm) Validate(field string) error { return nil }
 
 func (f *SpinnerForm) Emit(w io.Writer, mode string) error {
 	return f.EmitFrame(w, mode, func() error {
-		_, err := fmt.Fprintf(w, "Spinner(%q, %q).\n", f.ID, f.Sequence)
-		return err
+		return f.EmitConstructor(w, mode, "Spinner", fmt.Sprintf("%q", f.Sequence))
 	})
 }
@@ -0,0 +1,54 @@
+package widgets
+
+import (
+	"io"
+
+	"github.com/tekugo/zeichenwerk/core"
+)
+
+// SplitterForm is the WidgetForm for *Splitter. The split itself lives
+// in the hints of the neighbours, so the form only carries the minimum
+// size; Store clamps it to >= 0 like SetMinSize.
+type SplitterForm struct {
+	ComponentForm
+
+	MinSize int `group:"general" label:"Min size"`
+}
+
+func (f *SplitterForm) Name() string  { return "Splitter" }
+func (f *SplitterForm) Group() string { return "leaf" }
+func (f *SplitterForm) Help() string  { return "Draggable handle between two Flex children" }
+
+func (f *SplitterForm) Load(w core.Widget) {
+	s := w.(*Splitter)
+	f.ComponentForm.Load(&s.Component)
+	f.MinSize = s.minSize
+}
+
+func (f *SplitterForm) Store(w core.Widget) {
+	s := w.(*Splitter)
+	f.ComponentForm.Store(&s.Component)
+	s.SetMinSize(f.MinSize)
+}
+
+func (f *SplitterForm) New() core.Widget {
+	s := NewSplitter("", "")
+	f.Store(s)
+	return s
+}
+
+func (f *SplitterForm) Validate(field string) error { return nil }
+
+func (f *SplitterForm) Emit(w io.Writer, mode string) error {
+	if err := f.EmitFrame(w, mode, func() error {
+		return f.EmitConstructor(w, mode, "Splitter")
+	}); err != nil {
+		return err
+	}
+	if f.MinSize != 1 {
+		if err := f.EmitTODO(w, mode, "SetMinSize(%d)", f.MinSize); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,342 @@
+package widgets
+
+import (
+	"math"
+	"time"
+
+	"github.com/gdamore/tcell/v3"
+	. "github.com/tekugo/zeichenwerk/core"
+)
+
+// Splitter is a draggable handle placed between two children of a Flex.
+// Dragging it, or pressing the arrow keys while it has focus, moves space
+// from one neighbour to the other by changing their size hints, so the
+// parent lays them out anew. In a horizontal Flex the splitter is a
+// one-column vertical bar dragged left and right; in a vertical Flex a
+// one-row horizontal bar dragged up and down.
+//
+// The first resize turns the hints of both neighbours into fixed sizes.
+// A double click restores the hints they had before, fractional ones
+// included. Neither neighbour is made smaller than the minimum size (see
+// SetMinSize). A FlexItem basis set for a neighbour takes precedence over
+// its hint, so the neighbours should be added without one.
+//
+// Events:
+//   - [EvtChange] – float64: share of the space of both neighbours that
+//     the one before the splitter takes, in [0, 1]; dispatched when a drag
+//     ends, on every key press and on reset
+type Splitter struct {
+	Component
+	minSize    int       // Minimum content size of either neighbour
+	dragging   bool      // Drag in progress
+	dragOrigin int       // Mouse coordinate along the drag axis at drag start
+	dragSize   int       // Size of the neighbour before the splitter at drag start
+	moved      bool      // The current drag changed the split
+	ratio      float64   // Split set by the last resize
+	saved      bool      // hints holds the neighbours' hints before the first resize
+	deferred   bool      // A SetRatio call waits for the layout
+	hints      [2][2]int // Hints (width, height) of before and after, for Reset
+	lastClick  time.Time // Time of the last click, for double-click detection
+
+	// Characters read from theme strings in Apply; defaults set in constructor.
+	chVertical   string
+	chHorizontal string
+	chGripV      string
+	chGripH      string
+}
+
+// NewSplitter creates a new focusable splitter with a minimum neighbour
+// size of 1.
+func NewSplitter(id, class string) *Splitter {
+	s := &Splitter{
+		Component: Component{id: id, class: class, hwidth: 1, hheight: 1},
+		minSize:   1,
+
+		chVertical:   "│",
+		chHorizontal: "─",
+		chGripV:      "╪",
+		chGripH:      "╫",
+	}
+	s.SetFlag(FlagFocusable, true)
+	OnKey(s, s.handleKey)
+	OnMouse(s, s.handleMouse)
+	return s
+}
+
+// Apply registers the splitter styles and reads the theme strings.
+func (s *Splitter) Apply(theme *Theme) {
+	theme.Apply(s, s.Selector("splitter"), "focused", "hovered")
+	str := func(key, def string) string {
+		if v := theme.String(key); v != "" {
+			return v
+		}
+		return def
+	}
+	s.chVertical = str("splitter.vertical", "│")
+	s.chHorizontal = str("splitter.horizontal", "─")
+	s.chGripV = str("splitter.grip.vertical", "╪")
+	s.chGripH = str("splitter.grip.horizontal", "╫")
+}
+
+// SetMinSize sets the minimum content size, in cells along the drag axis,
+// that both neighbours keep (minimum 0).
+func (s *Splitter) SetMinSize(n int) {
+	s.minSize = max(0, n)
+}
+
+// MinSize returns the minimum size of the neighbours.
+func (s *Splitter) MinSize() int { return s.minSize }
+
+// Ratio returns the share of the space of both neighbours that the one
+// before the splitter takes, as laid out last. It is 0 when the splitter
+// has no neighbours or they have no size yet.
+func (s *Splitter) Ratio() float64 {
+	before, after := s.neighbours()
+	if before == nil {
+		return 0
+	}
+	a, b := s.size(before), s.size(after)
+	if a+b == 0 {
+		return 0
+	}
+	return float64(a) / float64(a+b)
+}
+
+// SetRatio divides the space of both neighbours so that the one before
+// the splitter takes the share r, e.g. a ratio persisted from an earlier
+// EvtChange. The minimum size still applies. The split depends on the
+// laid-out sizes; before the first layout the call is deferred once with
+// Root.Post until the UI has run its layout. It is dropped if the
+// neighbours still have no size then.
+func (s *Splitter) SetRatio(r float64) {
+	before, after := s.neighbours()
+	if before == nil {
+		return
+	}
+	total := s.size(before) + s.size(after)
+	if total == 0 {
+		if root := FindRoot(s); root != nil && !s.deferred {
+			s.deferred = true
+			root.Post(func() {
+				s.SetRatio(r)
+				s.deferred = false
+			})
+		}
+		return
+	}
+	r = max(0, min(r, 1))
+	s.resize(int(math.Round(float64(total) * r)))
+}
+
+// Reset restores the hints the neighbours had before the first resize and
+// dispatches EvtChange. It is a no-op when the split has not been changed.
+func (s *Splitter) Reset() {
+	before, after := s.neighbours()
+	if before == nil || !s.saved {
+		return
+	}
+	before.SetHint(s.hints[0][0], s.hints[0][1])
+	after.SetHint(s.hints[1][0], s.hints[1][1])
+	s.saved = false
+	Relayout(s)
+	s.Dispatch(s, EvtChange, s.Ratio())
+}
+
+// Render draws the handle along the full length of the splitter with a
+// grip mark in the middle.
+func (s *Splitter) Render(r *Renderer) {
+	if s.Flag(FlagHidden) {
+		return
+	}
+	s.Component.Render(r)
+	x, y, w, h := s.Content()
+	if w < 1 || h < 1 {
+		return
+	}
+	if s.vertical() {
+		r.Repeat(x, y, 1, 0, w, s.chHorizontal)
+		r.Put(x+w/2, y, s.chGripH)
+	} else {
+		r.Repeat(x, y, 0, 1, h, s.chVertical)
+		r.Put(x, y+h/2, s.chGripV)
+	}
+}
+
+// ---- Internal Helpers -----------------------------------------------------
+
+// vertical reports whether the parent stacks its children vertically, so
+// the splitter is a horizontal bar dragged up and down. Outside a Flex
+// the orientation is inferred from the splitter's bounds.
+func (s *Splitter) vertical() bool {
+	if parent := s.Parent(); parent != nil {
+		if _, ok := parent.(*Flex); ok {
+			return parent.Flag(FlagVertical)
+		}
+	}
+	_, _, w, h := s.Bounds()
+	return h == 1 && w > 1
+}
+
+// neighbours returns the siblings directly before and after the splitter,
+// or nil, nil when the parent is not a Flex or one of them is missing.
+func (s *Splitter) neighbours() (Widget, Widget) {
+	parent, ok := s.Parent().(*Flex)
+	if !ok {
+		return nil, nil
+	}
+	children := parent.Children()
+	for i, child := range children {
+		if child == s {
+			if i == 0 || i == len(children)-1 {
+				return nil, nil
+			}
+			return children[i-1], children[i+1]
+		}
+	}
+	return nil, nil
+}
+
+// size returns the laid-out extent of w along the drag axis, including
+// its style.
+func (s *Splitter) size(w Widget) int {
+	_, _, width, height := w.Bounds()
+	if s.vertical() {
+		return height
+	}
+	return width
+}
+
+// resize gives the neighbour before the splitter the extent a along the
+// drag axis and the one after the rest of their common space, keeping
+// both at least at the minimum size. The hints are set as fixed content
+// sizes, the new split is kept in ratio and the tree is laid out again.
+// It reports whether the split changed.
+func (s *Splitter) resize(a int) bool {
+	before, after := s.neighbours()
+	if before == nil {
+		return false
+	}
+	vertical := s.vertical()
+	styleA, styleB := before.Style().Horizontal(), after.Style().Horizontal()
+	if vertical {
+		styleA, styleB = before.Style().Vertical(), after.Style().Vertical()
+	}
+	oldA := s.size(before)
+	total := oldA + s.size(after)
+	a = min(a, total-s.minSize-styleB)
+	a = max(a, s.minSize+styleA)
+	if a == oldA || a < 0 || a > total {
+		return false
+	}
+
+	wa, ha := before.Hint()
+	wb, hb := after.Hint()
+	if !s.saved {
+		s.hints = [2][2]int{{wa, ha}, {wb, hb}}
+		s.saved = true
+	}
+	if vertical {
+		before.SetHint(wa, a-styleA)
+		after.SetHint(wb, total-a-styleB)
+	} else {
+		before.SetHint(a-styleA, ha)
+		after.SetHint(total-a-styleB, hb)
+	}
+	s.ratio = float64(a) / float64(total)
+	Relayout(s)
+	return true
+}
+
+// position returns the mouse coordinate along the drag axis.
+func (s *Splitter) position(evt *tcell.EventMouse) int {
+	x, y := evt.Position()
+	if s.vertical() {
+		return y
+	}
+	return x
+}
+
+// ---- Event Handlers -------------------------------------------------------
+
+func (s *Splitter) handleKey(evt *tcell.EventKey) bool {
+	before, _ := s.neighbours()
+	if before == nil {
+		return false
+	}
+	delta := 0
+	switch evt.Key() {
+	case tcell.KeyLeft:
+		if !s.vertical() {
+			delta = -1
+		}
+	case tcell.KeyRight:
+		if !s.vertical() {
+			delta = 1
+		}
+	case tcell.KeyUp:
+		if s.vertical() {
+			delta = -1
+		}
+	case tcell.KeyDown:
+		if s.vertical() {
+			delta = 1
+		}
+	}
+	if delta == 0 {
+		return false
+	}
+	if s.resize(s.size(before) + delta) {
+		s.Dispatch(s, EvtChange, s.ratio)
+	}
+	return true
+}
+
+// handleMouse starts a drag on a button-1 press, follows the mouse while
+// the button is held and ends the drag on release. A double click resets
+// the split.
+func (s *Splitter) handleMouse(evt *tcell.EventMouse) bool {
+	switch evt.Buttons() {
+	case tcell.Button1:
+		if s.dragging {
+			s.drag(evt)
+			return true
+		}
+		before, _ := s.neighbours()
+		if before == nil {
+			return false
+		}
+		now := time.Now()
+		if now.Sub(s.lastClick) < DoubleClickThreshold {
+			s.lastClick = time.Time{}
+			s.Reset()
+			return true
+		}
+		s.lastClick = now
+		s.dragging = true
+		s.moved = false
+		s.dragOrigin = s.position(evt)
+		s.dragSize = s.size(before)
+		capture(s, true)
+		return true
+	case tcell.ButtonNone:
+		if !s.dragging {
+			return false
+		}
+		s.drag(evt)
+		s.dragging = false
+		capture(s, false)
+		if s.moved {
+			s.Dispatch(s, EvtChange, s.ratio)
+		}
+		return true
+	}
+	return false
+}
+
+// drag moves the split by the distance the mouse moved since the drag
+// started.
+func (s *Splitter) drag(evt *tcell.EventMouse) {
+	if s.resize(s.dragSize + s.position(evt) - s.dragOrigin) {
+		s.moved = true
+	}
+}
@@ -0,0 +1,187 @@
+package widgets
+
+import (
+	"testing"
+
+	"github.com/gdamore/tcell/v3"
+	. "github.com/tekugo/zeichenwerk/core"
+)
+
+// newSplitterFlex returns a 31×5 flex holding a 10-wide static, a
+// splitter and a static taking the rest, laid out.
+func newSplitterFlex(vertical bool) (*Flex, *Static, *Splitter, *Static) {
+	f := NewFlex("f", "", Stretch, 0)
+	f.SetFlag(FlagVertical, vertical)
+	a := NewStatic("a", "", "A")
+	b := NewStatic("b", "", "B")
+	s := NewSplitter("s", "")
+	if vertical {
+		a.SetHint(0, 10)
+		b.SetHint(0, -1)
+		f.SetBounds(0, 0, 5, 31)
+	} else {
+		a.SetHint(10, 0)
+		b.SetHint(-1, 0)
+		f.SetBounds(0, 0, 31, 5)
+	}
+	f.Add(a)
+	f.Add(s)
+	f.Add(b)
+	f.Layout()
+	return f, a, s, b
+}
+
+// changes records the ratios the splitter reports with EvtChange.
+func changes(s *Splitter) *[]float64 {
+	var got []float64
+	s.On(EvtChange, func(_ Widget, _ Event, data ...any) bool {
+		got = append(got, data[0].(float64))
+		return true
+	})
+	return &got
+}
+
+func mouse(x, y int, buttons tcell.ButtonMask) *tcell.EventMouse {
+	return tcell.NewEventMouse(x, y, buttons, tcell.ModNone)
+}
+
+func TestSplitter_Neighbours(t *testing.T) {
+	_, a, s, b := newSplitterFlex(false)
+	before, after := s.neighbours()
+	if before != a || after != b {
+		t.Errorf("neighbours = %s, %s; want a, b", ID(before), ID(after))
+	}
+	if s.vertical() {
+		t.Error("splitter in a horizontal flex reported vertical")
+	}
+	if _, _, w, h := s.Bounds(); w != 1 || h != 5 {
+		t.Errorf("bounds = %d×%d; want 1×5", w, h)
+	}
+
+	alone := NewFlex("f", "", Stretch, 0)
+	edge := NewSplitter("s", "")
+	alone.Add(edge)
+	alone.Add(NewStatic("a", "", "A"))
+	if before, after := edge.neighbours(); before != nil || after != nil {
+		t.Error("splitter at the edge of a flex should have no neighbours")
+	}
+}
+
+func TestSplitter_Keyboard(t *testing.T) {
+	f, a, s, b := newSplitterFlex(false)
+	got := changes(s)
+
+	s.handleKey(tcell.NewEventKey(tcell.KeyRight, "", tcell.ModNone))
+	f.Layout()
+	if w, _ := a.Hint(); w != 11 {
+		t.Errorf("a hint after → = %d; want 11", w)
+	}
+	if w, _ := b.Hint(); w != 19 {
+		t.Errorf("b hint after → = %d; want 19 (fixed)", w)
+	}
+	if len(*got) != 1 || (*got)[0] != 11.0/30 {
+		t.Errorf("EvtChange = %v; want [11/30]", *got)
+	}
+	if s.handleKey(tcell.NewEventKey(tcell.KeyUp, "", tcell.ModNone)) {
+		t.Error("Up should be ignored in a horizontal flex")
+	}
+
+	// Nudging stops at the minimum size.
+	s.SetMinSize(2)
+	for range 20 {
+		s.handleKey(tcell.NewEventKey(tcell.KeyLeft, "", tcell.ModNone))
+		f.Layout()
+	}
+	if _, _, w, _ := a.Bounds(); w != 2 {
+		t.Errorf("a width after nudging left = %d; want 2", w)
+	}
+}
+
+func TestSplitter_Drag(t *testing.T) {
+	f, a, s, b := newSplitterFlex(false)
+	got := changes(s)
+
+	s.handleMouse(mouse(10, 2, tcell.Button1))
+	s.handleMouse(mouse(14, 2, tcell.Button1))
+	f.Layout()
+	if _, _, w, _ := a.Bounds(); w != 14 {
+		t.Errorf("a width during drag = %d; want 14", w)
+	}
+	if len(*got) != 0 {
+		t.Errorf("EvtChange during drag = %v; want none", *got)
+	}
+	// Dragging past the end clamps to the minimum size of b.
+	s.handleMouse(mouse(40, 2, tcell.ButtonNone))
+	f.Layout()
+	if _, _, w, _ := b.Bounds(); w != 1 {
+		t.Errorf("b width after drag = %d; want 1", w)
+	}
+	if s.dragging {
+		t.Error("release did not end the drag")
+	}
+	if len(*got) != 1 || (*got)[0] != 29.0/30 {
+		t.Errorf("EvtChange = %v; want [29/30]", *got)
+	}
+
+	// A click without movement reports nothing.
+	s.lastClick = s.lastClick.Add(-DoubleClickThreshold)
+	s.handleMouse(mouse(29, 2, tcell.Button1))
+	s.handleMouse(mouse(29, 2, tcell.ButtonNone))
+	if len(*got) != 1 {
+		t.Errorf("EvtChange after a plain click = %v; want one entry", *got)
+	}
+}
+
+func TestSplitter_Reset(t *testing.T) {
+	f, a, s, b := newSplitterFlex(false)
+	s.SetRatio(0.5)
+	f.Layout()
+	if _, _, w, _ := a.Bounds(); w != 15 {
+		t.Errorf("a width after SetRatio(0.5) = %d; want 15", w)
+	}
+
+	got := changes(s)
+	// A double click restores the original, fractional hints.
+	s.handleMouse(mouse(15, 2, tcell.Button1))
+	s.handleMouse(mouse(15, 2, tcell.ButtonNone))
+	s.handleMouse(mouse(15, 2, tcell.Button1))
+	if w, _ := a.Hint(); w != 10 {
+		t.Errorf("a hint after reset = %d; want 10", w)
+	}
+	if w, _ := b.Hint(); w != -1 {
+		t.Errorf("b hint after reset = %d; want -1", w)
+	}
+	if len(*got) != 1 {
+		t.Errorf("EvtChange after reset = %v; want one entry", *got)
+	}
+}
+
+func TestSplitter_Vertical(t *testing.T) {
+	f, a, s, _ := newSplitterFlex(true)
+	if !s.vertical() {
+		t.Fatal("splitter in a vertical flex should be vertical")
+	}
+	if s.handleKey(tcell.NewEventKey(tcell.KeyRight, "", tcell.ModNone)) {
+		t.Error("Right should be ignored in a vertical flex")
+	}
+	s.handleKey(tcell.NewEventKey(tcell.KeyDown, "", tcell.ModNone))
+	f.Layout()
+	if _, _, _, h := a.Bounds(); h != 11 {
+		t.Errorf("a height after ↓ = %d; want 11", h)
+	}
+	if w, _ := a.Hint(); w != 0 {
+		t.Errorf("a width hint = %d; want it kept at 0", w)
+	}
+}
+
+func TestSplitter_Render(t *testing.T) {
+	_, _, s, _ := newSplitterFlex(false)
+	cs := NewTestScreen()
+	s.Render(NewRenderer(cs, NewTheme()))
+	if got := cs.Get(10, 0); got != "│" {
+		t.Errorf("handle = %q; want │", got)
+	}
+	if got := cs.Get(10, 2); got != "╪" {
+		t.Errorf("grip = %q; want ╪", got)
+	}
+}
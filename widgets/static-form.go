@@ -52,13 +52,14 @@ func (f *StaticForm) Validate(field string) error { return nil }
 // the frame because the Builder API has no chained setter for it.
 func (f *StaticForm) Emit(w io.Writer, mode string) error {
 	if err := f.EmitFrame(w, mode, func() error {
-		_, err := fmt.Fprintf(w, "Static(%q, %q).\n", f.ID, f.Text)
-		return err
+		return f.EmitConstructor(w, mode, "Static", fmt.Sprintf("%q", f.Text))
 	}); err != nil {
 		return err
 	}
 	if f.Alignment != "" && f.Alignment != "left" {
-		fmt.Fprintf(w, "// TODO: SetAlignment(%q) — no Builder setter\n", f.Alignment)
+		if err := f.EmitTODO(w, mode, "SetAlignment(%q)", f.Alignment); err != nil {
+			return err
+		}
 	}
 	return nil
 }
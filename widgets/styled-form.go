@@ -42,7 +42,6 @@ func (f *StyledForm) Validate(field string) error { return nil }
 
 func (f *StyledForm) Emit(w io.Writer, mode string) error {
 	return f.EmitFrame(w, mode, func() error {
-		_, err := fmt.Fprintf(w, "Styled(%q, %q).\n", f.ID, f.Text)
-		return err
+		return f.EmitConstructor(w, mode, "Styled", fmt.Sprintf("%q", f.Text))
 	})
 }
@@ -1,7 +1,6 @@
 package widgets
 
 import (
-	"fmt"
 	"io"
 
 	"github.com/tekugo/zeichenwerk/core"
@@ -57,13 +56,17 @@ func (f *SwitcherForm) Validate(field string) error { return nil }
 // and leaves wiring to the user.
 func (f *SwitcherForm) Emit(w io.Writer, mode string) error {
 	if err := f.EmitFrame(w, mode, func() error {
-		_, err := fmt.Fprintf(w, "Switcher(%q, false).\n", f.ID)
-		return err
+		if mode == "compose" {
+			return f.EmitConstructor(w, mode, "Switcher")
+		}
+		return f.EmitConstructor(w, mode, "Switcher", "false")
 	}); err != nil {
 		return err
 	}
 	if f.Selected > 0 {
-		fmt.Fprintf(w, "// TODO: Select(%d) — no Builder setter\n", f.Selected)
+		if err := f.EmitTODO(w, mode, "Select(%d)", f.Selected); err != nil {
+			return err
+		}
 	}
 	return nil
 }
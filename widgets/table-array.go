@@ -1,5 +1,11 @@
 package widgets
 
+import (
+	"fmt"
+
+	. "github.com/tekugo/zeichenwerk/core"
+)
+
 // ArrayTableProvider is a concrete implementation of TableProvider that
 // stores table data in memory as a 2D slice of strings. This is the most
 // common provider for static or small datasets.
@@ -85,3 +91,49 @@ func (a *ArrayTableProvider) Length() int {
 func (a *ArrayTableProvider) Str(row, column int) string {
 	return a.data[row][column]
 }
+
+// SetStr sets the value of a cell. The column width is not changed.
+// Returns an error wrapping ErrOutOfRange if the cell does not exist.
+func (a *ArrayTableProvider) SetStr(row, column int, value string) error {
+	if row < 0 || row >= len(a.data) || column < 0 || column >= len(a.data[row]) {
+		return fmt.Errorf("%w: cell %d,%d", ErrOutOfRange, row, column)
+	}
+	a.data[row][column] = value
+	return nil
+}
+
+// SetSortable sets whether the user can sort by the given columns, or by all
+// columns if none are given.
+func (a *ArrayTableProvider) SetSortable(sortable bool, columns ...int) {
+	for _, i := range a.indices(columns) {
+		a.columns[i].Sortable = sortable
+	}
+}
+
+// SetFilterable sets whether Table.Filter matches the given columns, or all
+// columns if none are given.
+func (a *ArrayTableProvider) SetFilterable(filterable bool, columns ...int) {
+	for _, i := range a.indices(columns) {
+		a.columns[i].Filterable = filterable
+	}
+}
+
+// SetEditable sets whether the cells of the given columns, or of all columns
+// if none are given, can be edited in place.
+func (a *ArrayTableProvider) SetEditable(editable bool, columns ...int) {
+	for _, i := range a.indices(columns) {
+		a.columns[i].Editable = editable
+	}
+}
+
+// indices returns columns, or the indices of all columns if it is empty.
+func (a *ArrayTableProvider) indices(columns []int) []int {
+	if len(columns) > 0 {
+		return columns
+	}
+	all := make([]int, len(a.columns))
+	for i := range all {
+		all[i] = i
+	}
+	return all
+}
@@ -7,6 +7,7 @@ type TableColumn struct {
 	Header     string         // Display text for the column header
 	Width      int            // Column width in characters (auto-calculated for ArrayTableProvider)
 	Alignment  core.Alignment // AlignLeft, AlignCenter, or AlignRight
-	Sortable   bool           // Whether this column supports sorting (not yet implemented)
-	Filterable bool           // Whether this column supports filtering (not yet implemented)
+	Sortable   bool           // Whether the user can sort by this column
+	Filterable bool           // Whether Table.Filter matches this column
+	Editable   bool           // Whether cells can be edited with an EditableTableProvider
 }
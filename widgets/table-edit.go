@@ -0,0 +1,141 @@
+package widgets
+
+import (
+	"github.com/gdamore/tcell/v3"
+	. "github.com/tekugo/zeichenwerk/core"
+)
+
+// SetValidator sets a function that checks an edited value before it is
+// passed to [EditableTableProvider.SetStr]. A non-nil error keeps the cell
+// editor open and shows the error below the cell. row is the table row of
+// the edited cell. Set fn to nil to clear.
+func (t *Table) SetValidator(fn func(row, col int, value string) error) {
+	t.validator = fn
+}
+
+// Editing reports whether the cell editor is open.
+func (t *Table) Editing() bool {
+	return t.editing
+}
+
+// Edit opens an Input over cell (row, col) to edit its value in place.
+// Cells are editable if the provider implements [EditableTableProvider]
+// and the column is marked [TableColumn.Editable]; in cell-navigation mode
+// Enter and F2 call Edit for the selected cell, so Enter dispatches
+// EvtActivate only on columns that are not editable.
+//
+// The cell is selected and scrolled into view first. Enter commits the
+// value, Esc cancels. The value is checked by the validator and stored with
+// SetStr of the provider; if either returns an error, the error is shown
+// below the cell and the editor stays open. A committed change dispatches
+// EvtChange with the row, column, old and new value.
+//
+// Edit returns false if the column is not editable, the table is
+// read-only or not part of a UI, the row does not exist or the editor is
+// already open.
+func (t *Table) Edit(row, col int) bool {
+	root := FindRoot(t)
+	if root == nil || t.editing || !t.editable(col) || row < 0 || row >= t.length() {
+		return false
+	}
+	provider := t.provider.(EditableTableProvider)
+	if t.cellNav {
+		t.SetSelected(row, col)
+	} else {
+		t.row = row
+		t.adjust()
+		t.column = col
+		t.adjustCol()
+	}
+	x, y, w, ok := t.CellBounds(row, col)
+	if !ok {
+		return false
+	}
+	theme := root.Theme()
+	old := t.str(row, col)
+
+	popup := NewFlex("table-edit", "table", Stretch, 0)
+	popup.SetFlag(FlagVertical, true)
+	input := NewInput("table-edit-input", "table", old)
+	input.SetHint(w, 1)
+	message := NewStatic("table-edit-error", "table", "")
+	message.SetFlag(FlagHidden, true)
+	popup.Add(input)
+	popup.Add(message)
+	popup.Apply(theme)
+	input.Apply(theme)
+	message.Apply(theme)
+	message.SetStyle("", t.Style("error"))
+	input.End()
+
+	// fail shows an error below the cell, widening the popup to fit it.
+	fail := func(err error) {
+		text := err.Error()
+		message.Set(text)
+		message.SetHint(max(w, len([]rune(text))), 1)
+		message.SetFlag(FlagHidden, false)
+		popup.SetBounds(x, y, max(w, len([]rune(text))), 2)
+		popup.Layout()
+		Redraw(root)
+	}
+
+	OnKey(input, func(evt *tcell.EventKey) bool {
+		switch evt.Key() {
+		case tcell.KeyEnter:
+			value := input.Get()
+			if value != old {
+				if err := t.commit(provider, row, col, old, value); err != nil {
+					fail(err)
+					return true
+				}
+			}
+			root.Close()
+			root.Focus(t)
+			return true
+		case tcell.KeyEsc:
+			root.Close()
+			root.Focus(t)
+			return true
+		}
+		return false
+	})
+
+	popup.On(EvtClose, func(_ Widget, _ Event, _ ...any) bool {
+		t.editing = false
+		t.Refresh()
+		return false
+	})
+
+	t.editing = true
+	root.Popup(x, y, w, 1, popup)
+	return true
+}
+
+// editable reports whether cells of a column can be edited: the provider
+// implements [EditableTableProvider], the column is marked Editable and the
+// table is not read-only.
+func (t *Table) editable(col int) bool {
+	if _, ok := t.provider.(EditableTableProvider); !ok || t.Flag(FlagReadonly) {
+		return false
+	}
+	columns := t.provider.Columns()
+	return col >= 0 && col < len(columns) && columns[col].Editable
+}
+
+// commit validates and stores an edited value and dispatches EvtChange.
+// Sorting and filtering are re-applied afterwards, keeping the edited row
+// selected while it is visible.
+func (t *Table) commit(provider EditableTableProvider, row, col int, old, value string) error {
+	if t.validator != nil {
+		if err := t.validator(row, col, value); err != nil {
+			return err
+		}
+	}
+	if err := provider.SetStr(t.SourceRow(row), col, value); err != nil {
+		return err
+	}
+	t.Log(t, Debug, "Cell edited", "row", row, "column", col)
+	t.Dispatch(t, EvtChange, row, col, old, value)
+	t.reindex()
+	return nil
+}
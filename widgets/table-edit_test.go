@@ -0,0 +1,98 @@
+package widgets
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gdamore/tcell/v3"
+	. "github.com/tekugo/zeichenwerk/core"
+)
+
+// ── Editing ──────────────────────────────────────────────────────────────────
+
+func TestArrayTableProvider_SetStr(t *testing.T) {
+	p := makeProvider()
+	if err := p.SetStr(1, 2, "Hamburg"); err != nil {
+		t.Fatalf("SetStr = %v", err)
+	}
+	if got := p.Str(1, 2); got != "Hamburg" {
+		t.Errorf("Str = %q; want Hamburg", got)
+	}
+	if err := p.SetStr(5, 0, "x"); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("SetStr out of range = %v; want ErrOutOfRange", err)
+	}
+}
+
+func TestTable_Edit_RequiresEditableColumn(t *testing.T) {
+	p := makeProvider()
+	tbl := NewTable("t", "", p, true)
+	if tbl.editable(0) {
+		t.Error("column without the Editable flag should not be editable")
+	}
+	p.SetEditable(true, 0)
+	if !tbl.editable(0) || tbl.editable(1) {
+		t.Error("SetEditable(true, 0) should make only column 0 editable")
+	}
+	tbl.SetFlag(FlagReadonly, true)
+	if tbl.editable(0) {
+		t.Error("read-only table should not be editable")
+	}
+}
+
+func TestTable_Edit_WithoutUI(t *testing.T) {
+	p := makeProvider()
+	p.SetEditable(true)
+	tbl := NewTable("t", "", p, true)
+	tbl.SetBounds(0, 0, 40, 10)
+	if tbl.Edit(0, 0) {
+		t.Error("Edit outside a UI should return false")
+	}
+}
+
+func TestTable_Enter_ActivatesNonEditableColumn(t *testing.T) {
+	p := makeProvider()
+	p.SetEditable(true, 1)
+	tbl := NewTable("t", "", p, true)
+	tbl.SetBounds(0, 0, 40, 10)
+	activated := false
+	tbl.On(EvtActivate, func(_ Widget, _ Event, _ ...any) bool {
+		activated = true
+		return true
+	})
+	tbl.handleKey(tcell.NewEventKey(tcell.KeyEnter, "", tcell.ModNone))
+	if !activated {
+		t.Error("Enter on a column that is not editable should dispatch EvtActivate")
+	}
+}
+
+func TestTable_Commit_ValidatesAndReindexes(t *testing.T) {
+	p := makeProvider()
+	tbl := NewTable("t", "", p, true)
+	tbl.Sort(0, SortAscending)
+	tbl.SetValidator(func(_, _ int, value string) error {
+		if value == "" {
+			return errors.New("required")
+		}
+		return nil
+	})
+	var data []any
+	tbl.On(EvtChange, func(_ Widget, _ Event, args ...any) bool {
+		data = args
+		return true
+	})
+	if err := tbl.commit(p, 0, 0, "Alice", ""); err == nil {
+		t.Error("commit of an empty value should fail validation")
+	}
+	if err := tbl.commit(p, 0, 0, "Alice", "Zoe"); err != nil {
+		t.Fatalf("commit = %v", err)
+	}
+	if p.Str(0, 0) != "Zoe" {
+		t.Errorf("provider row 0 = %q; want Zoe", p.Str(0, 0))
+	}
+	if len(data) != 4 || data[2] != "Alice" || data[3] != "Zoe" {
+		t.Errorf("EvtChange data = %v; want row, col, Alice, Zoe", data)
+	}
+	if got := tbl.str(4, 0); got != "Zoe" {
+		t.Errorf("last sorted row = %q; want Zoe after re-sorting", got)
+	}
+}
@@ -53,8 +53,7 @@ func (f *TableForm) Validate(field string) error { return nil }
 // "tableProvider" with the actual variable.
 func (f *TableForm) Emit(w io.Writer, mode string) error {
 	return f.EmitFrame(w, mode, func() error {
-		_, err := fmt.Fprintf(w, "Table(%q, tableProvider /* TODO */, %t).\n", f.ID, f.CellNav)
-		return err
+		return f.EmitConstructor(w, mode, "Table", fmt.Sprintf("tableProvider /* TODO */, %t", f.CellNav))
 	})
 }
 
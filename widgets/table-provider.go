@@ -17,3 +17,32 @@ type TableProvider interface {
 	// for the first data row (header is handled separately).
 	Str(row, column int) string
 }
+
+// SortOrder is the sort direction of a table column.
+type SortOrder int
+
+const (
+	SortNone       SortOrder = iota // Provider order
+	SortAscending                   // Smallest value first
+	SortDescending                  // Largest value first
+)
+
+// SortableProvider is an optional extension of [TableProvider] for data
+// sources that can sort natively, e.g. by re-running a database query with
+// a different ORDER BY. When the provider of a Table implements it, Table
+// calls Sort instead of sorting the rows itself; column filters are still
+// applied by the table on top of the provider order. SortNone restores the
+// natural order.
+type SortableProvider interface {
+	TableProvider
+	Sort(column int, order SortOrder)
+}
+
+// EditableTableProvider is an optional extension of [TableProvider] for
+// data sources whose cells can be edited in place with [Table.Edit]. SetStr
+// returns an error to reject a value; the table shows it below the cell
+// and keeps the editor open.
+type EditableTableProvider interface {
+	TableProvider
+	SetStr(row, column int, value string) error
+}
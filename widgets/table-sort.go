@@ -0,0 +1,310 @@
+package widgets
+
+import (
+	"cmp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ---- Sorting ----------------------------------------------------------------
+
+// Sort sorts the table by column in the given order; SortNone restores the
+// provider order. Providers implementing [SortableProvider] sort natively,
+// all others are sorted through an index over the provider rows, so the
+// provider itself is never modified. Values that parse as numbers compare
+// numerically and sort before text, text compares case-insensitively, and
+// equal values keep their provider order.
+//
+// Sort works on any column; [TableColumn.Sortable] only controls whether
+// the user can sort by clicking the header or pressing s. The selected row
+// stays selected if it is still visible.
+func (t *Table) Sort(column int, order SortOrder) {
+	if t.provider == nil || column < 0 || column >= len(t.provider.Columns()) {
+		return
+	}
+	if order == SortNone {
+		column = -1
+	}
+	if sp, ok := t.provider.(SortableProvider); ok {
+		if column >= 0 {
+			sp.Sort(column, order)
+		} else if t.sortColumn >= 0 {
+			sp.Sort(t.sortColumn, SortNone)
+		}
+	}
+	t.sortColumn, t.sortOrder = column, order
+	t.reindex()
+}
+
+// Sorting returns the sorted column and the sort order. The column is -1
+// when the table is not sorted.
+func (t *Table) Sorting() (int, SortOrder) {
+	return t.sortColumn, t.sortOrder
+}
+
+// cycleSort advances the sort of a sortable column from unsorted to
+// ascending, descending and back to unsorted. Another column starts with
+// ascending.
+func (t *Table) cycleSort(column int) bool {
+	columns := t.provider.Columns()
+	if column < 0 || column >= len(columns) || !columns[column].Sortable {
+		return false
+	}
+	order := SortAscending
+	if column == t.sortColumn {
+		order = (t.sortOrder + 1) % 3
+	}
+	t.Sort(column, order)
+	return true
+}
+
+// sortIndicator returns the header text of a column with the sort glyph
+// right-aligned in the last cells of the column.
+func (t *Table) sortIndicator(column int, header TableColumn, ascending, descending string) string {
+	if column != t.sortColumn {
+		return header.Header
+	}
+	glyph := []rune(ascending)
+	if t.sortOrder == SortDescending {
+		glyph = []rune(descending)
+	}
+	if len(glyph) == 0 || len(glyph) >= header.Width {
+		return header.Header
+	}
+	label := []rune(cellText(header.Header, header.Width-len(glyph), header.Alignment))
+	return string(label) + strings.Repeat(" ", header.Width-len(glyph)-len(label)) + string(glyph)
+}
+
+// compareCells compares two cell values: numbers numerically and before
+// text, text case-insensitively.
+func compareCells(a, b sortKey) int {
+	switch {
+	case a.number && b.number:
+		return cmp.Compare(a.value, b.value)
+	case a.number:
+		return -1
+	case b.number:
+		return 1
+	}
+	return strings.Compare(a.text, b.text)
+}
+
+// sortKey is a cell value prepared for comparison.
+type sortKey struct {
+	text   string
+	value  float64
+	number bool
+}
+
+// newSortKey parses a cell value once before sorting.
+func newSortKey(text string) sortKey {
+	text = strings.TrimSpace(text)
+	if value, err := strconv.ParseFloat(text, 64); err == nil {
+		return sortKey{value: value, number: true}
+	}
+	return sortKey{text: strings.ToLower(text)}
+}
+
+// ---- Filtering --------------------------------------------------------------
+
+// Filter shows only rows where at least one filterable column contains the
+// query as a case-insensitive substring. An empty query shows all rows
+// again. Filter implements [Filterable], so a [Filter] widget can be bound
+// to the table directly; use FilterColumn to bind a Filter to a single
+// column instead.
+func (t *Table) Filter(query string) {
+	t.query = strings.ToLower(query)
+	t.reindex()
+}
+
+// SetColumnFilter shows only rows whose value in column contains the query
+// as a case-insensitive substring. Column filters combine with each other
+// and with Filter. An empty query removes the column filter.
+func (t *Table) SetColumnFilter(column int, query string) {
+	if query == "" {
+		delete(t.filters, column)
+	} else {
+		if t.filters == nil {
+			t.filters = make(map[int]string)
+		}
+		t.filters[column] = strings.ToLower(query)
+	}
+	t.reindex()
+}
+
+// ColumnFilter returns the lower-cased filter query of a column.
+func (t *Table) ColumnFilter(column int) string {
+	return t.filters[column]
+}
+
+// FilterColumn returns a [Filterable] that filters the table by one column,
+// for binding a [Filter] widget per column. It also implements [Suggester]
+// with the distinct values of that column.
+func (t *Table) FilterColumn(column int) Filterable {
+	return &tableColumnFilter{table: t, column: column}
+}
+
+// Suggest returns the distinct values of all filterable columns that have
+// query as a case-insensitive prefix, in provider order.
+func (t *Table) Suggest(query string) []string {
+	columns := t.provider.Columns()
+	var filterable []int
+	for i, column := range columns {
+		if column.Filterable {
+			filterable = append(filterable, i)
+		}
+	}
+	return t.suggest(query, filterable...)
+}
+
+// suggest collects the distinct values of columns with prefix query.
+func (t *Table) suggest(query string, columns ...int) []string {
+	if query == "" || t.provider == nil {
+		return nil
+	}
+	lower := strings.ToLower(query)
+	seen := make(map[string]bool)
+	var results []string
+	for row := range t.provider.Length() {
+		for _, column := range columns {
+			value := t.provider.Str(row, column)
+			if !seen[value] && strings.HasPrefix(strings.ToLower(value), lower) {
+				seen[value] = true
+				results = append(results, value)
+			}
+		}
+	}
+	return results
+}
+
+// matches reports whether a provider row passes the table and column
+// filters.
+func (t *Table) matches(row int, columns []TableColumn) bool {
+	for column, query := range t.filters {
+		if column < len(columns) && !strings.Contains(strings.ToLower(t.provider.Str(row, column)), query) {
+			return false
+		}
+	}
+	if t.query == "" {
+		return true
+	}
+	for i, column := range columns {
+		if column.Filterable && strings.Contains(strings.ToLower(t.provider.Str(row, i)), t.query) {
+			return true
+		}
+	}
+	return false
+}
+
+// tableColumnFilter binds a Filter widget to a single table column.
+type tableColumnFilter struct {
+	table  *Table
+	column int
+}
+
+func (f *tableColumnFilter) Filter(query string) {
+	f.table.SetColumnFilter(f.column, query)
+}
+
+func (f *tableColumnFilter) Suggest(query string) []string {
+	return f.table.suggest(query, f.column)
+}
+
+// ---- Row index --------------------------------------------------------------
+
+// SourceRow returns the provider row shown at a table row. Table rows, as
+// used by Selected, SetSelected, CellBounds, the cell styler and events,
+// are positions in the sorted and filtered view. Returns -1 if row is out of
+// range.
+func (t *Table) SourceRow(row int) int {
+	if row < 0 || row >= t.length() {
+		return -1
+	}
+	if t.rows == nil {
+		return row
+	}
+	return t.rows[row]
+}
+
+// Reload re-applies sorting and filtering after the data of the provider
+// changed. It is not needed while the table is neither sorted nor
+// filtered.
+func (t *Table) Reload() {
+	t.reindex()
+}
+
+// length returns the number of visible rows.
+func (t *Table) length() int {
+	if t.rows != nil {
+		return len(t.rows)
+	}
+	if t.provider == nil {
+		return 0
+	}
+	return t.provider.Length()
+}
+
+// str returns the text of a cell at a table row.
+func (t *Table) str(row, column int) string {
+	if t.rows != nil {
+		row = t.rows[row]
+	}
+	return t.provider.Str(row, column)
+}
+
+// reindex rebuilds the visible rows from the provider, the filters and the
+// sort order, keeping the selected provider row selected when possible.
+func (t *Table) reindex() {
+	if t.provider == nil {
+		return
+	}
+	selected := t.SourceRow(t.row)
+	columns := t.provider.Columns()
+	if t.sortColumn >= len(columns) {
+		t.sortColumn, t.sortOrder = -1, SortNone
+	}
+	_, native := t.provider.(SortableProvider)
+	sorted := t.sortColumn >= 0 && !native
+
+	if !sorted && t.query == "" && len(t.filters) == 0 {
+		t.rows = nil
+	} else {
+		n := t.provider.Length()
+		rows := make([]int, 0, n)
+		for row := range n {
+			if t.matches(row, columns) {
+				rows = append(rows, row)
+			}
+		}
+		if sorted {
+			keys := make([]sortKey, n)
+			for _, row := range rows {
+				keys[row] = newSortKey(t.provider.Str(row, t.sortColumn))
+			}
+			descending := t.sortOrder == SortDescending
+			slices.SortStableFunc(rows, func(a, b int) int {
+				if descending {
+					return compareCells(keys[b], keys[a])
+				}
+				return compareCells(keys[a], keys[b])
+			})
+		}
+		t.rows = rows
+	}
+
+	t.row = 0
+	if selected >= 0 {
+		if t.rows == nil {
+			t.row = min(selected, max(0, t.length()-1))
+		} else if i := slices.Index(t.rows, selected); i >= 0 {
+			t.row = i
+		}
+	}
+	t.offsetY = min(t.offsetY, max(0, t.length()-1))
+	if _, _, _, h := t.Content(); h > 2 {
+		t.adjust()
+	} else {
+		t.Refresh()
+	}
+}
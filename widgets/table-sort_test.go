@@ -0,0 +1,216 @@
+package widgets
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/gdamore/tcell/v3"
+	. "github.com/tekugo/zeichenwerk/core"
+)
+
+// column returns the values of a column in table order.
+func column(tbl *Table, col int) []string {
+	var values []string
+	for row := range tbl.length() {
+		values = append(values, tbl.str(row, col))
+	}
+	return values
+}
+
+// ── Sorting ──────────────────────────────────────────────────────────────────
+
+func TestTable_Sort_Numeric(t *testing.T) {
+	p := makeProvider()
+	tbl := NewTable("t", "", p, false)
+	tbl.Sort(1, SortAscending)
+	if got := column(tbl, 1); !slices.Equal(got, []string{"25", "28", "30", "35", "40"}) {
+		t.Errorf("ascending = %v", got)
+	}
+	tbl.Sort(1, SortDescending)
+	if got := column(tbl, 0); !slices.Equal(got, []string{"Carol", "Dave", "Alice", "Eve", "Bob"}) {
+		t.Errorf("descending = %v", got)
+	}
+	if got := p.Str(0, 0); got != "Alice" {
+		t.Errorf("provider row 0 = %q; sorting must not modify the provider", got)
+	}
+	tbl.Sort(1, SortNone)
+	if col, order := tbl.Sorting(); col != -1 || order != SortNone {
+		t.Errorf("Sorting = %d, %d after SortNone; want -1, SortNone", col, order)
+	}
+	if got := column(tbl, 0); got[0] != "Alice" || got[4] != "Eve" {
+		t.Errorf("unsorted = %v; want provider order", got)
+	}
+}
+
+func TestTable_Sort_TextAndStable(t *testing.T) {
+	p := NewArrayTableProvider([]string{"Key", "N"}, [][]string{
+		{"beta", "1"}, {"10", "2"}, {"Alpha", "3"}, {"beta", "4"}, {"9", "5"},
+	})
+	tbl := NewTable("t", "", p, false)
+	tbl.Sort(0, SortAscending)
+	if got := column(tbl, 1); !slices.Equal(got, []string{"5", "2", "3", "1", "4"}) {
+		t.Errorf("ascending = %v; want numbers first, then case-insensitive text, ties in provider order", got)
+	}
+	tbl.Sort(0, SortDescending)
+	if got := column(tbl, 1); !slices.Equal(got, []string{"1", "4", "3", "2", "5"}) {
+		t.Errorf("descending = %v; want ties in provider order", got)
+	}
+}
+
+func TestTable_Sort_KeepsSelection(t *testing.T) {
+	tbl := NewTable("t", "", makeProvider(), false)
+	tbl.SetBounds(0, 0, 40, 10)
+	tbl.SetSelected(2, 0) // Carol
+	tbl.Sort(0, SortDescending)
+	row, _ := tbl.Selected()
+	if got := tbl.str(row, 0); got != "Carol" {
+		t.Errorf("selected row shows %q after sorting; want Carol", got)
+	}
+	if got := tbl.SourceRow(row); got != 2 {
+		t.Errorf("SourceRow = %d; want 2", got)
+	}
+}
+
+func TestTable_HeaderClick_CyclesSort(t *testing.T) {
+	p := makeProvider()
+	p.SetSortable(true, 1)
+	tbl := NewTable("t", "", p, false)
+	tbl.SetBounds(0, 0, 40, 10)
+
+	click := func(x int) bool {
+		handled := tbl.handleMouse(tcell.NewEventMouse(x, 0, tcell.Button1, tcell.ModNone))
+		tbl.handleMouse(tcell.NewEventMouse(x, 0, tcell.ButtonNone, tcell.ModNone))
+		return handled
+	}
+	if click(0) {
+		t.Error("click on a column that is not sortable should not be handled")
+	}
+	for _, want := range []SortOrder{SortAscending, SortDescending, SortNone, SortAscending} {
+		if !click(7) {
+			t.Fatal("click on the Age header should be handled")
+		}
+		if _, order := tbl.Sorting(); order != want {
+			t.Errorf("order = %d; want %d", order, want)
+		}
+	}
+
+	// Motion events while the button is held do not cycle again.
+	tbl.handleMouse(tcell.NewEventMouse(7, 0, tcell.Button1, tcell.ModNone))
+	tbl.handleMouse(tcell.NewEventMouse(8, 0, tcell.Button1, tcell.ModNone))
+	if _, order := tbl.Sorting(); order != SortDescending {
+		t.Errorf("order = %d after a held click; want SortDescending", order)
+	}
+}
+
+func TestTable_KeyS_SortsSelectedColumn(t *testing.T) {
+	p := makeProvider()
+	p.SetSortable(true)
+	tbl := NewTable("t", "", p, true)
+	tbl.SetBounds(0, 0, 40, 10)
+	tbl.handleKey(tcell.NewEventKey(tcell.KeyRight, "", tcell.ModNone))
+	tbl.handleKey(tcell.NewEventKey(tcell.KeyRune, "s", tcell.ModNone))
+	if col, order := tbl.Sorting(); col != 1 || order != SortAscending {
+		t.Errorf("Sorting = %d, %d; want 1, SortAscending", col, order)
+	}
+}
+
+func TestTable_Render_SortIndicator(t *testing.T) {
+	tbl := NewTable("t", "", makeProvider(), false)
+	cs := NewTestScreen()
+	r := NewRenderer(cs, NewTheme())
+	tbl.SetBounds(0, 0, 20, 8)
+	tbl.Sort(2, SortDescending)
+	tbl.Render(r)
+	var header strings.Builder
+	for x := 10; x < 18; x++ {
+		header.WriteString(cs.Get(x, 0))
+	}
+	if got := header.String(); got != "City   v" {
+		t.Errorf("header = %q; want %q", got, "City   v")
+	}
+	if got := cs.Get(0, 2); got != "C" {
+		t.Errorf("first row starts with %q; want C of Carol", got)
+	}
+}
+
+// sortingProvider records native sort requests.
+type sortingProvider struct {
+	*ArrayTableProvider
+	calls []string
+}
+
+func (p *sortingProvider) Sort(column int, order SortOrder) {
+	p.calls = append(p.calls, string(rune('0'+column))+string(rune('0'+order)))
+}
+
+func TestTable_Sort_NativeProvider(t *testing.T) {
+	p := &sortingProvider{ArrayTableProvider: makeProvider()}
+	tbl := NewTable("t", "", p, false)
+	tbl.Sort(1, SortDescending)
+	tbl.Sort(1, SortNone)
+	if !slices.Equal(p.calls, []string{"12", "10"}) {
+		t.Errorf("native sort calls = %v; want [12 10]", p.calls)
+	}
+	if tbl.rows != nil {
+		t.Errorf("table built an index %v for a native provider", tbl.rows)
+	}
+}
+
+// ── Filtering ────────────────────────────────────────────────────────────────
+
+func TestTable_Filter_MatchesFilterableColumns(t *testing.T) {
+	p := makeProvider()
+	p.SetFilterable(true, 2)
+	tbl := NewTable("t", "", p, false)
+	tbl.Filter("R")
+	if got := column(tbl, 2); !slices.Equal(got, []string{"New York", "Berlin", "Paris", "Madrid"}) {
+		t.Errorf("filtered = %v", got)
+	}
+	tbl.Filter("alice")
+	if tbl.length() != 0 {
+		t.Errorf("Name is not filterable; got %d rows", tbl.length())
+	}
+	tbl.Filter("")
+	if tbl.length() != 5 {
+		t.Errorf("empty filter shows %d rows; want 5", tbl.length())
+	}
+	if _, ok := any(tbl).(Suggester); !ok {
+		t.Error("Table should implement Suggester")
+	}
+	if got := tbl.Suggest("m"); !slices.Equal(got, []string{"Madrid"}) {
+		t.Errorf("Suggest = %v; want [Madrid]", got)
+	}
+}
+
+func TestTable_ColumnFilter_WithSort(t *testing.T) {
+	tbl := NewTable("t", "", makeProvider(), false)
+	tbl.SetColumnFilter(2, "r")
+	tbl.SetColumnFilter(0, "e")
+	tbl.Sort(1, SortDescending)
+	if got := column(tbl, 0); !slices.Equal(got, []string{"Dave", "Alice", "Eve"}) {
+		t.Errorf("rows = %v", got)
+	}
+	if got := tbl.ColumnFilter(0); got != "e" {
+		t.Errorf("ColumnFilter = %q; want e", got)
+	}
+	tbl.SetColumnFilter(0, "")
+	if tbl.length() != 4 {
+		t.Errorf("rows after removing a filter = %d; want 4", tbl.length())
+	}
+}
+
+func TestTable_FilterColumn_BindsFilterWidget(t *testing.T) {
+	tbl := NewTable("t", "", makeProvider(), false)
+	f := NewFilter("f", "")
+	f.Bind(tbl.FilterColumn(2))
+	f.Set("par")
+	f.Dispatch(f, EvtChange, "par")
+	if got := column(tbl, 0); !slices.Equal(got, []string{"Dave"}) {
+		t.Errorf("rows = %v; want [Dave]", got)
+	}
+	f.Unbind()
+	if tbl.length() != 5 {
+		t.Errorf("rows after Unbind = %d; want 5", tbl.length())
+	}
+}
@@ -19,16 +19,25 @@ type Table struct {
 	inner, outer     bool
 	cellNav          bool // false = row navigation mode, true = cell navigation mode
 	cellStyler       func(row, col int, highlight bool) *Style
+	rows             []int          // Visible provider rows in display order (nil = all rows)
+	sortColumn       int            // Sorted column, -1 when unsorted
+	sortOrder        SortOrder      // Sort order of sortColumn
+	query            string         // Lower-cased query matched against filterable columns
+	filters          map[int]string // Lower-cased per-column filter queries
+	pressed          bool           // Mouse button held since a header click
+	editing          bool           // Cell editor is open
+	validator        func(row, col int, value string) error
 }
 
 // NewTable creates a new table widget with the given ID, class, and data provider.
 func NewTable(id, class string, provider TableProvider, cellNav bool) *Table {
 	table := &Table{
-		Component: Component{id: id, class: class},
-		grid:      &Border{InnerH: "-", InnerV: "|"},
-		inner:     true,
-		outer:     true,
-		cellNav:   cellNav,
+		Component:  Component{id: id, class: class},
+		grid:       &Border{InnerH: "-", InnerV: "|"},
+		inner:      true,
+		outer:      true,
+		cellNav:    cellNav,
+		sortColumn: -1,
 	}
 	table.SetFlag(FlagFocusable, true)
 	table.Set(provider)
@@ -39,18 +48,29 @@ func NewTable(id, class string, provider TableProvider, cellNav bool) *Table {
 
 // handleMouse handles mouse wheel events on the table. Vertical wheel impulses
 // move the selected row by MouseWheelStep, horizontal impulses scroll the
-// viewport by one column. Click handling is not yet implemented.
+// viewport by one column. Clicking the header of a sortable column cycles its
+// sort order.
 func (t *Table) handleMouse(ev *tcell.EventMouse) bool {
 	if t.provider == nil {
 		return false
 	}
+	if ev.Buttons() != tcell.Button1 {
+		t.pressed = false
+	}
 	switch ev.Buttons() {
+	case tcell.Button1:
+		// Only the press cycles the sort, not the motion events while held.
+		if t.pressed {
+			return false
+		}
+		t.pressed = true
+		return t.cycleSort(t.headerColumn(ev.Position()))
 	case tcell.WheelUp:
 		t.row = max(0, t.row-MouseWheelStep)
 		t.adjust()
 		return true
 	case tcell.WheelDown:
-		t.row = min(t.provider.Length()-1, t.row+MouseWheelStep)
+		t.row = min(t.length()-1, t.row+MouseWheelStep)
 		t.adjust()
 		return true
 	case tcell.WheelLeft:
@@ -63,6 +83,26 @@ func (t *Table) handleMouse(ev *tcell.EventMouse) bool {
 	return false
 }
 
+// headerColumn returns the column whose header is at the screen position,
+// or -1.
+func (t *Table) headerColumn(mx, my int) int {
+	x, y, w, _ := t.Content()
+	if my != y || mx < x || mx >= x+w {
+		return -1
+	}
+	rx := mx - x + t.offsetX
+	for i, column := range t.provider.Columns() {
+		if rx < column.Width {
+			return i
+		}
+		rx -= column.Width + 1
+		if rx < 0 {
+			return -1
+		}
+	}
+	return -1
+}
+
 // Apply applies theme styles to the table and its sub-selectors.
 func (t *Table) Apply(theme *Theme) {
 	theme.Apply(t, t.Selector("table"), "disabled", "focused")
@@ -70,6 +110,7 @@ func (t *Table) Apply(theme *Theme) {
 	theme.Apply(t, t.Selector("table/header"), "disabled", "focused")
 	theme.Apply(t, t.Selector("table/highlight"), "disabled", "focused")
 	theme.Apply(t, t.Selector("table/cell"), "disabled", "focused")
+	theme.Apply(t, t.Selector("table/error"))
 }
 
 // Refresh triggers a redraw of the table.
@@ -78,6 +119,7 @@ func (t *Table) Refresh() {
 }
 
 // Set updates the data provider and recalculates the total table width.
+// The sort order and filters are applied to the new provider.
 func (t *Table) Set(value TableProvider) {
 	t.provider = value
 	t.tableWidth = 0
@@ -87,7 +129,10 @@ func (t *Table) Set(value TableProvider) {
 	}
 	t.tableWidth += len(columns) - 1
 	t.Log(t, Debug, "New table provider")
-	t.Refresh()
+	if sp, ok := value.(SortableProvider); ok && t.sortColumn >= 0 && t.sortColumn < len(columns) {
+		sp.Sort(t.sortColumn, t.sortOrder)
+	}
+	t.reindex()
 }
 
 // Hint returns the preferred size of the table.
@@ -97,7 +142,7 @@ func (t *Table) Hint() (int, int) {
 	}
 
 	w := 0
-	h := t.provider.Length()
+	h := t.length()
 	for i, column := range t.provider.Columns() {
 		if i > 0 {
 			w++
@@ -110,7 +155,7 @@ func (t *Table) Hint() (int, int) {
 // Selected returns the current (row, col) selection.
 // In row mode col is always -1; in cell mode col is the active column index.
 func (t *Table) Selected() (int, int) {
-	if t.provider == nil || t.row < 0 || t.row >= t.provider.Length() {
+	if t.provider == nil || t.row < 0 || t.row >= t.length() {
 		return -1, -1
 	}
 	if t.cellNav {
@@ -122,7 +167,7 @@ func (t *Table) Selected() (int, int) {
 // SetSelected programmatically sets the selected row (and column in cell mode).
 // In row mode the col argument is ignored. Returns false if the row is out of range.
 func (t *Table) SetSelected(row, col int) bool {
-	if t.provider == nil || row < 0 || row >= t.provider.Length() {
+	if t.provider == nil || row < 0 || row >= t.length() {
 		return false
 	}
 	t.row = row
@@ -145,7 +190,7 @@ func (t *Table) Offset() (int, int) {
 // SetOffset sets the scroll offsets, clamping to valid ranges.
 func (t *Table) SetOffset(offsetX, offsetY int) {
 	t.offsetX = max(0, min(offsetX, max(0, t.tableWidth-t.width)))
-	t.offsetY = max(0, min(offsetY, max(0, t.provider.Length()-1)))
+	t.offsetY = max(0, min(offsetY, max(0, t.length()-1)))
 	t.Refresh()
 }
 
@@ -158,10 +203,10 @@ func (t *Table) handleKey(event *tcell.EventKey) bool {
 	switch event.Key() {
 	case tcell.KeyDown:
 		if event.Modifiers()&tcell.ModCtrl != 0 {
-			t.row = max(0, t.provider.Length()-1)
+			t.row = max(0, t.length()-1)
 			t.adjust()
 		} else {
-			if t.row < t.provider.Length()-1 {
+			if t.row < t.length()-1 {
 				t.row++
 				t.adjust()
 			}
@@ -247,7 +292,7 @@ func (t *Table) handleKey(event *tcell.EventKey) bool {
 	case tcell.KeyEnd:
 		if t.cellNav {
 			if event.Modifiers()&tcell.ModCtrl != 0 {
-				t.row = max(0, t.provider.Length()-1)
+				t.row = max(0, t.length()-1)
 				t.column = lastCol
 				t.offsetX = 0
 				t.adjust()
@@ -257,11 +302,11 @@ func (t *Table) handleKey(event *tcell.EventKey) bool {
 			}
 		} else {
 			if event.Modifiers()&tcell.ModCtrl != 0 {
-				t.row = max(0, t.provider.Length()-1)
+				t.row = max(0, t.length()-1)
 				t.offsetX = 0
 				t.adjust()
 			} else {
-				t.row = max(0, t.provider.Length()-1)
+				t.row = max(0, t.length()-1)
 				t.adjust()
 			}
 		}
@@ -271,18 +316,26 @@ func (t *Table) handleKey(event *tcell.EventKey) bool {
 		t.adjust()
 		return true
 	case tcell.KeyPgDn:
-		t.row = min(t.provider.Length()-1, t.row+pageSize)
+		t.row = min(t.length()-1, t.row+pageSize)
 		t.adjust()
 		return true
+	case tcell.KeyF2:
+		return t.cellNav && t.Edit(t.row, t.column)
 	case tcell.KeyEnter:
-		if t.provider.Length() > 0 && t.row >= 0 && t.row < t.provider.Length() {
+		if t.cellNav && t.editable(t.column) {
+			return t.Edit(t.row, t.column)
+		}
+		if t.length() > 0 && t.row >= 0 && t.row < t.length() {
 			rowData := t.getCurrentRowData()
 			t.Dispatch(t, EvtActivate, t.row, rowData)
 		}
 		return true
 	case tcell.KeyRune:
+		if event.Str() == "s" && t.cellNav {
+			return t.cycleSort(t.column)
+		}
 		if event.Str() == " " {
-			if t.provider.Length() > 0 && t.row >= 0 && t.row < t.provider.Length() {
+			if t.length() > 0 && t.row >= 0 && t.row < t.length() {
 				col := -1
 				if t.cellNav {
 					col = t.column
@@ -301,7 +354,7 @@ func (t *Table) handleKey(event *tcell.EventKey) bool {
 func (t *Table) adjust() {
 	_, _, _, h := t.Content()
 
-	if t.provider.Length() < h-2 {
+	if t.length() < h-2 {
 		t.Refresh()
 		return
 	}
@@ -374,14 +427,14 @@ func (t *Table) scrollByColumn(direction int) {
 
 // getCurrentRowData returns all column values for the currently selected row.
 func (t *Table) getCurrentRowData() []string {
-	if t.provider == nil || t.row < 0 || t.row >= t.provider.Length() {
+	if t.provider == nil || t.row < 0 || t.row >= t.length() {
 		return nil
 	}
 
 	columns := t.provider.Columns()
 	rowData := make([]string, len(columns))
 	for i := range columns {
-		rowData[i] = t.provider.Str(t.row, i)
+		rowData[i] = t.str(t.row, i)
 	}
 	return rowData
 }
@@ -397,7 +450,7 @@ func (t *Table) SetCellStyler(fn func(row, col int, highlight bool) *Style) {
 // ok is false when the cell is outside the visible viewport.
 func (t *Table) CellBounds(row, col int) (x, y, w int, ok bool) {
 	columns := t.provider.Columns()
-	if row < 0 || row >= t.provider.Length() || col < 0 || col >= len(columns) {
+	if row < 0 || row >= t.length() || col < 0 || col >= len(columns) {
 		return 0, 0, 0, false
 	}
 	cx, cy, cw, ch := t.Content()
@@ -462,6 +515,8 @@ func (t *Table) renderTableHeader(r *Renderer, x, y, w, h int, headerStyle, grid
 	rx := 0
 	rw := w
 	columns := t.provider.Columns()
+	ascending := r.Theme.String("table.sort.ascending")
+	descending := r.Theme.String("table.sort.descending")
 	for i, column := range columns {
 		if rw <= 0 {
 			break
@@ -470,13 +525,14 @@ func (t *Table) renderTableHeader(r *Renderer, x, y, w, h int, headerStyle, grid
 			rx = rx + column.Width + 1
 			continue
 		}
+		header := t.sortIndicator(i, column, ascending, descending)
 		r.Set(headerStyle.Foreground(), headerStyle.Background(), headerStyle.Font())
 
 		cx := x - t.offsetX + rx
 		if rx < t.offsetX {
 			start := t.offsetX - rx
 			rcw := min(column.Width-start, rw)
-			runes := []rune(column.Header)
+			runes := []rune(header)
 			if start < len(runes) {
 				r.Text(cx+start, y, string(runes[start:]), rcw)
 			}
@@ -485,7 +541,7 @@ func (t *Table) renderTableHeader(r *Renderer, x, y, w, h int, headerStyle, grid
 			rw += start
 		} else {
 			cw := min(rw, column.Width)
-			r.Text(cx, y, column.Header, cw)
+			r.Text(cx, y, header, cw)
 			r.Set(gridStyle.Foreground(), gridStyle.Background(), gridStyle.Font())
 			r.Repeat(cx, y+1, 1, 0, cw, t.grid.InnerH)
 		}
@@ -514,7 +570,7 @@ func (t *Table) renderTableContent(r *Renderer, x, y, w, h int, gridStyle *Style
 	columns := t.provider.Columns()
 	focused := t.Flag(FlagFocused)
 
-	for row < t.provider.Length() && row-t.offsetY < h {
+	for row < t.length() && row-t.offsetY < h {
 		rx := 0
 		rw := w
 		for i, column := range columns {
@@ -556,7 +612,7 @@ func (t *Table) renderTableContent(r *Renderer, x, y, w, h int, gridStyle *Style
 			if rx < t.offsetX {
 				start := t.offsetX - rx
 				rcw := min(column.Width-start, rw)
-				runes := []rune(cellText(t.provider.Str(row, i), column.Width, column.Alignment))
+				runes := []rune(cellText(t.str(row, i), column.Width, column.Alignment))
 				if start < len(runes) {
 					r.Text(cx+start, cy, string(runes[start:]), rcw)
 				} else {
@@ -565,7 +621,7 @@ func (t *Table) renderTableContent(r *Renderer, x, y, w, h int, gridStyle *Style
 				rw += start
 			} else {
 				cw := min(rw, column.Width)
-				r.Text(cx, cy, cellText(t.provider.Str(row, i), column.Width, column.Alignment), cw)
+				r.Text(cx, cy, cellText(t.str(row, i), column.Width, column.Alignment), cw)
 			}
 			if i < len(columns)-1 && t.inner && rw > column.Width {
 				// Always render separator in grid style, never in cell/highlight colour
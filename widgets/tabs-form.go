@@ -1,7 +1,6 @@
 package widgets
 
 import (
-	"fmt"
 	"io"
 	"strings"
 
@@ -57,17 +56,26 @@ func (f *TabsForm) Validate(field string) error { return nil }
 func (f *TabsForm) Emit(w io.Writer, mode string) error {
 	if err := f.EmitFrame(w, mode, func() error {
 		names := parseItems(f.TabsRaw)
-		args := fmt.Sprintf("%q", f.ID)
-		for _, n := range names {
-			args += fmt.Sprintf(", %q", n)
+		if mode != "compose" {
+			return f.EmitConstructor(w, mode, "Tabs", stringArgs(mode, names)...)
 		}
-		_, err := fmt.Fprintf(w, "Tabs(%s).\n", args)
-		return err
+		// Compose has no parameter for the tab labels.
+		if err := f.EmitConstructor(w, mode, "Tabs"); err != nil {
+			return err
+		}
+		for _, name := range names {
+			if err := f.EmitTODO(w, mode, "Add(%q)", name); err != nil {
+				return err
+			}
+		}
+		return nil
 	}); err != nil {
 		return err
 	}
 	if f.Selected > 0 {
-		fmt.Fprintf(w, "// TODO: Set(%d) — no Builder setter\n", f.Selected)
+		if err := f.EmitTODO(w, mode, "Set(%d)", f.Selected); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -1,7 +1,6 @@
 package widgets
 
 import (
-	"fmt"
 	"io"
 
 	"github.com/tekugo/zeichenwerk/core"
@@ -48,16 +47,19 @@ func (f *TerminalForm) Validate(field string) error { return nil }
 
 func (f *TerminalForm) Emit(w io.Writer, mode string) error {
 	if err := f.EmitFrame(w, mode, func() error {
-		_, err := fmt.Fprintf(w, "Terminal(%q).\n", f.ID)
-		return err
+		return f.EmitConstructor(w, mode, "Terminal")
 	}); err != nil {
 		return err
 	}
 	if !f.AutoWrap {
-		fmt.Fprintf(w, "// TODO: AutoWrap = false — no Builder setter\n")
+		if err := f.EmitTODO(w, mode, "AutoWrap = false"); err != nil {
+			return err
+		}
 	}
 	if !f.ShowCursor {
-		fmt.Fprintf(w, "// TODO: ShowCursor = false — no Builder setter\n")
+		if err := f.EmitTODO(w, mode, "ShowCursor = false"); err != nil {
+			return err
+		}
 	}
 	return nil
 }
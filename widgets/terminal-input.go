@@ -0,0 +1,329 @@
+package widgets
+
+import (
+	"fmt"
+
+	"github.com/gdamore/tcell/v3"
+	. "github.com/tekugo/zeichenwerk/core"
+)
+
+// ---- Event Handlers ---------------------------------------------------------
+
+// handleKey scrolls the scrollback with Shift-PgUp and Shift-PgDn and
+// forwards all other key events to the running process. While a process is
+// running, the terminal consumes all keys, including those bound to global
+// actions like Tab or Esc, as the process needs them. Forwarding a key
+// returns the view to the live screen.
+func (t *Terminal) handleKey(ev *tcell.EventKey) bool {
+	t.mu.Lock()
+	running, appCursor := t.pty != nil, t.appCursor
+	page := max(t.active.Height()-1, 1)
+	t.mu.Unlock()
+
+	if ev.Modifiers() == tcell.ModShift {
+		switch ev.Key() {
+		case tcell.KeyPgUp:
+			t.Scroll(page)
+			return true
+		case tcell.KeyPgDn:
+			t.Scroll(-page)
+			return true
+		}
+	}
+
+	if !running {
+		return false
+	}
+	if t.ScrollOffset() > 0 {
+		t.ScrollToBottom()
+	}
+	t.send(encodeKey(ev, appCursor))
+	return true
+}
+
+// handleMouse forwards mouse events inside the content area to the running
+// process, if it enabled mouse tracking and Shift is not held. Otherwise
+// the wheel scrolls the scrollback and dragging with the primary button
+// selects text, which is copied to the clipboard when the button is
+// released.
+func (t *Terminal) handleMouse(ev *tcell.EventMouse) bool {
+	x0, y0, w, h := t.Content()
+	mx, my := ev.Position()
+	x, y := mx-x0, my-y0
+	if x < 0 || y < 0 || x >= w || y >= h {
+		return false
+	}
+
+	t.mu.Lock()
+	if t.pty != nil && t.mouse != 0 && ev.Modifiers()&tcell.ModShift == 0 {
+		var data []byte
+		data, t.buttons = encodeMouse(ev, x, y, t.mouse, t.sgrMouse, t.buttons)
+		t.mu.Unlock()
+		t.send(data)
+		return true
+	}
+
+	buttons := ev.Buttons()
+	switch {
+	case buttons&tcell.WheelUp != 0:
+		t.mu.Unlock()
+		t.Scroll(3)
+	case buttons&tcell.WheelDown != 0:
+		t.mu.Unlock()
+		t.Scroll(-3)
+	case buttons&tcell.Button1 != 0:
+		t.selectAt(x, y, !t.selecting)
+		t.mu.Unlock()
+		Redraw(t)
+	case t.selecting:
+		t.selecting = false
+		t.mu.Unlock()
+		t.Copy()
+		Redraw(t)
+	default:
+		t.mu.Unlock()
+		return false
+	}
+	return true
+}
+
+// handlePaste wraps pasted text in bracketed paste markers if the running
+// process enabled bracketed paste mode. The pasted text itself arrives as
+// key events between the start and end of the paste.
+func (t *Terminal) handlePaste(_ Widget, _ Event, data ...any) bool {
+	if len(data) != 1 {
+		return false
+	}
+	ev, ok := data[0].(*tcell.EventPaste)
+	if !ok {
+		return false
+	}
+	t.mu.Lock()
+	running, bracketed := t.pty != nil, t.bracketed
+	t.mu.Unlock()
+	if !running {
+		return false
+	}
+	if bracketed {
+		if ev.Start() {
+			t.send([]byte("\x1b[200~"))
+		} else {
+			t.send([]byte("\x1b[201~"))
+		}
+	}
+	return true
+}
+
+// send writes input to the running process, if any.
+func (t *Terminal) send(data []byte) {
+	t.mu.Lock()
+	pty := t.pty
+	t.mu.Unlock()
+	if pty == nil || len(data) == 0 {
+		return
+	}
+	if _, err := pty.Write(data); err != nil {
+		t.Log(t, Debug, "Terminal input dropped", "error", err)
+	}
+}
+
+// ---- Encoding ---------------------------------------------------------------
+
+// termCursorKeys maps keys to the final byte of their CSI/SS3 sequence.
+var termCursorKeys = map[tcell.Key]byte{
+	tcell.KeyUp:    'A',
+	tcell.KeyDown:  'B',
+	tcell.KeyRight: 'C',
+	tcell.KeyLeft:  'D',
+	tcell.KeyHome:  'H',
+	tcell.KeyEnd:   'F',
+}
+
+// termFunctionKeys maps F1–F4 to the final byte of their SS3 sequence.
+var termFunctionKeys = map[tcell.Key]byte{
+	tcell.KeyF1: 'P',
+	tcell.KeyF2: 'Q',
+	tcell.KeyF3: 'R',
+	tcell.KeyF4: 'S',
+}
+
+// termTildeKeys maps keys to the parameter of their "CSI n ~" sequence.
+var termTildeKeys = map[tcell.Key]int{
+	tcell.KeyInsert: 2,
+	tcell.KeyDelete: 3,
+	tcell.KeyPgUp:   5,
+	tcell.KeyPgDn:   6,
+	tcell.KeyF5:     15,
+	tcell.KeyF6:     17,
+	tcell.KeyF7:     18,
+	tcell.KeyF8:     19,
+	tcell.KeyF9:     20,
+	tcell.KeyF10:    21,
+	tcell.KeyF11:    23,
+	tcell.KeyF12:    24,
+}
+
+// encodeKey returns the byte sequence an xterm sends for a key event. In
+// application cursor mode (DECCKM), unmodified cursor keys send SS3 instead
+// of CSI sequences. Alt prefixes plain keys with ESC; modified special keys
+// use the xterm modifier parameter ("CSI 1;5A" for Ctrl-Up). Keys without
+// an encoding yield nil.
+func encodeKey(ev *tcell.EventKey, appCursor bool) []byte {
+	mod := ev.Modifiers()
+	var seq string
+
+	switch key := ev.Key(); {
+	case key == tcell.KeyRune:
+		seq = ev.Str()
+		if mod&tcell.ModCtrl != 0 && len(seq) == 1 {
+			switch ch := seq[0]; {
+			case ch == ' ':
+				seq = "\x00"
+			case ch == '?':
+				seq = "\x7f"
+			case ch >= '@' && ch <= '_', ch >= 'a' && ch <= 'z':
+				seq = string(rune(ch & 0x1f))
+			}
+		}
+	case key == tcell.KeyBackspace:
+		seq = "\x7f"
+		if mod&tcell.ModCtrl != 0 {
+			seq = "\x08"
+		}
+	case key == tcell.KeyBacktab:
+		return []byte("\x1b[Z")
+	case key >= tcell.KeyCtrlA && key <= tcell.KeyCtrlZ:
+		seq = string(rune(key - tcell.KeyCtrlA + 1))
+	case key < 0x20 || key == 0x7f:
+		// Enter, Tab, Esc and other control keys are their ASCII codes.
+		seq = string(rune(key))
+	default:
+		return encodeSpecialKey(key, termModifier(mod), appCursor)
+	}
+
+	if mod&tcell.ModAlt != 0 {
+		seq = "\x1b" + seq
+	}
+	return []byte(seq)
+}
+
+// encodeSpecialKey encodes cursor, editing and function keys. m is the
+// xterm modifier parameter, 1 for no modifiers.
+func encodeSpecialKey(key tcell.Key, m int, appCursor bool) []byte {
+	if final, ok := termCursorKeys[key]; ok {
+		switch {
+		case m > 1:
+			return fmt.Appendf(nil, "\x1b[1;%d%c", m, final)
+		case appCursor:
+			return []byte{0x1b, 'O', final}
+		default:
+			return []byte{0x1b, '[', final}
+		}
+	}
+	if final, ok := termFunctionKeys[key]; ok {
+		if m > 1 {
+			return fmt.Appendf(nil, "\x1b[1;%d%c", m, final)
+		}
+		return []byte{0x1b, 'O', final}
+	}
+	if n, ok := termTildeKeys[key]; ok {
+		if m > 1 {
+			return fmt.Appendf(nil, "\x1b[%d;%d~", n, m)
+		}
+		return fmt.Appendf(nil, "\x1b[%d~", n)
+	}
+	return nil
+}
+
+// termModifier returns the xterm modifier parameter for a modifier mask:
+// 1 plus 1 for Shift, 2 for Alt, 4 for Ctrl and 8 for Meta.
+func termModifier(mod tcell.ModMask) int {
+	m := 1
+	if mod&tcell.ModShift != 0 {
+		m += 1
+	}
+	if mod&tcell.ModAlt != 0 {
+		m += 2
+	}
+	if mod&tcell.ModCtrl != 0 {
+		m += 4
+	}
+	if mod&tcell.ModMeta != 0 {
+		m += 8
+	}
+	return m
+}
+
+// encodeMouse encodes a mouse event at the 0-based cell position x, y for
+// the given tracking mode (9, 1000, 1002 or 1003), either in SGR (1006) or
+// in the legacy X10 byte encoding. prev holds the buttons pressed before
+// the event, so that presses, releases and drags can be told apart; the
+// buttons pressed after the event are returned with the encoded bytes,
+// which are nil if the mode does not report the event.
+func encodeMouse(ev *tcell.EventMouse, x, y, mode int, sgr bool, prev tcell.ButtonMask) ([]byte, tcell.ButtonMask) {
+	buttons := ev.Buttons()
+	pressed := buttons & (tcell.Button1 | tcell.Button2 | tcell.Button3)
+
+	code, release := -1, false
+	switch {
+	case buttons&tcell.WheelUp != 0:
+		code = 64
+	case buttons&tcell.WheelDown != 0:
+		code = 65
+	case buttons&tcell.WheelLeft != 0:
+		code = 66
+	case buttons&tcell.WheelRight != 0:
+		code = 67
+	case pressed&^prev != 0:
+		code = mouseButtonCode(pressed &^ prev)
+	case pressed == 0 && prev != 0:
+		if mode == 9 {
+			return nil, pressed
+		}
+		code, release = mouseButtonCode(prev), true
+	case pressed != 0 && mode >= 1002:
+		code = mouseButtonCode(pressed) + 32
+	case pressed == 0 && mode == 1003:
+		code = 3 + 32
+	default:
+		return nil, pressed
+	}
+
+	if mode != 9 {
+		mod := ev.Modifiers()
+		if mod&tcell.ModShift != 0 {
+			code |= 4
+		}
+		if mod&(tcell.ModAlt|tcell.ModMeta) != 0 {
+			code |= 8
+		}
+		if mod&tcell.ModCtrl != 0 {
+			code |= 16
+		}
+	}
+
+	if sgr {
+		final := 'M'
+		if release {
+			final = 'm'
+		}
+		return fmt.Appendf(nil, "\x1b[<%d;%d;%d%c", code, x+1, y+1, final), pressed
+	}
+	if release {
+		code = code&^3 | 3 // legacy releases do not tell the button
+	}
+	return []byte{0x1b, '[', 'M', byte(32 + code), byte(min(33+x, 255)), byte(min(33+y, 255))}, pressed
+}
+
+// mouseButtonCode returns the X10 code of the first pressed button: 0 for
+// the primary, 1 for the middle and 2 for the secondary button.
+func mouseButtonCode(buttons tcell.ButtonMask) int {
+	switch {
+	case buttons&tcell.Button1 != 0:
+		return 0
+	case buttons&tcell.Button3 != 0:
+		return 1
+	default:
+		return 2
+	}
+}
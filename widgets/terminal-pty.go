@@ -0,0 +1,110 @@
+package widgets
+
+import (
+	"fmt"
+	"io"
+	"os/exec"
+	"time"
+
+	. "github.com/tekugo/zeichenwerk/core"
+)
+
+// Start runs cmd on a new pseudo-terminal sized to the terminal buffer.
+// The process output is fed into the terminal, key, mouse and paste events
+// of the focused terminal are sent to the process, and resizing the widget
+// resizes the pseudo-terminal. Stdin, Stdout and Stderr of cmd are set to
+// the pseudo-terminal, which becomes the controlling terminal of a new
+// session. TERM is set to xterm-256color unless cmd.Env defines it.
+//
+// When the process exits, EvtExit is dispatched with the exit code and the
+// error returned by Wait. In a UI the event is dispatched on the event
+// loop through Post; a terminal without a root dispatches it from a
+// background goroutine. Start returns an error
+// wrapping ErrRunning if a process is still running, or ErrPty if no
+// pseudo-terminal is available; pseudo-terminals are supported on Linux.
+func (t *Terminal) Start(cmd *exec.Cmd) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.pty != nil {
+		return fmt.Errorf("%w: %s", ErrRunning, t.cmd.Path)
+	}
+
+	// An inherited TERM describes the outer terminal, not this one.
+	if cmd.Env == nil {
+		cmd.Env = append(cmd.Environ(), "TERM=xterm-256color")
+	} else if !hasEnv(cmd.Env, "TERM") {
+		cmd.Env = append(cmd.Env, "TERM=xterm-256color")
+	}
+
+	pty, err := startPty(cmd, t.main.Width(), t.main.Height())
+	if err != nil {
+		return err
+	}
+	t.pty, t.cmd = pty, cmd
+
+	// The reader stops when the last process holding the pseudo-terminal
+	// exits or when the master is closed after the process exited.
+	drained := make(chan struct{})
+	go func() {
+		defer close(drained)
+		_, _ = io.Copy(t, pty)
+	}()
+
+	go func() {
+		err := cmd.Wait()
+		select {
+		case <-drained:
+		case <-time.After(100 * time.Millisecond):
+			// Background jobs still hold the pseudo-terminal open.
+		}
+		_ = pty.Close()
+
+		t.mu.Lock()
+		t.pty, t.cmd = nil, nil
+		t.buttons = 0
+		t.mu.Unlock()
+
+		code := cmd.ProcessState.ExitCode()
+		exited := func() {
+			t.Log(t, Debug, "Process exited", "path", cmd.Path, "code", code)
+			t.Dispatch(t, EvtExit, code, err)
+			Redraw(t)
+		}
+		if root := FindRoot(t); root != nil {
+			root.Post(exited)
+		} else {
+			exited()
+		}
+	}()
+	return nil
+}
+
+// Running reports whether a process started with Start is still running.
+func (t *Terminal) Running() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.pty != nil
+}
+
+// resizePty propagates a new buffer size to the running process.
+func (t *Terminal) resizePty(w, h int) {
+	t.mu.Lock()
+	pty := t.pty
+	t.mu.Unlock()
+	if pty == nil {
+		return
+	}
+	if err := setPtySize(pty, w, h); err != nil {
+		t.Log(t, Debug, "Terminal resize failed", "error", err)
+	}
+}
+
+// hasEnv reports whether env contains a definition of the variable name.
+func hasEnv(env []string, name string) bool {
+	for _, entry := range env {
+		if len(entry) > len(name) && entry[len(name)] == '=' && entry[:len(name)] == name {
+			return true
+		}
+	}
+	return false
+}
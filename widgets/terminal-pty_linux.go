@@ -0,0 +1,98 @@
+package widgets
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"syscall"
+
+	. "github.com/tekugo/zeichenwerk/core"
+	"golang.org/x/sys/unix"
+)
+
+// startPty allocates a pseudo-terminal of the given size, starts cmd on its
+// slave side as the leader of a new session and returns the master.
+func startPty(cmd *exec.Cmd, w, h int) (*os.File, error) {
+	master, slave, err := openPty()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrPty, err)
+	}
+	defer slave.Close()
+
+	if err := setPtySize(master, w, h); err != nil {
+		master.Close()
+		return nil, fmt.Errorf("%w: %w", ErrPty, err)
+	}
+
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = slave, slave, slave
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	cmd.SysProcAttr.Setsid = true
+	cmd.SysProcAttr.Setctty = true
+	cmd.SysProcAttr.Ctty = 0 // stdin in the child
+
+	if err := cmd.Start(); err != nil {
+		master.Close()
+		return nil, err
+	}
+	return master, nil
+}
+
+// openPty opens a new pseudo-terminal master through /dev/ptmx and its
+// slave. The master is kept non-blocking, so that closing it stops reads.
+func openPty() (*os.File, *os.File, error) {
+	master, err := os.OpenFile("/dev/ptmx", os.O_RDWR|syscall.O_NOCTTY|syscall.O_CLOEXEC, 0)
+	if err != nil {
+		return nil, nil, err
+	}
+	conn, err := master.SyscallConn()
+	if err != nil {
+		master.Close()
+		return nil, nil, err
+	}
+
+	var n int
+	var ioctlErr error
+	err = conn.Control(func(fd uintptr) {
+		if ioctlErr = unix.IoctlSetPointerInt(int(fd), unix.TIOCSPTLCK, 0); ioctlErr != nil {
+			return
+		}
+		n, ioctlErr = unix.IoctlGetInt(int(fd), unix.TIOCGPTN)
+	})
+	if err == nil {
+		err = ioctlErr
+	}
+	if err != nil {
+		master.Close()
+		return nil, nil, err
+	}
+
+	slave, err := os.OpenFile("/dev/pts/"+strconv.Itoa(n), os.O_RDWR|syscall.O_NOCTTY|syscall.O_CLOEXEC, 0)
+	if err != nil {
+		master.Close()
+		return nil, nil, err
+	}
+	return master, slave, nil
+}
+
+// setPtySize sets the window size of a pseudo-terminal, which sends
+// SIGWINCH to its foreground process group.
+func setPtySize(pty *os.File, w, h int) error {
+	conn, err := pty.SyscallConn()
+	if err != nil {
+		return err
+	}
+	var ioctlErr error
+	err = conn.Control(func(fd uintptr) {
+		ioctlErr = unix.IoctlSetWinsize(int(fd), unix.TIOCSWINSZ, &unix.Winsize{
+			Row: uint16(h),
+			Col: uint16(w),
+		})
+	})
+	if err != nil {
+		return err
+	}
+	return ioctlErr
+}
@@ -0,0 +1,100 @@
+package widgets
+
+import (
+	"errors"
+	"os/exec"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gdamore/tcell/v3"
+	. "github.com/tekugo/zeichenwerk/core"
+)
+
+// startTerminal starts a shell script in a new terminal and returns the
+// terminal and a channel receiving the exit code.
+func startTerminal(t *testing.T, script string) (*Terminal, chan int) {
+	t.Helper()
+	term := NewTerminal("t", "")
+	term.SetBounds(0, 0, 40, 10)
+	exited := make(chan int, 1)
+	term.On(EvtExit, func(_ Widget, _ Event, data ...any) bool {
+		exited <- data[0].(int)
+		return true
+	})
+	if err := term.Start(exec.Command("/bin/sh", "-c", script)); err != nil {
+		if errors.Is(err, ErrPty) {
+			t.Skipf("no pseudo-terminal available: %v", err)
+		}
+		t.Fatalf("Start: %v", err)
+	}
+	return term, exited
+}
+
+// waitExit waits for the exit event and returns the exit code.
+func waitExit(t *testing.T, exited chan int) int {
+	t.Helper()
+	select {
+	case code := <-exited:
+		return code
+	case <-time.After(5 * time.Second):
+		t.Fatal("process did not exit")
+		return -1
+	}
+}
+
+// row returns the text of a row of the main buffer.
+func row(term *Terminal, y int) string {
+	term.mu.Lock()
+	defer term.mu.Unlock()
+	var b strings.Builder
+	for x := range term.main.Width() {
+		glyph, _, _, _, _ := term.main.Get(x, y)
+		if glyph == 0 {
+			glyph = ' '
+		}
+		b.WriteRune(glyph)
+	}
+	return strings.TrimRight(b.String(), " ")
+}
+
+func TestTerminal_Start_OutputAndExit(t *testing.T) {
+	term, exited := startTerminal(t, "stty size; echo $TERM; exit 3")
+	if code := waitExit(t, exited); code != 3 {
+		t.Errorf("exit code = %d; want 3", code)
+	}
+	if got := row(term, 0); got != "10 40" {
+		t.Errorf("row 0 = %q; want %q", got, "10 40")
+	}
+	if got := row(term, 1); got != "xterm-256color" {
+		t.Errorf("row 1 = %q; want %q", got, "xterm-256color")
+	}
+	if term.Running() {
+		t.Error("Running() = true after exit")
+	}
+}
+
+func TestTerminal_Start_ForwardsKeys(t *testing.T) {
+	term, exited := startTerminal(t, "read line; echo \"got $line\"")
+	if err := term.Start(exec.Command("/bin/true")); !errors.Is(err, ErrRunning) {
+		t.Errorf("second Start = %v; want ErrRunning", err)
+	}
+	for _, ch := range "hi" {
+		term.handleKey(tcell.NewEventKey(tcell.KeyRune, string(ch), tcell.ModNone))
+	}
+	term.handleKey(tcell.NewEventKey(tcell.KeyEnter, "", tcell.ModNone))
+	waitExit(t, exited)
+	if got := row(term, 1); got != "got hi" {
+		t.Errorf("row 1 = %q; want %q", got, "got hi")
+	}
+}
+
+func TestTerminal_Start_Resize(t *testing.T) {
+	term, exited := startTerminal(t, "read line; stty size")
+	term.SetBounds(0, 0, 30, 8)
+	term.handleKey(tcell.NewEventKey(tcell.KeyEnter, "", tcell.ModNone))
+	waitExit(t, exited)
+	if got := row(term, 1); got != "8 30" {
+		t.Errorf("row 1 = %q; want %q", got, "8 30")
+	}
+}
@@ -0,0 +1,22 @@
+//go:build !linux
+
+package widgets
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+
+	. "github.com/tekugo/zeichenwerk/core"
+)
+
+// startPty is not supported on this platform.
+func startPty(_ *exec.Cmd, _, _ int) (*os.File, error) {
+	return nil, fmt.Errorf("%w: not supported on %s", ErrPty, runtime.GOOS)
+}
+
+// setPtySize is not supported on this platform.
+func setPtySize(_ *os.File, _, _ int) error {
+	return nil
+}
@@ -0,0 +1,454 @@
+package widgets
+
+import (
+	"regexp"
+	"strings"
+)
+
+// termDefaultScrollback is the default number of scrollback lines.
+const termDefaultScrollback = 1000
+
+// termLine is a row that scrolled off the top of the main screen, stored
+// in the same packed form as the cells of a CellBuffer.
+type termLine struct {
+	char, fg, bg, ul []uint32
+}
+
+// get returns the cell at column x; cells beyond the stored width, which
+// occur after the terminal was widened, are empty.
+func (l termLine) get(x int) (ch rune, fg, bg, ul Color, attrs uint32) {
+	if x < 0 || x >= len(l.char) {
+		return 0, 0, 0, 0, 0
+	}
+	raw := l.char[x]
+	return rune(raw & charRuneMask), Color(l.fg[x]), Color(l.bg[x]), Color(l.ul[x]), raw &^ charRuneMask
+}
+
+// termScrollback is a bounded ring of scrollback lines. When full, pushing
+// a line drops the oldest one.
+type termScrollback struct {
+	lines []termLine // ring storage
+	start int        // index of the oldest line in lines
+	count int        // number of stored lines
+	limit int        // maximum number of lines, 0 disables the scrollback
+	total int        // number of lines ever pushed, to number lines stably
+}
+
+// push appends the row y of buf as the newest line.
+func (s *termScrollback) push(buf *CellBuffer, y int) {
+	s.total++
+	if s.limit <= 0 {
+		return
+	}
+	if s.count < s.limit {
+		s.lines = append(s.lines, buf.saveRow(y, termLine{}))
+		s.count++
+		return
+	}
+	s.lines[s.start] = buf.saveRow(y, s.lines[s.start])
+	s.start = (s.start + 1) % len(s.lines)
+}
+
+// line returns the i-th stored line, 0 being the oldest.
+func (s *termScrollback) line(i int) termLine {
+	return s.lines[(s.start+i)%len(s.lines)]
+}
+
+// resize changes the limit, keeping the newest lines.
+func (s *termScrollback) resize(limit int) {
+	keep := min(s.count, max(limit, 0))
+	lines := make([]termLine, 0, keep)
+	for i := s.count - keep; i < s.count; i++ {
+		lines = append(lines, s.line(i))
+	}
+	s.lines, s.start, s.count, s.limit = lines, 0, keep, limit
+}
+
+// clear drops all stored lines.
+func (s *termScrollback) clear() {
+	s.lines, s.start, s.count = nil, 0, 0
+}
+
+// termPos is a cell position in the scrollback and screen. Lines are
+// absolute, i.e. counted from the first line ever scrolled into the
+// scrollback, so that positions stay valid while output scrolls.
+type termPos struct {
+	line, col int
+}
+
+// before reports whether p comes before q in reading order.
+func (p termPos) before(q termPos) bool {
+	return p.line < q.line || (p.line == q.line && p.col < q.col)
+}
+
+// ---- Scrolling --------------------------------------------------------------
+
+// Scrollback returns the maximum number of scrollback lines.
+func (t *Terminal) Scrollback() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.history.limit
+}
+
+// SetScrollback sets the maximum number of lines kept after they scroll
+// off the top of the main screen (1000 by default). 0 disables the
+// scrollback. The alternate screen never has a scrollback.
+func (t *Terminal) SetScrollback(lines int) {
+	t.mu.Lock()
+	t.history.resize(lines)
+	t.offset = min(t.offset, t.history.count)
+	t.mu.Unlock()
+	Redraw(t)
+}
+
+// ScrollOffset returns how many lines the view is scrolled back into the
+// scrollback; 0 shows the live screen.
+func (t *Terminal) ScrollOffset() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.offset
+}
+
+// Scroll scrolls the view by delta lines into the scrollback (positive,
+// towards older output) or back towards the live screen (negative). While
+// scrolled back, the view stays on the same lines when new output arrives.
+func (t *Terminal) Scroll(delta int) {
+	t.mu.Lock()
+	if t.active == t.main {
+		t.offset = max(0, min(t.offset+delta, t.history.count))
+	}
+	t.mu.Unlock()
+	Redraw(t)
+}
+
+// ScrollToBottom shows the live screen again.
+func (t *Terminal) ScrollToBottom() {
+	t.mu.Lock()
+	t.offset = 0
+	t.mu.Unlock()
+	Redraw(t)
+}
+
+// pushHistory moves the top row of the main screen into the scrollback
+// before it is scrolled away. Must be called with mu held.
+func (t *Terminal) pushHistory() {
+	if t.active != t.main || t.scroll.top != 0 {
+		return
+	}
+	t.history.push(t.main, 0)
+	if t.offset > 0 {
+		t.offset = min(t.offset+1, t.history.count)
+	}
+}
+
+// ---- Rows -------------------------------------------------------------------
+
+// rows returns the number of rows of scrollback and screen together.
+func (t *Terminal) rows() int {
+	if t.active != t.main {
+		return t.active.Height()
+	}
+	return t.history.count + t.main.Height()
+}
+
+// top returns the row shown in the first line of the view.
+func (t *Terminal) top() int {
+	if t.active != t.main {
+		return 0
+	}
+	return t.history.count - t.offset
+}
+
+// cell returns a cell of a row, counted from the oldest scrollback line.
+func (t *Terminal) cell(row, x int) (ch rune, fg, bg, ul Color, attrs uint32) {
+	if t.active != t.main {
+		return t.active.Get(x, row)
+	}
+	if row < t.history.count {
+		return t.history.line(row).get(x)
+	}
+	return t.main.Get(x, row-t.history.count)
+}
+
+// rowText returns the text of a row and the column of each of its runes.
+// Empty cells are spaces and continuation cells of wide runes are skipped.
+func (t *Terminal) rowText(row int) ([]rune, []int) {
+	w := t.active.Width()
+	runes := make([]rune, 0, w)
+	cols := make([]int, 0, w)
+	for x := 0; x < w; x++ {
+		ch, _, _, _, attrs := t.cell(row, x)
+		if ch == 0 {
+			ch = ' '
+		}
+		runes = append(runes, ch)
+		cols = append(cols, x)
+		if attrs&charWide != 0 {
+			x++
+		}
+	}
+	return runes, cols
+}
+
+// absolute converts a row to an absolute line number and back.
+func (t *Terminal) absolute(row int) int {
+	if t.active != t.main {
+		return row
+	}
+	return row + t.history.total - t.history.count
+}
+
+func (t *Terminal) relative(line int) int {
+	if t.active != t.main {
+		return line
+	}
+	return line - t.history.total + t.history.count
+}
+
+// ---- Selection --------------------------------------------------------------
+
+// Selection returns the selected text. Lines are separated by newlines
+// and trailing blanks are removed.
+func (t *Terminal) Selection() string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.selectionText()
+}
+
+// ClearSelection removes the selection.
+func (t *Terminal) ClearSelection() {
+	t.mu.Lock()
+	t.selected, t.selecting = false, false
+	t.mu.Unlock()
+	Redraw(t)
+}
+
+// Copy copies the selection to the system clipboard with OSC 52, which
+// the hosting terminal may ignore. It is called when a mouse selection
+// ends.
+func (t *Terminal) Copy() {
+	text := t.Selection()
+	if text == "" {
+		return
+	}
+	if root, ok := FindRoot(t).(interface{ SetClipboard([]byte) }); ok {
+		root.SetClipboard([]byte(text))
+	}
+}
+
+// selectionBounds returns the ordered selection in rows. Must be called
+// with mu held.
+func (t *Terminal) selectionBounds() (termPos, termPos, bool) {
+	if !t.selected {
+		return termPos{}, termPos{}, false
+	}
+	from, to := t.sel[0], t.sel[1]
+	if to.before(from) {
+		from, to = to, from
+	}
+	from.line, to.line = t.relative(from.line), t.relative(to.line)
+	if to.line < 0 || from.line >= t.rows() || from == to {
+		return termPos{}, termPos{}, false
+	}
+	if from.line < 0 {
+		from = termPos{}
+	}
+	to.line = min(to.line, t.rows()-1)
+	return from, to, true
+}
+
+// isSelected reports whether the cell x of a row is selected.
+func (t *Terminal) isSelected(row, x int, from, to termPos) bool {
+	p := termPos{row, x}
+	return !p.before(from) && p.before(to)
+}
+
+// selectionText returns the selected text. Must be called with mu held.
+func (t *Terminal) selectionText() string {
+	from, to, ok := t.selectionBounds()
+	if !ok {
+		return ""
+	}
+	var lines []string
+	for row := from.line; row <= to.line; row++ {
+		runes, cols := t.rowText(row)
+		var b strings.Builder
+		for i, r := range runes {
+			if t.isSelected(row, cols[i], from, to) {
+				b.WriteRune(r)
+			}
+		}
+		lines = append(lines, strings.TrimRight(b.String(), " "))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// selectAt starts or extends a mouse selection at a cell of the view.
+// The selection covers the cells from the anchor to the cell under the
+// mouse, both included. Must be called with mu held.
+func (t *Terminal) selectAt(x, y int, start bool) {
+	pos := termPos{t.absolute(t.top() + y), x}
+	if start {
+		t.anchor = pos
+		t.selected, t.selecting = false, true
+		return
+	}
+	from, to := t.anchor, pos
+	if to.before(from) {
+		from, to = to, from
+	}
+	t.sel = [2]termPos{from, {to.line, to.col + 1}}
+	t.selected = true
+}
+
+// ---- Search -----------------------------------------------------------------
+
+// Find returns all matches of query in the scrollback and on the screen,
+// in order from the oldest line. Match lines count from the oldest
+// scrollback line and columns are cells; matches of wide runes cover two
+// cells per rune. An empty query or an invalid regular expression yields
+// no matches; use SetSearch to get the compile error.
+func (t *Terminal) Find(query string, opts FindOptions) []Match {
+	if query == "" {
+		return nil
+	}
+	re, err := terminalPattern(query, opts)
+	if err != nil {
+		return nil
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var result []Match
+	for row := range t.rows() {
+		result = append(result, t.searchRow(row, re)...)
+	}
+	return result
+}
+
+// Search returns the active search query and its options.
+func (t *Terminal) Search() (string, FindOptions) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.query, t.options
+}
+
+// SetSearch sets the active search. Visible matches are highlighted with
+// the "terminal/match" style and FindNext and FindPrevious move between
+// them. An empty query clears the search. If the query is an invalid
+// regular expression, the search is cleared and an error wrapping
+// ErrInvalidPattern is returned.
+func (t *Terminal) SetSearch(query string, opts FindOptions) error {
+	defer Redraw(t)
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.query, t.options, t.pattern = "", opts, nil
+	if query == "" {
+		return nil
+	}
+	re, err := terminalPattern(query, opts)
+	if err != nil {
+		return err
+	}
+	t.query, t.pattern = query, re
+	return nil
+}
+
+// FindNext selects the next match of the active search after the
+// selection, or after the top of the view, and scrolls it into view. It
+// wraps around at the bottom of the screen and reports false if there is
+// no match.
+func (t *Terminal) FindNext() bool {
+	return t.findFrom(true)
+}
+
+// FindPrevious selects the match of the active search before the
+// selection, or before the bottom of the view, and scrolls it into view.
+// It wraps around at the oldest scrollback line and reports false if
+// there is no match.
+func (t *Terminal) FindPrevious() bool {
+	return t.findFrom(false)
+}
+
+// findFrom implements FindNext and FindPrevious.
+func (t *Terminal) findFrom(forward bool) bool {
+	query, opts := t.Search()
+	matches := t.Find(query, opts)
+	if len(matches) == 0 {
+		return false
+	}
+
+	t.mu.Lock()
+	from := termPos{t.top(), -1}
+	if !forward {
+		from = termPos{t.top() + t.active.Height(), 0}
+	}
+	if start, end, ok := t.selectionBounds(); ok {
+		from = start
+		if forward {
+			from = termPos{end.line, end.col - 1}
+		}
+	}
+
+	target := matches[0]
+	if forward {
+		for _, m := range matches {
+			if from.before(termPos{m.Line, m.Column}) {
+				target = m
+				break
+			}
+		}
+	} else {
+		target = matches[len(matches)-1]
+		for i := len(matches) - 1; i >= 0; i-- {
+			if (termPos{matches[i].Line, matches[i].Column}).before(from) {
+				target = matches[i]
+				break
+			}
+		}
+	}
+
+	line := t.absolute(target.Line)
+	t.sel = [2]termPos{{line, target.Column}, {line, target.Column + target.Length}}
+	t.selected, t.selecting = true, false
+	if t.active == t.main {
+		top, h := t.top(), t.main.Height()
+		if target.Line < top || target.Line >= top+h {
+			t.offset = max(0, min(t.history.count-target.Line+h/2, t.history.count))
+		}
+	}
+	t.mu.Unlock()
+	Redraw(t)
+	return true
+}
+
+// searchRow returns the matches of re in a row. Must be called with mu
+// held.
+func (t *Terminal) searchRow(row int, re *regexp.Regexp) []Match {
+	runes, cols := t.rowText(row)
+	text := string(runes)
+	var result []Match
+	for _, loc := range re.FindAllStringIndex(text, -1) {
+		if loc[0] == loc[1] {
+			continue
+		}
+		first := len([]rune(text[:loc[0]]))
+		last := len([]rune(text[:loc[1]])) - 1
+		end := cols[last] + 1
+		if _, _, _, _, attrs := t.cell(row, cols[last]); attrs&charWide != 0 {
+			end++
+		}
+		result = append(result, Match{Line: row, Column: cols[first], Length: end - cols[first]})
+	}
+	return result
+}
+
+// terminalPattern compiles a search query like the Editor does, including
+// case-sensitive plain text, which the Editor matches without a regular
+// expression.
+func terminalPattern(query string, opts FindOptions) (*regexp.Regexp, error) {
+	re, err := compileSearch(query, opts)
+	if re == nil && err == nil {
+		re = regexp.MustCompile(regexp.QuoteMeta(query))
+	}
+	return re, err
+}
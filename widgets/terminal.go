@@ -1,8 +1,12 @@
 package widgets
 
 import (
+	"os"
+	"os/exec"
+	"regexp"
 	"sync"
 
+	"github.com/gdamore/tcell/v3"
 	"github.com/rivo/uniseg"
 	. "github.com/tekugo/zeichenwerk/core"
 )
@@ -23,7 +27,10 @@ type termCursor struct {
 // Terminal is a zeichenwerk widget that renders arbitrary terminal output.
 // It holds two CellBuffers (main and alternate screen), processes byte streams
 // via an embedded AnsiParser, and renders the active buffer via the Renderer.
-// It implements io.Writer so callers can pipe pty output directly into it.
+// It implements io.Writer so callers can pipe pty output directly into it,
+// or runs a process on a pseudo-terminal of its own with Start. Lines
+// scrolled off the main screen are kept in a bounded scrollback, which can
+// be scrolled, selected and searched.
 type Terminal struct {
 	Component
 	main    *CellBuffer
@@ -40,6 +47,28 @@ type Terminal struct {
 	autoWrap   bool
 	showCursor bool
 	mu         sync.Mutex
+
+	// ---- Process State ----
+	pty *os.File  // pseudo-terminal master while a process is running
+	cmd *exec.Cmd // process started with Start
+
+	// ---- Input Modes (set by the running application) ----
+	appCursor bool             // DECCKM: cursor keys send SS3 sequences
+	bracketed bool             // bracketed paste mode (2004)
+	mouse     int              // mouse tracking mode: 0, 9, 1000, 1002 or 1003
+	sgrMouse  bool             // SGR mouse encoding (1006)
+	buttons   tcell.ButtonMask // mouse buttons currently reported as pressed
+
+	// ---- Scrollback, Selection and Search ----
+	history   termScrollback // lines scrolled off the top of the main screen
+	offset    int            // lines the view is scrolled back, 0 = live
+	sel       [2]termPos     // selection start and (exclusive) end
+	selected  bool           // sel holds a selection
+	selecting bool           // mouse drag selection in progress
+	anchor    termPos        // cell where the mouse selection started
+	query     string         // active search query
+	options   FindOptions    // active search options
+	pattern   *regexp.Regexp // compiled active search
 }
 
 // termHandler implements AnsiHandler. Methods are always called from within
@@ -58,6 +87,7 @@ func NewTerminal(id, class string) *Terminal {
 		showCursor: true,
 	}
 	t.active = t.main
+	t.history.limit = termDefaultScrollback
 	t.cur = termCursor{
 		fg: ColorDefault,
 		bg: ColorDefault,
@@ -68,6 +98,9 @@ func NewTerminal(id, class string) *Terminal {
 	t.handler = &termHandler{t: t}
 	t.parser = NewAnsiParser(t.handler)
 	t.SetFlag(FlagFocusable, true)
+	OnKey(t, t.handleKey)
+	OnMouse(t, t.handleMouse)
+	t.On(EvtPaste, t.handlePaste)
 	return t
 }
 
@@ -81,9 +114,12 @@ func (t *Terminal) Write(data []byte) (int, error) {
 	return len(data), nil
 }
 
-// Apply applies "terminal" and "terminal:focused" styles from the theme.
+// Apply applies "terminal" and "terminal:focused" styles from the theme,
+// and the "terminal/selection" and "terminal/match" part styles.
 func (t *Terminal) Apply(theme *Theme) {
 	theme.Apply(t, t.Selector("terminal"))
+	theme.Apply(t, t.Selector("terminal/selection"))
+	theme.Apply(t, t.Selector("terminal/match"))
 }
 
 // Hint returns the buffer dimensions (80×24 by default).
@@ -95,11 +131,15 @@ func (t *Terminal) Hint() (int, int) {
 	return t.main.Width(), t.main.Height()
 }
 
-// Clear clears both buffers, resets cursor and scroll region.
+// Clear clears both buffers and the scrollback, resets cursor and scroll
+// region.
 func (t *Terminal) Clear() {
 	t.mu.Lock()
 	t.main.Clear()
 	t.alt.Clear()
+	t.history.clear()
+	t.offset = 0
+	t.selected, t.selecting = false, false
 	t.cur.x, t.cur.y = 0, 0
 	t.cur.wrap = false
 	t.scroll.top = 0
@@ -124,12 +164,14 @@ func (t *Terminal) Resize(w, h int) {
 	t.clampCursor()
 	t.clampScroll()
 	t.mu.Unlock()
+	t.resizePty(w, h)
 }
 
 // SetBounds overrides Component.SetBounds to immediately resize the cell
 // buffers to match the new content area. This ensures that Write() always
 // writes into a buffer that is sized to the visible widget area, regardless
-// of whether Render() has been called yet.
+// of whether Render() has been called yet. A running process is notified of
+// the new window size.
 func (t *Terminal) SetBounds(x, y, w, h int) {
 	t.Component.SetBounds(x, y, w, h)
 	style := t.Style()
@@ -157,11 +199,22 @@ func (t *Terminal) Render(r *Renderer) {
 		t.alt.Resize(cw, ch)
 		t.clampCursor()
 		t.clampScroll()
+		defer t.resizePty(cw, ch)
 	}
 
+	// The view shows the rows from top on, which are in the scrollback
+	// while scrolled back.
+	top := t.top()
+	from, to, selected := t.selectionBounds()
+	selStyle, matchStyle := t.Style("selection"), t.Style("match")
+
 	for y := 0; y < ch; y++ {
+		var matches []Match
+		if t.pattern != nil {
+			matches = t.searchRow(top+y, t.pattern)
+		}
 		for x := 0; x < cw; x++ {
-			glyph, fg, bg, ul, attrs := t.active.Get(x, y)
+			glyph, fg, bg, ul, attrs := t.cell(top+y, x)
 
 			// Apply reverse-video: swap fg and bg at render time.
 			if attrs&charReverse != 0 {
@@ -172,6 +225,12 @@ func (t *Terminal) Render(r *Renderer) {
 			bgStr := colorToHex(bg)
 			font := attrsToFont(attrs)
 
+			if selected && t.isSelected(top+y, x, from, to) {
+				fgStr, bgStr = selStyle.Foreground(), selStyle.Background()
+			} else if inMatch(matches, x) {
+				fgStr, bgStr = matchStyle.Foreground(), matchStyle.Background()
+			}
+
 			r.Set(fgStr, bgStr, font)
 
 			// Underline colour and style.
@@ -200,6 +259,16 @@ func (t *Terminal) Title() string {
 
 // ---- Internal helpers -------------------------------------------------------
 
+// inMatch reports whether column x is part of one of the matches.
+func inMatch(matches []Match, x int) bool {
+	for _, m := range matches {
+		if x >= m.Column && x < m.Column+m.Length {
+			return true
+		}
+	}
+	return false
+}
+
 func (t *Terminal) lineFeed() {
 	if t.cur.y < t.scroll.bot {
 		t.cur.y++
@@ -219,6 +288,7 @@ func (t *Terminal) reverseIndex() {
 
 func (t *Terminal) scrollUp(n int) {
 	for i := 0; i < n; i++ {
+		t.pushHistory()
 		for row := t.scroll.top + 1; row <= t.scroll.bot; row++ {
 			t.active.copyRow(row-1, row)
 		}
@@ -287,6 +357,10 @@ func (t *Terminal) hardReset() {
 	t.scroll.bot = t.main.Height() - 1
 	t.autoWrap = true
 	t.showCursor = true
+	t.appCursor = false
+	t.bracketed = false
+	t.mouse = 0
+	t.sgrMouse = false
 }
 
 // applySGR processes SGR (Select Graphic Rendition) parameters.
@@ -599,10 +673,13 @@ func (h *termHandler) CsiDispatch(params []int, inter, final byte) {
 				t.active.ClearLineColor(y, 0, w, t.cur.bg)
 			}
 			t.active.ClearLineColor(t.cur.y, 0, t.cur.x+1, t.cur.bg)
-		case 2, 3: // entire screen
+		case 2: // entire screen
 			for y := 0; y < h2; y++ {
 				t.active.ClearLineColor(y, 0, w, t.cur.bg)
 			}
+		case 3: // scrollback (xterm extension)
+			t.history.clear()
+			t.offset = 0
 		}
 
 	case 'K': // EL - erase line
@@ -676,6 +753,8 @@ func (h *termHandler) CsiDispatch(params []int, inter, final byte) {
 		for _, mode := range params {
 			if isPrivate {
 				switch mode {
+				case 1: // DECCKM - application cursor keys
+					t.appCursor = set
 				case 7: // Auto-wrap
 					t.autoWrap = set
 				case 25: // Cursor visibility
@@ -692,6 +771,17 @@ func (h *termHandler) CsiDispatch(params []int, inter, final byte) {
 						t.active = t.main
 					}
 					t.clampScroll()
+				case 9, 1000, 1002, 1003: // Mouse tracking
+					if set {
+						t.mouse = mode
+					} else if t.mouse == mode {
+						t.mouse = 0
+					}
+					t.buttons = 0
+				case 1006: // SGR mouse encoding
+					t.sgrMouse = set
+				case 2004: // Bracketed paste
+					t.bracketed = set
 				}
 			}
 		}
@@ -0,0 +1,291 @@
+package widgets
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/gdamore/tcell/v3"
+	. "github.com/tekugo/zeichenwerk/core"
+)
+
+// ---- Key encoding -----------------------------------------------------------
+
+func TestTerminal_EncodeKey(t *testing.T) {
+	tests := []struct {
+		name      string
+		ev        *tcell.EventKey
+		appCursor bool
+		want      string
+	}{
+		{"rune", tcell.NewEventKey(tcell.KeyRune, "ä", tcell.ModNone), false, "ä"},
+		{"alt rune", tcell.NewEventKey(tcell.KeyRune, "x", tcell.ModAlt), false, "\x1bx"},
+		{"ctrl-c", tcell.NewEventKey(tcell.KeyCtrlC, "", tcell.ModCtrl), false, "\x03"},
+		{"ctrl-]", tcell.NewEventKey(tcell.KeyRune, "]", tcell.ModCtrl), false, "\x1d"},
+		{"ctrl-shift-a", tcell.NewEventKey(tcell.KeyRune, "A", tcell.ModCtrl|tcell.ModShift), false, "\x01"},
+		{"enter", tcell.NewEventKey(tcell.KeyEnter, "", tcell.ModNone), false, "\r"},
+		{"tab", tcell.NewEventKey(tcell.KeyTab, "", tcell.ModNone), false, "\t"},
+		{"backtab", tcell.NewEventKey(tcell.KeyBacktab, "", tcell.ModNone), false, "\x1b[Z"},
+		{"esc", tcell.NewEventKey(tcell.KeyEsc, "", tcell.ModNone), false, "\x1b"},
+		{"backspace", tcell.NewEventKey(tcell.KeyBackspace, "", tcell.ModNone), false, "\x7f"},
+		{"up", tcell.NewEventKey(tcell.KeyUp, "", tcell.ModNone), false, "\x1b[A"},
+		{"up app", tcell.NewEventKey(tcell.KeyUp, "", tcell.ModNone), true, "\x1bOA"},
+		{"ctrl-up", tcell.NewEventKey(tcell.KeyUp, "", tcell.ModCtrl), true, "\x1b[1;5A"},
+		{"home", tcell.NewEventKey(tcell.KeyHome, "", tcell.ModNone), false, "\x1b[H"},
+		{"f1", tcell.NewEventKey(tcell.KeyF1, "", tcell.ModNone), false, "\x1bOP"},
+		{"shift-f1", tcell.NewEventKey(tcell.KeyF1, "", tcell.ModShift), false, "\x1b[1;2P"},
+		{"f5", tcell.NewEventKey(tcell.KeyF5, "", tcell.ModNone), false, "\x1b[15~"},
+		{"delete", tcell.NewEventKey(tcell.KeyDelete, "", tcell.ModNone), false, "\x1b[3~"},
+		{"shift-pgup", tcell.NewEventKey(tcell.KeyPgUp, "", tcell.ModShift), false, "\x1b[5;2~"},
+	}
+	for _, tt := range tests {
+		if got := string(encodeKey(tt.ev, tt.appCursor)); got != tt.want {
+			t.Errorf("%s: encodeKey = %q; want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+// ---- Mouse encoding ---------------------------------------------------------
+
+func TestTerminal_EncodeMouse_SGR(t *testing.T) {
+	press := tcell.NewEventMouse(0, 0, tcell.Button1, tcell.ModNone)
+	data, buttons := encodeMouse(press, 4, 2, 1000, true, 0)
+	if string(data) != "\x1b[<0;5;3M" {
+		t.Errorf("press = %q; want %q", data, "\x1b[<0;5;3M")
+	}
+
+	// Dragging is only reported in button-event mode.
+	if data, _ := encodeMouse(press, 5, 2, 1000, true, buttons); data != nil {
+		t.Errorf("drag in mode 1000 = %q; want nil", data)
+	}
+	if data, _ := encodeMouse(press, 5, 2, 1002, true, buttons); string(data) != "\x1b[<32;6;3M" {
+		t.Errorf("drag in mode 1002 = %q; want %q", data, "\x1b[<32;6;3M")
+	}
+
+	release := tcell.NewEventMouse(0, 0, tcell.ButtonNone, tcell.ModNone)
+	data, buttons = encodeMouse(release, 5, 2, 1000, true, buttons)
+	if string(data) != "\x1b[<0;6;3m" || buttons != 0 {
+		t.Errorf("release = %q, buttons %v; want %q, 0", data, buttons, "\x1b[<0;6;3m")
+	}
+
+	wheel := tcell.NewEventMouse(0, 0, tcell.WheelDown, tcell.ModCtrl)
+	if data, _ := encodeMouse(wheel, 0, 0, 1000, true, 0); string(data) != "\x1b[<81;1;1M" {
+		t.Errorf("ctrl-wheel = %q; want %q", data, "\x1b[<81;1;1M")
+	}
+}
+
+func TestTerminal_EncodeMouse_Legacy(t *testing.T) {
+	press := tcell.NewEventMouse(0, 0, tcell.Button2, tcell.ModNone)
+	data, buttons := encodeMouse(press, 0, 1, 1000, false, 0)
+	if string(data) != "\x1b[M\x22\x21\x22" {
+		t.Errorf("press = %q; want %q", data, "\x1b[M\x22\x21\x22")
+	}
+	release := tcell.NewEventMouse(0, 0, tcell.ButtonNone, tcell.ModNone)
+	if data, _ := encodeMouse(release, 0, 1, 1000, false, buttons); string(data) != "\x1b[M\x23\x21\x22" {
+		t.Errorf("release = %q; want %q", data, "\x1b[M\x23\x21\x22")
+	}
+	if data, _ := encodeMouse(release, 0, 1, 9, false, buttons); data != nil {
+		t.Errorf("release in X10 mode = %q; want nil", data)
+	}
+}
+
+// ---- Input modes ------------------------------------------------------------
+
+func TestTerminal_InputModes(t *testing.T) {
+	term := NewTerminal("t", "")
+	term.Write([]byte("\x1b[?1h\x1b[?1002h\x1b[?1006h\x1b[?2004h"))
+	if !term.appCursor || term.mouse != 1002 || !term.sgrMouse || !term.bracketed {
+		t.Fatalf("modes = %v %d %v %v; want all set",
+			term.appCursor, term.mouse, term.sgrMouse, term.bracketed)
+	}
+	term.Write([]byte("\x1b[?1000l"))
+	if term.mouse != 1002 {
+		t.Errorf("resetting another mouse mode changed mouse to %d", term.mouse)
+	}
+	term.Write([]byte("\x1bc"))
+	if term.appCursor || term.mouse != 0 || term.sgrMouse || term.bracketed {
+		t.Errorf("RIS did not reset input modes")
+	}
+}
+
+func TestTerminal_KeysIgnoredWithoutProcess(t *testing.T) {
+	term := NewTerminal("t", "")
+	if term.handleKey(tcell.NewEventKey(tcell.KeyRune, "a", tcell.ModNone)) {
+		t.Error("key consumed without a running process")
+	}
+}
+
+// ---- Scrollback -------------------------------------------------------------
+
+// newScrollbackTerminal returns a 10×3 terminal after writing lines 1 … n.
+func newScrollbackTerminal(n int) *Terminal {
+	term := NewTerminal("t", "")
+	term.SetBounds(0, 0, 10, 3)
+	for i := 1; i <= n; i++ {
+		if i > 1 {
+			term.Write([]byte("\r\n"))
+		}
+		term.Write([]byte("line " + string(rune('0'+i))))
+	}
+	return term
+}
+
+// viewRow returns the text shown in row y of the view.
+func viewRow(term *Terminal, y int) string {
+	term.mu.Lock()
+	defer term.mu.Unlock()
+	runes, _ := term.rowText(term.top() + y)
+	return strings.TrimRight(string(runes), " ")
+}
+
+func TestTerminal_Scrollback_KeepsScrolledLines(t *testing.T) {
+	term := newScrollbackTerminal(6)
+	if term.history.count != 3 {
+		t.Fatalf("scrollback lines = %d; want 3", term.history.count)
+	}
+	if got := viewRow(term, 0); got != "line 4" {
+		t.Errorf("live row 0 = %q; want %q", got, "line 4")
+	}
+	term.Scroll(2)
+	if got := viewRow(term, 0); got != "line 2" {
+		t.Errorf("row 0 scrolled back 2 = %q; want %q", got, "line 2")
+	}
+	term.Scroll(10)
+	if term.ScrollOffset() != 3 {
+		t.Errorf("ScrollOffset = %d; want it clamped to 3", term.ScrollOffset())
+	}
+
+	// New output does not move the view while scrolled back.
+	term.Write([]byte("\r\nline 7"))
+	if got := viewRow(term, 0); got != "line 1" {
+		t.Errorf("row 0 after output = %q; want %q", got, "line 1")
+	}
+	term.ScrollToBottom()
+	if got := viewRow(term, 2); got != "line 7" {
+		t.Errorf("live row 2 = %q; want %q", got, "line 7")
+	}
+}
+
+func TestTerminal_Scrollback_Limit(t *testing.T) {
+	term := NewTerminal("t", "")
+	term.SetScrollback(2)
+	term.SetBounds(0, 0, 10, 3)
+	for i := 1; i <= 6; i++ {
+		term.Write([]byte("line " + string(rune('0'+i)) + "\r\n"))
+	}
+	term.Scroll(5)
+	if got := viewRow(term, 0); got != "line 3" {
+		t.Errorf("oldest row = %q; want %q", got, "line 3")
+	}
+	term.Write([]byte("\x1b[3J"))
+	if term.history.count != 0 || term.ScrollOffset() != 0 {
+		t.Errorf("ED 3 left %d lines, offset %d", term.history.count, term.ScrollOffset())
+	}
+}
+
+func TestTerminal_Scrollback_AlternateScreen(t *testing.T) {
+	term := newScrollbackTerminal(6)
+	term.Write([]byte("\x1b[?1049h"))
+	for i := 0; i < 5; i++ {
+		term.Write([]byte("x\r\n"))
+	}
+	if term.history.count != 3 {
+		t.Errorf("alternate screen added to the scrollback: %d lines", term.history.count)
+	}
+	term.Scroll(1)
+	if term.ScrollOffset() != 0 {
+		t.Errorf("alternate screen scrolled back to %d", term.ScrollOffset())
+	}
+}
+
+func TestTerminal_KeyShiftPgUp_Scrolls(t *testing.T) {
+	term := newScrollbackTerminal(6)
+	term.handleKey(tcell.NewEventKey(tcell.KeyPgUp, "", tcell.ModShift))
+	if term.ScrollOffset() != 2 {
+		t.Errorf("ScrollOffset after Shift-PgUp = %d; want 2", term.ScrollOffset())
+	}
+	term.handleKey(tcell.NewEventKey(tcell.KeyPgDn, "", tcell.ModShift))
+	if term.ScrollOffset() != 0 {
+		t.Errorf("ScrollOffset after Shift-PgDn = %d; want 0", term.ScrollOffset())
+	}
+}
+
+func TestTerminal_MouseWheel_Scrolls(t *testing.T) {
+	term := newScrollbackTerminal(6)
+	term.handleMouse(tcell.NewEventMouse(1, 1, tcell.WheelUp, tcell.ModNone))
+	if term.ScrollOffset() != 3 {
+		t.Errorf("ScrollOffset after wheel up = %d; want 3", term.ScrollOffset())
+	}
+}
+
+// ---- Selection --------------------------------------------------------------
+
+func TestTerminal_MouseSelection(t *testing.T) {
+	term := newScrollbackTerminal(6)
+	term.Scroll(1)
+	term.handleMouse(tcell.NewEventMouse(5, 0, tcell.Button1, tcell.ModNone))
+	term.handleMouse(tcell.NewEventMouse(3, 1, tcell.Button1, tcell.ModNone))
+	term.handleMouse(tcell.NewEventMouse(3, 1, tcell.ButtonNone, tcell.ModNone))
+	if got := term.Selection(); got != "3\nline" {
+		t.Errorf("Selection = %q; want %q", got, "3\nline")
+	}
+
+	// The selection stays on its lines while output scrolls.
+	term.ScrollToBottom()
+	term.Write([]byte("\r\nline 7"))
+	if got := term.Selection(); got != "3\nline" {
+		t.Errorf("Selection after output = %q; want %q", got, "3\nline")
+	}
+
+	// A click without dragging clears the selection.
+	term.handleMouse(tcell.NewEventMouse(0, 0, tcell.Button1, tcell.ModNone))
+	term.handleMouse(tcell.NewEventMouse(0, 0, tcell.ButtonNone, tcell.ModNone))
+	if got := term.Selection(); got != "" {
+		t.Errorf("Selection after click = %q; want empty", got)
+	}
+}
+
+// ---- Search -----------------------------------------------------------------
+
+func TestTerminal_Find(t *testing.T) {
+	term := newScrollbackTerminal(6)
+	term.Write([]byte("\r\n漢字 line"))
+	matches := term.Find("LINE", FindOptions{})
+	if len(matches) != 7 {
+		t.Fatalf("Find = %d matches; want 7", len(matches))
+	}
+	if m := matches[0]; m.Line != 0 || m.Column != 0 || m.Length != 4 {
+		t.Errorf("first match = %+v; want line 0, column 0, length 4", m)
+	}
+	if m := matches[6]; m.Column != 5 {
+		t.Errorf("match after wide runes = %+v; want column 5", m)
+	}
+	if got := term.Find("漢字", FindOptions{CaseSensitive: true}); len(got) != 1 || got[0].Length != 4 {
+		t.Errorf("wide match = %+v; want one match of 4 cells", got)
+	}
+	if err := term.SetSearch("(", FindOptions{Regex: true}); !errors.Is(err, ErrInvalidPattern) {
+		t.Errorf("SetSearch invalid = %v; want ErrInvalidPattern", err)
+	}
+}
+
+func TestTerminal_FindPrevious_ScrollsBack(t *testing.T) {
+	term := newScrollbackTerminal(6)
+	term.SetSearch(`line [12]`, FindOptions{Regex: true})
+	if !term.FindPrevious() {
+		t.Fatal("FindPrevious = false; want a match")
+	}
+	if got := term.Selection(); got != "line 2" {
+		t.Errorf("Selection = %q; want %q", got, "line 2")
+	}
+	if got := term.ScrollOffset(); got < 2 {
+		t.Errorf("ScrollOffset = %d; want line 2 scrolled into view", got)
+	}
+	term.FindPrevious()
+	if got := term.Selection(); got != "line 1" {
+		t.Errorf("Selection = %q; want %q", got, "line 1")
+	}
+	term.FindNext()
+	if got := term.Selection(); got != "line 2" {
+		t.Errorf("Selection after FindNext = %q; want %q", got, "line 2")
+	}
+}
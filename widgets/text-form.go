@@ -64,8 +64,7 @@ func (f *TextForm) Emit(w io.Writer, mode string) error {
 			}
 			contentExpr = "[]string{" + strings.Join(quoted, ", ") + "}"
 		}
-		_, err := fmt.Fprintf(w, "Text(%q, %s, %t, %d).\n", f.ID, contentExpr, f.Follow, f.Max)
-		return err
+		return f.EmitConstructor(w, mode, "Text", fmt.Sprintf("%s, %t, %d", contentExpr, f.Follow, f.Max))
 	})
 }
 
@@ -73,8 +73,7 @@ func (f *TilesForm) Emit(w io.Writer, mode string) error {
 		if th < 1 {
 			th = 1
 		}
-		_, err := fmt.Fprintf(w, "Tiles(%q, tileRender /* TODO */, %d, %d).\n", f.ID, tw, th)
-		return err
+		return f.EmitConstructor(w, mode, "Tiles", fmt.Sprintf("tileRender /* TODO */, %d, %d", tw, th))
 	})
 }
 
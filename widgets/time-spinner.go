@@ -0,0 +1,205 @@
+package widgets
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/gdamore/tcell/v3"
+	. "github.com/tekugo/zeichenwerk/core"
+)
+
+// TimeSpinner is a compact input for a time of day, shown as "HH:MM". One
+// of the two fields is active; ←/→ switch between them, ↑/↓ or +/-
+// change the active field with wrap-around and digits type a new value.
+type TimeSpinner struct {
+	Component
+	hour   int  // 0-23
+	minute int  // 0-59
+	field  int  // active field: 0 = hour, 1 = minute
+	typed  bool // a first digit has been typed into the active field
+}
+
+// NewTimeSpinner creates a time spinner set to 00:00 with the hour field
+// active.
+func NewTimeSpinner(id, class string) *TimeSpinner {
+	t := &TimeSpinner{
+		Component: Component{id: id, class: class},
+	}
+	t.SetFlag(FlagFocusable, true)
+	OnKey(t, t.handleKey)
+	OnMouse(t, t.handleMouse)
+	return t
+}
+
+// ---- Widget Methods -------------------------------------------------------
+
+// Apply applies the time spinner styles.
+func (t *TimeSpinner) Apply(theme *Theme) {
+	theme.Apply(t, t.Selector("time-spinner"), "disabled", "focused")
+	theme.Apply(t, t.Selector("time-spinner/field"), "focused")
+}
+
+// Hint returns the width of "HH:MM" and a height of 1.
+func (t *TimeSpinner) Hint() (int, int) {
+	if t.hwidth != 0 || t.hheight != 0 {
+		return t.hwidth, t.hheight
+	}
+	return 5, 1
+}
+
+// Render draws the two fields; the active one is highlighted while the
+// spinner has the focus.
+func (t *TimeSpinner) Render(r *Renderer) {
+	if t.Flag(FlagHidden) {
+		return
+	}
+	t.Component.Render(r)
+
+	cx, cy, cw, ch := t.Content()
+	if cw < 5 || ch < 1 {
+		return
+	}
+	for i, value := range []int{t.hour, t.minute} {
+		style := t.Style("field")
+		if i == t.field && t.Flag(FlagFocused) {
+			style = t.Style("field:focused")
+		}
+		r.Set(style.Foreground(), style.Background(), style.Font())
+		r.Text(cx+i*3, cy, fmt.Sprintf("%02d", value), 2)
+	}
+	style := t.Style()
+	r.Set(style.Foreground(), style.Background(), style.Font())
+	r.Text(cx+2, cy, ":", 1)
+}
+
+// Summary returns the time for Dump output.
+func (t *TimeSpinner) Summary() string {
+	return fmt.Sprintf("time=%02d:%02d", t.hour, t.minute)
+}
+
+// ---- Getters and Setters --------------------------------------------------
+
+// Time returns the hour and the minute.
+func (t *TimeSpinner) Time() (int, int) {
+	return t.hour, t.minute
+}
+
+// SetTime sets the hour and the minute, clamped to 0-23 and 0-59. No
+// events are dispatched.
+func (t *TimeSpinner) SetTime(hour, minute int) {
+	t.hour = max(0, min(23, hour))
+	t.minute = max(0, min(59, minute))
+	t.typed = false
+	t.Refresh()
+}
+
+// ---- Internal Helpers -----------------------------------------------------
+
+// set changes the active field and dispatches EvtChange with the hour and
+// the minute.
+func (t *TimeSpinner) set(value int) {
+	if t.field == 0 {
+		t.hour = (value%24 + 24) % 24
+	} else {
+		t.minute = (value%60 + 60) % 60
+	}
+	t.Dispatch(t, EvtChange, t.hour, t.minute)
+	t.Refresh()
+}
+
+// step adds delta to the active field with wrap-around.
+func (t *TimeSpinner) step(delta int) {
+	t.typed = false
+	if t.field == 0 {
+		t.set(t.hour + delta)
+	} else {
+		t.set(t.minute + delta)
+	}
+}
+
+// selectField makes the field active and ends typing.
+func (t *TimeSpinner) selectField(field int) {
+	t.field = field
+	t.typed = false
+	t.Refresh()
+}
+
+// typeDigit enters a digit: the first digit replaces the field, the second
+// one is appended if the result is valid. A complete hour moves on to the
+// minute.
+func (t *TimeSpinner) typeDigit(digit int) {
+	limit := 23
+	value := t.hour
+	if t.field == 1 {
+		limit, value = 59, t.minute
+	}
+	if t.typed && value*10+digit <= limit {
+		t.set(value*10 + digit)
+		t.typed = false
+		if t.field == 0 {
+			t.field = 1
+		}
+		return
+	}
+	t.set(digit)
+	t.typed = digit*10 <= limit
+	if !t.typed && t.field == 0 {
+		t.field = 1
+	}
+}
+
+// handleKey switches fields, steps and types values. Enter is left to the
+// application, for example to confirm the time.
+func (t *TimeSpinner) handleKey(evt *tcell.EventKey) bool {
+	switch evt.Key() {
+	case tcell.KeyLeft:
+		if t.field == 0 {
+			return false
+		}
+		t.selectField(0)
+	case tcell.KeyRight:
+		if t.field == 1 {
+			return false
+		}
+		t.selectField(1)
+	case tcell.KeyUp:
+		t.step(1)
+	case tcell.KeyDown:
+		t.step(-1)
+	case tcell.KeyRune:
+		switch s := evt.Str(); s {
+		case "+":
+			t.step(1)
+		case "-":
+			t.step(-1)
+		case ":":
+			t.selectField(1)
+		default:
+			digit, err := strconv.Atoi(s)
+			if err != nil || len(s) != 1 {
+				return false
+			}
+			t.typeDigit(digit)
+		}
+	default:
+		return false
+	}
+	return true
+}
+
+// handleMouse activates the field clicked and steps it with the wheel.
+func (t *TimeSpinner) handleMouse(event *tcell.EventMouse) bool {
+	x, _ := event.Position()
+	cx, _, _, _ := t.Content()
+	switch event.Buttons() {
+	case tcell.Button1:
+		t.selectField(min(1, max(0, (x-cx)/3)))
+	case tcell.WheelUp:
+		t.step(1)
+	case tcell.WheelDown:
+		t.step(-1)
+	default:
+		return false
+	}
+	return true
+}
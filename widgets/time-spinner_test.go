@@ -0,0 +1,76 @@
+package widgets
+
+import (
+	"testing"
+
+	"github.com/gdamore/tcell/v3"
+	. "github.com/tekugo/zeichenwerk/core"
+)
+
+func runeKey(s string) *tcell.EventKey {
+	return tcell.NewEventKey(tcell.KeyRune, s, tcell.ModNone)
+}
+
+func TestTimeSpinner_Keys(t *testing.T) {
+	s := NewTimeSpinner("t", "")
+	s.SetTime(23, 58)
+	var changes int
+	s.On(EvtChange, func(_ Widget, _ Event, data ...any) bool {
+		changes++
+		return true
+	})
+
+	s.handleKey(newKey(tcell.KeyUp))
+	if h, m := s.Time(); h != 0 || m != 58 {
+		t.Errorf("↑ on the hour = %02d:%02d; want 00:58 (wrap-around)", h, m)
+	}
+	if s.handleKey(newKey(tcell.KeyLeft)) {
+		t.Error("← on the hour field should not be consumed")
+	}
+	s.handleKey(newKey(tcell.KeyRight))
+	s.handleKey(runeKey("+"))
+	s.handleKey(runeKey("+"))
+	if h, m := s.Time(); h != 0 || m != 0 {
+		t.Errorf("+ + on 58 minutes = %02d:%02d; want 00:00", h, m)
+	}
+	s.handleKey(newKey(tcell.KeyDown))
+	if _, m := s.Time(); m != 59 || changes != 4 {
+		t.Errorf("↓ = %d minutes after %d changes; want 59 after 4", m, changes)
+	}
+}
+
+func TestTimeSpinner_Digits(t *testing.T) {
+	s := NewTimeSpinner("t", "")
+	s.handleKey(runeKey("1"))
+	s.handleKey(runeKey("7"))
+	s.handleKey(runeKey("4"))
+	s.handleKey(runeKey("5"))
+	if h, m := s.Time(); h != 17 || m != 45 {
+		t.Errorf("typed 1745 = %02d:%02d; want 17:45", h, m)
+	}
+
+	// A first digit that cannot start a two-digit hour completes it.
+	s.handleKey(newKey(tcell.KeyLeft))
+	s.handleKey(runeKey("8"))
+	s.handleKey(runeKey("3"))
+	if h, m := s.Time(); h != 8 || m != 3 {
+		t.Errorf("typed 83 = %02d:%02d; want 08:03", h, m)
+	}
+}
+
+func TestTimeSpinner_Render(t *testing.T) {
+	cs := NewTestScreen()
+	s := NewTimeSpinner("t", "")
+	s.SetTime(9, 5)
+	s.SetBounds(0, 0, 5, 1)
+	s.Render(NewRenderer(cs, NewTheme()))
+	if got := screenRow(cs, 0, 0, 5); got != "09:05" {
+		t.Errorf("render = %q; want 09:05", got)
+	}
+
+	s.handleMouse(mouse(4, 0, tcell.Button1))
+	s.handleMouse(mouse(4, 0, tcell.WheelUp))
+	if h, m := s.Time(); h != 9 || m != 6 {
+		t.Errorf("wheel on the minute = %02d:%02d; want 09:06", h, m)
+	}
+}
@@ -1,7 +1,6 @@
 package widgets
 
 import (
-	"fmt"
 	"io"
 
 	"github.com/tekugo/zeichenwerk/core"
@@ -43,7 +42,6 @@ func (f *TreeForm) Validate(field string) error { return nil }
 
 func (f *TreeForm) Emit(w io.Writer, mode string) error {
 	return f.EmitFrame(w, mode, func() error {
-		_, err := fmt.Fprintf(w, "Tree(%q).\n", f.ID)
-		return err
+		return f.EmitConstructor(w, mode, "Tree")
 	})
 }
@@ -71,7 +71,6 @@ func (f *TreeFSForm) Emit(w io.Writer, mode string) error {
 		if path == "" {
 			path = "."
 		}
-		_, err := fmt.Fprintf(w, "TreeFS(%q, %q, %t).\n", f.ID, path, f.DirsOnly)
-		return err
+		return f.EmitConstructor(w, mode, "TreeFS", fmt.Sprintf("%q, %t", path, f.DirsOnly))
 	})
 }
@@ -1,7 +1,6 @@
 package widgets
 
 import (
-	"fmt"
 	"io"
 
 	"github.com/tekugo/zeichenwerk/core"
@@ -55,7 +54,6 @@ func (f *TreeWidgetsForm) Validate(field string) error { return nil }
 // "rootWidget" with the actual variable.
 func (f *TreeWidgetsForm) Emit(w io.Writer, mode string) error {
 	return f.EmitFrame(w, mode, func() error {
-		_, err := fmt.Fprintf(w, "TreeWidgets(%q, rootWidget /* TODO */).\n", f.ID)
-		return err
+		return f.EmitConstructor(w, mode, "TreeWidgets", "rootWidget /* TODO */")
 	})
 }
@@ -1,7 +1,6 @@
 package widgets
 
 import (
-	"fmt"
 	"io"
 
 	"github.com/tekugo/zeichenwerk/core"
@@ -56,16 +55,20 @@ func (f *TypeaheadForm) Validate(field string) error { return nil }
 
 func (f *TypeaheadForm) Emit(w io.Writer, mode string) error {
 	if err := f.EmitFrame(w, mode, func() error {
-		_, err := fmt.Fprintf(w, "Typeahead(%q%s).\n", f.ID, inputParamArgs(f.Text, f.Placeholder, f.Mask))
-		return err
+		params := inputParams(f.Text, f.Placeholder, f.Mask)
+		return f.EmitConstructor(w, mode, "Typeahead", stringArgs(mode, params)...)
 	}); err != nil {
 		return err
 	}
 	if f.Masked {
-		fmt.Fprintf(w, "Flag(FlagMasked, true).\n")
+		if err := f.EmitFlag(w, mode, "FlagMasked"); err != nil {
+			return err
+		}
 	}
 	if f.Readonly {
-		fmt.Fprintf(w, "Flag(FlagReadonly, true).\n")
+		if err := f.EmitFlag(w, mode, "FlagReadonly"); err != nil {
+			return err
+		}
 	}
 	return nil
 }
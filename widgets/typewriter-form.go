@@ -86,25 +86,34 @@ func (f *TypewriterForm) Validate(field string) error {
 // on the Builder, so changes from defaults emit as TODO comments.
 func (f *TypewriterForm) Emit(w io.Writer, mode string) error {
 	if err := f.EmitFrame(w, mode, func() error {
-		_, err := fmt.Fprintf(w, "Typewriter(%q).\n", f.ID)
-		return err
+		return f.EmitConstructor(w, mode, "Typewriter")
 	}); err != nil {
 		return err
 	}
 	if f.Text != "" {
-		fmt.Fprintf(w, "// TODO: SetText(%q) — no Builder setter\n", f.Text)
+		if err := f.EmitTODO(w, mode, "SetText(%q)", f.Text); err != nil {
+			return err
+		}
 	}
 	if f.Rate > 1 {
-		fmt.Fprintf(w, "// TODO: SetRate(%d) — no Builder setter\n", f.Rate)
+		if err := f.EmitTODO(w, mode, "SetRate(%d)", f.Rate); err != nil {
+			return err
+		}
 	}
 	if !f.ShowCursor {
-		fmt.Fprintf(w, "// TODO: SetCursor(false) — no Builder setter\n")
+		if err := f.EmitTODO(w, mode, "SetCursor(false)"); err != nil {
+			return err
+		}
 	}
 	if f.Repeat {
-		fmt.Fprintf(w, "// TODO: SetRepeat(true) — no Builder setter\n")
+		if err := f.EmitTODO(w, mode, "SetRepeat(true)"); err != nil {
+			return err
+		}
 	}
 	if f.Dwell != "" && f.Dwell != "500ms" {
-		fmt.Fprintf(w, "// TODO: SetDwell(%q) — no Builder setter\n", f.Dwell)
+		if err := f.EmitTODO(w, mode, "SetDwell(%q)", f.Dwell); err != nil {
+			return err
+		}
 	}
 	return nil
 }
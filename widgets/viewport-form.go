@@ -42,7 +42,6 @@ func (f *ViewportForm) Validate(field string) error { return nil }
 
 func (f *ViewportForm) Emit(w io.Writer, mode string) error {
 	return f.EmitFrame(w, mode, func() error {
-		_, err := fmt.Fprintf(w, "Viewport(%q, %q).\n", f.ID, f.Title)
-		return err
+		return f.EmitConstructor(w, mode, "Viewport", fmt.Sprintf("%q", f.Title))
 	})
 }